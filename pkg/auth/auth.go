@@ -40,7 +40,7 @@ func ValidateToken(token string, penalty time.Duration,
 		}
 
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-			if !secureCompare(bearerAuth(r), token) {
+			if !secureCompare(BearerAuth(r), token) {
 				if penalty > 0 {
 					time.Sleep(penalty)
 				}
@@ -53,8 +53,8 @@ func ValidateToken(token string, penalty time.Duration,
 	}
 }
 
-// bearerAuth returns the token provided in the request's Authorization header.
-func bearerAuth(r *http.Request) (token string) {
+// BearerAuth returns the token provided in the request's Authorization header.
+func BearerAuth(r *http.Request) (token string) {
 	auth := r.Header.Get("Authorization")
 	if auth == "" {
 		return
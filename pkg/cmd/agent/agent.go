@@ -16,7 +16,7 @@ import (
 	"github.com/scylladb/scylla-manager/v3/swagger/gen/agent/models"
 )
 
-func newAgentHandler(c agent.Config, rclone http.Handler, logger log.Logger) *chi.Mux {
+func newAgentHandler(c agent.Config, rclone http.Handler, t *throttler, logger log.Logger) *chi.Mux {
 	m := chi.NewMux()
 
 	m.Get("/node_info", newNodeInfoHandler(c).getNodeInfo)
@@ -44,6 +44,10 @@ func newAgentHandler(c agent.Config, rclone http.Handler, logger log.Logger) *ch
 			render.Respond(writer, request, err)
 		}
 	})
+	m.Get("/throttle", func(writer http.ResponseWriter, request *http.Request) {
+		s := t.Status()
+		render.Respond(writer, request, &s)
+	})
 	m.Post("/terminate", selfSigterm())
 	m.Post("/free_os_memory", func(writer http.ResponseWriter, request *http.Request) {
 		debug.FreeOSMemory()
@@ -0,0 +1,263 @@
+// Copyright (C) 2024 ScyllaDB
+
+package main
+
+import (
+	"bufio"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/rclone/rclone/fs"
+	"github.com/rclone/rclone/fs/operations"
+	"github.com/scylladb/go-log"
+	"github.com/scylladb/scylla-manager/v3/pkg/config/agent"
+	"github.com/scylladb/scylla-manager/v3/pkg/rclone/crypt"
+	"github.com/scylladb/scylla-manager/v3/pkg/service/backup/backupspec"
+	"github.com/scylladb/scylla-manager/v3/pkg/util/uuid"
+)
+
+// commitlogArchiver periodically uploads commitlog segments archived by Scylla
+// to the backup location and removes them from the local directory.
+// Segments are uploaded as they are, agent has neither the backup encryption
+// key nor the object lock settings of the cluster. Archiving is refused if
+// the newest backup of the node is encrypted or locked.
+type commitlogArchiver struct {
+	config      agent.CommitlogArchiveConfig
+	location    backupspec.Location
+	manifestDir string
+	remoteDir   string
+	logger      log.Logger
+
+	// checkedTag is the snapshot tag of the newest backup of the node that
+	// is known to be neither encrypted nor locked.
+	checkedTag string
+}
+
+func newCommitlogArchiver(ctx context.Context, c agent.Config, logger log.Logger) (*commitlogArchiver, error) {
+	location, err := backupspec.NewLocation(c.CommitlogArchive.Location)
+	if err != nil {
+		return nil, errors.Wrapf(err, "parse location %s", c.CommitlogArchive.Location)
+	}
+	clusterID, err := uuid.Parse(c.CommitlogArchive.ClusterID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "parse cluster ID %s", c.CommitlogArchive.ClusterID)
+	}
+	if _, err := os.Stat(c.CommitlogArchive.Directory); err != nil {
+		return nil, errors.Wrap(err, "check directory")
+	}
+
+	addr := net.JoinHostPort(c.Scylla.APIAddress, c.Scylla.APIPort)
+	dc, err := scyllaAPIGetString(ctx, addr, "/snitch/datacenter")
+	if err != nil {
+		return nil, errors.Wrap(err, "get datacenter")
+	}
+	hostID, err := scyllaAPIGetString(ctx, addr, "/storage_service/hostid/local")
+	if err != nil {
+		return nil, errors.Wrap(err, "get host ID")
+	}
+
+	return &commitlogArchiver{
+		config:      c.CommitlogArchive,
+		location:    location,
+		manifestDir: backupspec.RemoteManifestDir(clusterID, dc, hostID),
+		remoteDir:   location.RemotePath(backupspec.RemoteCommitlogDir(clusterID, dc, hostID)),
+		logger:      logger,
+	}, nil
+}
+
+// Run archives segments until context is canceled.
+func (a *commitlogArchiver) Run(ctx context.Context) {
+	ticker := time.NewTicker(a.config.Interval)
+	defer ticker.Stop()
+
+	for {
+		if err := a.archive(ctx); err != nil {
+			a.logger.Error(ctx, "Failed to archive commitlog segments", "error", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (a *commitlogArchiver) archive(ctx context.Context) error {
+	entries, err := os.ReadDir(a.config.Directory)
+	if err != nil {
+		return errors.Wrap(err, "read directory")
+	}
+
+	type segment struct {
+		name     string
+		sealedAt time.Time
+	}
+	var segments []segment
+	for _, e := range entries {
+		if e.IsDir() || !backupspec.IsCommitlogSegment(e.Name()) {
+			continue
+		}
+		info, err := e.Info()
+		if err != nil {
+			return errors.Wrapf(err, "stat %s", e.Name())
+		}
+		segments = append(segments, segment{name: e.Name(), sealedAt: info.ModTime()})
+	}
+	if len(segments) == 0 {
+		return nil
+	}
+	if err := a.checkBackups(ctx); err != nil {
+		return err
+	}
+
+	// Upload the oldest segments first so that the remote archive has no gaps
+	sort.Slice(segments, func(i, j int) bool {
+		return segments[i].sealedAt.Before(segments[j].sealedAt)
+	})
+
+	srcFs, err := fs.NewFs(ctx, a.config.Directory)
+	if err != nil {
+		return errors.Wrap(err, "init directory")
+	}
+	dstFs, err := fs.NewFs(ctx, a.remoteDir)
+	if err != nil {
+		return errors.Wrap(err, "init location")
+	}
+
+	for _, s := range segments {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		dst := backupspec.CommitlogSegmentFile(s.sealedAt, s.name)
+		if err := operations.CopyFile(ctx, dstFs, srcFs, dst, s.name); err != nil {
+			return errors.Wrapf(err, "upload %s", s.name)
+		}
+		if err := os.Remove(filepath.Join(a.config.Directory, s.name)); err != nil {
+			return errors.Wrapf(err, "remove %s", s.name)
+		}
+		a.logger.Info(ctx, "Archived commitlog segment", "segment", s.name, "sealed_at", s.sealedAt, "remote_dir", a.remoteDir)
+	}
+
+	return nil
+}
+
+// checkBackups returns error if the newest backup of the node is encrypted
+// or locked, segments uploaded without encryption and object lock would
+// weaken protection of the backed up data.
+func (a *commitlogArchiver) checkBackups(ctx context.Context) error {
+	f, err := fs.NewFs(ctx, a.location.RemotePath(a.manifestDir))
+	if err != nil {
+		return errors.Wrap(err, "init location")
+	}
+	entries, err := f.List(ctx, "")
+	if errors.Is(err, fs.ErrorDirNotFound) {
+		return nil
+	}
+	if err != nil {
+		return errors.Wrap(err, "list manifests")
+	}
+
+	var (
+		newest fs.Object
+		tag    string
+	)
+	for _, e := range entries {
+		o, ok := e.(fs.Object)
+		if !ok {
+			continue
+		}
+		var m backupspec.ManifestInfo
+		if err := m.ParsePath(path.Join(a.manifestDir, o.Remote())); err != nil || m.Temporary {
+			continue
+		}
+		if m.SnapshotTag > tag {
+			newest, tag = o, m.SnapshotTag
+		}
+	}
+	if newest == nil || tag == a.checkedTag {
+		return nil
+	}
+
+	if err := checkManifest(ctx, newest); err != nil {
+		return errors.Wrapf(err, "backup %s", tag)
+	}
+	a.checkedTag = tag
+	return nil
+}
+
+// checkManifest returns error if backup of manifest o is encrypted or locked.
+// Manifests of encrypted backups are encrypted as well.
+func checkManifest(ctx context.Context, o fs.Object) error {
+	r, err := o.Open(ctx)
+	if err != nil {
+		return errors.Wrap(err, "open manifest")
+	}
+	defer r.Close()
+
+	br := bufio.NewReader(r)
+	// Peek returns what it can read if manifest is shorter
+	head, _ := br.Peek(64) // nolint: errcheck
+	if crypt.IsEncrypted(head) {
+		return errors.New("backup is encrypted, commitlog segments can't be archived without encryption")
+	}
+
+	gr, err := gzip.NewReader(br)
+	if err != nil {
+		return errors.Wrap(err, "read manifest")
+	}
+	defer gr.Close()
+	var c backupspec.ManifestContent
+	if err := json.NewDecoder(gr).Decode(&c); err != nil {
+		return errors.Wrap(err, "decode manifest")
+	}
+
+	if c.EncryptionKeyID != "" {
+		return errors.New("backup is encrypted, commitlog segments can't be archived without encryption")
+	}
+	if c.ObjectLockMode != "" {
+		return errors.New("backup is locked, commitlog segments can't be archived without object lock")
+	}
+	return nil
+}
+
+func scyllaAPIGetString(ctx context.Context, addr, path string) (string, error) {
+	u := url.URL{
+		Host:   addr,
+		Scheme: "http",
+		Path:   path,
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), http.NoBody)
+	if err != nil {
+		return "", err
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	buf, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return "", errors.Errorf("%s: %s", resp.Status, buf)
+	}
+
+	// Scylla API returns quoted strings.
+	return strconv.Unquote(string(buf))
+}
@@ -0,0 +1,106 @@
+// Copyright (C) 2024 ScyllaDB
+
+package main
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/rclone/rclone/backend/local"
+	"github.com/rclone/rclone/fs/config/configmap"
+	"github.com/scylladb/scylla-manager/v3/pkg/rclone/crypt"
+	"github.com/scylladb/scylla-manager/v3/pkg/service/backup/backupspec"
+)
+
+func TestCheckManifest(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+
+	key, err := crypt.NewKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	manifest := func(c backupspec.ManifestContent) []byte {
+		t.Helper()
+		var buf bytes.Buffer
+		gw := gzip.NewWriter(&buf)
+		if err := json.NewEncoder(gw).Encode(c); err != nil {
+			t.Fatal(err)
+		}
+		if err := gw.Close(); err != nil {
+			t.Fatal(err)
+		}
+		return buf.Bytes()
+	}
+	encrypted := func(b []byte) []byte {
+		t.Helper()
+		r, err := crypt.NewEncrypter(key, bytes.NewReader(b))
+		if err != nil {
+			t.Fatal(err)
+		}
+		out, err := io.ReadAll(r)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return out
+	}
+
+	table := []struct {
+		Name  string
+		Data  []byte
+		Error bool
+	}{
+		{
+			Name: "plain",
+			Data: manifest(backupspec.ManifestContent{Version: "v2"}),
+		},
+		{
+			Name:  "encrypted manifest",
+			Data:  encrypted(manifest(backupspec.ManifestContent{Version: "v2"})),
+			Error: true,
+		},
+		{
+			Name:  "encryption key ID",
+			Data:  manifest(backupspec.ManifestContent{Version: "v2", EncryptionKeyID: "id"}),
+			Error: true,
+		},
+		{
+			Name:  "object lock",
+			Data:  manifest(backupspec.ManifestContent{Version: "v2", ObjectLockMode: "governance"}),
+			Error: true,
+		},
+	}
+
+	f, err := local.NewFs(ctx, "local", dir, configmap.Simple{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i := range table {
+		test := table[i]
+		t.Run(test.Name, func(t *testing.T) {
+			name := filepath.Base(t.Name())
+			if err := os.WriteFile(filepath.Join(dir, name), test.Data, 0o600); err != nil {
+				t.Fatal(err)
+			}
+			o, err := f.NewObject(ctx, name)
+			if err != nil {
+				t.Fatal(err)
+			}
+			err = checkManifest(ctx, o)
+			if test.Error && err == nil {
+				t.Fatal("checkManifest() expected error")
+			}
+			if !test.Error && err != nil {
+				t.Fatalf("checkManifest() error %s", err)
+			}
+		})
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net"
+	"os"
 	"runtime"
 	"strings"
 
@@ -15,6 +16,7 @@ import (
 	"github.com/pkg/errors"
 	"github.com/scylladb/scylla-manager/v3/pkg/downloader"
 	"github.com/scylladb/scylla-manager/v3/pkg/rclone"
+	"github.com/scylladb/scylla-manager/v3/pkg/rclone/crypt"
 	backup "github.com/scylladb/scylla-manager/v3/pkg/service/backup/backupspec"
 	"github.com/scylladb/scylla-manager/v3/pkg/util/uuid"
 	scyllaOperations "github.com/scylladb/scylla-manager/v3/swagger/gen/scylla/v1/client/operations"
@@ -31,6 +33,7 @@ var downloadFilesArgs = struct {
 	mode        downloader.TableDirModeValue
 	clearTables bool
 	dryRun      bool
+	keyFile     string
 
 	nodeID      uuid.Value
 	snapshotTag backup.SnapshotTagValue
@@ -93,6 +96,17 @@ var downloadFilesCmd = &cobra.Command{
 		if a.clearTables {
 			opts = append(opts, downloader.WithClearTables())
 		}
+		if a.keyFile != "" {
+			b, err := os.ReadFile(a.keyFile)
+			if err != nil {
+				return errors.Wrap(err, "read encryption key file")
+			}
+			key, err := crypt.ParseKey(strings.TrimSpace(string(b)))
+			if err != nil {
+				return errors.Wrap(err, "encryption key file")
+			}
+			opts = append(opts, downloader.WithEncryptionKey(key))
+		}
 		d, err := downloader.New(a.location.Value(), a.dataDir, logger, opts...)
 		if err != nil {
 			return err
@@ -212,6 +226,7 @@ func init() {
 	f.Var(&a.mode, "mode", "mode changes resulting directory structure, supported values are: `upload, sstableloader`, set 'upload' to use table upload directories, set 'sstableloader' for <keyspace>/<table> directories layout") // nolint: lll
 	f.BoolVar(&a.clearTables, "clear-tables", false, "remove sstables before downloading")
 	f.BoolVar(&a.dryRun, "dry-run", false, "validate and print a plan without downloading (or clearing) any files")
+	f.StringVar(&a.keyFile, "encryption-key-file", "", "`path` to a file with base64 encoded backup encryption key, required for downloading encrypted backups")
 	f.VarP(&a.nodeID, "node", "n", "'Host `ID`' value from nodetool status command output of a node you want to restore (default local node)")
 	f.VarP(&a.snapshotTag, "snapshot-tag", "T", "Scylla Manager snapshot `tag` as read from backup listing e.g. sm_20060102150405UTC, use --list-snapshots to get a list of snapshots of the node") // nolint: lll
 	f.IntVar(&a.rateLimit, "rate-limit", 0, "rate limit in megabytes (MiB) per second (default no limit)")
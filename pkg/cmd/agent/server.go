@@ -21,6 +21,7 @@ import (
 	"github.com/scylladb/scylla-manager/v3/pkg/config/agent"
 	"github.com/scylladb/scylla-manager/v3/pkg/rclone"
 	"github.com/scylladb/scylla-manager/v3/pkg/rclone/rcserver"
+	"github.com/scylladb/scylla-manager/v3/pkg/rclone/resume"
 	"github.com/scylladb/scylla-manager/v3/pkg/util/certutil"
 	"github.com/scylladb/scylla-manager/v3/pkg/util/cpuset"
 	"github.com/scylladb/scylla-manager/v3/pkg/util/httppprof"
@@ -39,6 +40,12 @@ type server struct {
 	prometheusServer *http.Server
 	debugServer      *http.Server
 
+	commitlogArchiver     *commitlogArchiver
+	stopCommitlogArchiver context.CancelFunc
+
+	throttler     *throttler
+	stopThrottler context.CancelFunc
+
 	errCh chan error
 }
 
@@ -102,12 +109,43 @@ func (s *server) init(ctx context.Context) error {
 	s.metrics.MustRegister()
 
 	// Register rclone providers
-	return multierr.Combine(
+	if err := multierr.Combine(
 		rclone.RegisterLocalDirProvider("data", "Jailed Scylla data", s.config.Scylla.DataDirectory),
 		rclone.RegisterS3Provider(s.config.S3),
 		rclone.RegisterGCSProvider(s.config.GCS),
 		rclone.RegisterAzureProvider(s.config.Azure),
-	)
+		rclone.RegisterNFSProvider(s.config.NFS),
+		rclone.RegisterSFTPProvider(s.config.SFTP),
+	); err != nil {
+		return err
+	}
+
+	// Set bandwidth profile upper bound
+	if err := rcserver.SetAgentBandwidthProfile(s.config.BandwidthProfile); err != nil {
+		return errors.Wrap(err, "bandwidth profile")
+	}
+
+	if s.config.CommitlogArchive.Enabled() {
+		a, err := newCommitlogArchiver(ctx, s.config, s.logger.Named("commitlog"))
+		if err != nil {
+			return errors.Wrap(err, "commitlog archiver")
+		}
+		s.commitlogArchiver = a
+	}
+
+	if s.config.AdaptiveThrottling.Enabled {
+		s.throttler = newThrottler(s.config, s.logger.Named("throttler"))
+	}
+
+	if s.config.ResumableUploads.Enabled {
+		st, err := resume.NewStore(s.config.ResumableUploads.Directory)
+		if err != nil {
+			return errors.Wrap(err, "resumable uploads")
+		}
+		rcserver.SetUploadStore(st)
+	}
+
+	return nil
 }
 
 func findAndPinCPUs(ctx context.Context, cfg agent.Config, logger log.Logger) error {
@@ -163,7 +201,7 @@ func (s *server) makeServers(ctx context.Context) error {
 	s.httpsServer = &http.Server{
 		Addr:      s.config.HTTPS,
 		TLSConfig: tlsConfig,
-		Handler:   newRouter(s.config, s.metrics, rcserver.New(), s.logger.Named("http")),
+		Handler:   newRouter(s.config, s.metrics, rcserver.New(), s.throttler, s.logger.Named("http")),
 	}
 	if s.config.Prometheus != "" {
 		s.prometheusServer = &http.Server{
@@ -233,10 +271,41 @@ func (s *server) startServers(ctx context.Context) {
 		}()
 	}
 
+	go rcserver.RunBandwidthScheduler(ctx)
+	go rcserver.RunUploadStorePruner(ctx, s.config.ResumableUploads.MaxAge)
+
+	if s.commitlogArchiver != nil {
+		s.logger.Info(ctx, "Starting commitlog archiver",
+			"directory", s.commitlogArchiver.config.Directory,
+			"remote_dir", s.commitlogArchiver.remoteDir,
+			"interval", s.commitlogArchiver.config.Interval,
+		)
+		actx, cancel := context.WithCancel(ctx)
+		s.stopCommitlogArchiver = cancel
+		go s.commitlogArchiver.Run(actx)
+	}
+
+	if s.throttler != nil {
+		s.logger.Info(ctx, "Starting adaptive throttling",
+			"interval", s.throttler.config.Interval,
+			"metrics_url", s.throttler.metricsURL,
+		)
+		tctx, cancel := context.WithCancel(ctx)
+		s.stopThrottler = cancel
+		go s.throttler.Run(tctx)
+	}
+
 	s.logger.Info(ctx, "Service started")
 }
 
 func (s *server) shutdownServers(ctx context.Context, timeout time.Duration) {
+	if s.stopCommitlogArchiver != nil {
+		s.stopCommitlogArchiver()
+	}
+	if s.stopThrottler != nil {
+		s.stopThrottler()
+	}
+
 	s.logger.Info(ctx, "Closing servers", "timeout", timeout)
 
 	tctx, cancel := context.WithTimeout(ctx, timeout)
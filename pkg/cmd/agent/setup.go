@@ -41,6 +41,12 @@ func setupCommand(configFiles []string, level zapcore.Level) (agent.Config, log.
 	if err := rclone.RegisterAzureProvider(c.Azure); err != nil {
 		return c, logger, err
 	}
+	if err := rclone.RegisterNFSProvider(c.NFS); err != nil {
+		return c, logger, err
+	}
+	if err := rclone.RegisterSFTPProvider(c.SFTP); err != nil {
+		return c, logger, err
+	}
 
 	return c, logger, nil
 }
@@ -0,0 +1,240 @@
+// Copyright (C) 2024 ScyllaDB
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/common/expfmt"
+	"github.com/rclone/rclone/fs"
+	"github.com/scylladb/go-log"
+	"github.com/scylladb/scylla-manager/v3/pkg/config/agent"
+	"github.com/scylladb/scylla-manager/v3/pkg/rclone/rcserver"
+	"github.com/scylladb/scylla-manager/v3/swagger/gen/agent/models"
+)
+
+// Scylla metrics used for checking node load.
+const (
+	reactorUtilizationMetric = "scylla_reactor_utilization"
+	readLatencyMetric        = "scylla_storage_proxy_coordinator_read_latency"
+	writeLatencyMetric       = "scylla_storage_proxy_coordinator_write_latency"
+)
+
+// scyllaLoad is a snapshot of Scylla load metrics.
+// Latency sums are in microseconds, they are counters, the average latency
+// is computed from difference between two snapshots.
+type scyllaLoad struct {
+	CPUUtilization    float64
+	ReadLatencySum    float64
+	ReadLatencyCount  uint64
+	WriteLatencySum   float64
+	WriteLatencyCount uint64
+}
+
+// parseScyllaLoad reads load metrics from Scylla metrics in Prometheus text
+// format. CPU utilization is averaged over shards, latencies are summed over
+// shards and scheduling groups.
+func parseScyllaLoad(r io.Reader) (scyllaLoad, error) {
+	var p expfmt.TextParser
+	mfs, err := p.TextToMetricFamilies(r)
+	if err != nil {
+		return scyllaLoad{}, errors.Wrap(err, "parse metrics")
+	}
+
+	var l scyllaLoad
+	if mf, ok := mfs[reactorUtilizationMetric]; ok && len(mf.GetMetric()) > 0 {
+		for _, m := range mf.GetMetric() {
+			l.CPUUtilization += m.GetGauge().GetValue()
+		}
+		l.CPUUtilization /= float64(len(mf.GetMetric()))
+	}
+	if mf, ok := mfs[readLatencyMetric]; ok {
+		for _, m := range mf.GetMetric() {
+			l.ReadLatencySum += m.GetHistogram().GetSampleSum()
+			l.ReadLatencyCount += m.GetHistogram().GetSampleCount()
+		}
+	}
+	if mf, ok := mfs[writeLatencyMetric]; ok {
+		for _, m := range mf.GetMetric() {
+			l.WriteLatencySum += m.GetHistogram().GetSampleSum()
+			l.WriteLatencyCount += m.GetHistogram().GetSampleCount()
+		}
+	}
+	return l, nil
+}
+
+// avgLatency returns average latency of operations between prev and cur
+// snapshots.
+func avgLatency(prevSum, curSum float64, prevCount, curCount uint64) time.Duration {
+	if curCount <= prevCount {
+		return 0
+	}
+	return time.Duration((curSum - prevSum) / float64(curCount-prevCount) * float64(time.Microsecond))
+}
+
+// nextThrottleLimit returns bandwidth limit in MiB/s after a check of node
+// load. When node is overloaded the limit is halved down to min, starting
+// from base. Otherwise the limit is doubled, it's removed (0) when it
+// reaches base.
+func nextThrottleLimit(limit, base, minLimit int, overloaded bool) int {
+	if overloaded {
+		if limit == 0 {
+			limit = base
+		}
+		limit /= 2
+		if limit < minLimit {
+			limit = minLimit
+		}
+		return limit
+	}
+
+	if limit == 0 {
+		return 0
+	}
+	limit *= 2
+	if limit >= base {
+		return 0
+	}
+	return limit
+}
+
+// throttler lowers bandwidth of transfers when Scylla node exceeds configured
+// load thresholds and ramps it back up when load goes down.
+type throttler struct {
+	config     agent.AdaptiveThrottlingConfig
+	metricsURL string
+	logger     log.Logger
+
+	mu     sync.Mutex
+	prev   *scyllaLoad
+	status models.ThrottleStatus
+}
+
+func newThrottler(c agent.Config, logger log.Logger) *throttler {
+	addr := c.Scylla.PrometheusAddress
+	if addr == "" {
+		addr = c.Scylla.ListenAddress
+	}
+	return &throttler{
+		config:     c.AdaptiveThrottling,
+		metricsURL: "http://" + net.JoinHostPort(addr, c.Scylla.PrometheusPort) + "/metrics",
+		logger:     logger,
+		status:     models.ThrottleStatus{Enabled: true},
+	}
+}
+
+// Status returns the current throttling status, it's safe to call on nil
+// throttler.
+func (t *throttler) Status() models.ThrottleStatus {
+	if t == nil {
+		return models.ThrottleStatus{}
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.status
+}
+
+// Run checks node load until context is canceled.
+func (t *throttler) Run(ctx context.Context) {
+	ticker := time.NewTicker(t.config.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		if err := t.check(ctx); err != nil {
+			t.logger.Error(ctx, "Failed to check Scylla load", "error", err)
+		}
+	}
+}
+
+func (t *throttler) check(ctx context.Context) error {
+	cur, err := t.scyllaLoad(ctx)
+	if err != nil {
+		return err
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	s := t.status
+	s.CPUUtilization = cur.CPUUtilization
+	s.ReadLatency, s.WriteLatency = 0, 0
+	if t.prev != nil {
+		s.ReadLatency = ms(avgLatency(t.prev.ReadLatencySum, cur.ReadLatencySum, t.prev.ReadLatencyCount, cur.ReadLatencyCount))
+		s.WriteLatency = ms(avgLatency(t.prev.WriteLatencySum, cur.WriteLatencySum, t.prev.WriteLatencyCount, cur.WriteLatencyCount))
+	}
+	t.prev = &cur
+
+	s.Reason = t.overloadReason(s)
+	s.Overloaded = s.Reason != ""
+
+	base := t.config.MaxBandwidth
+	if bw := rcserver.BandwidthLimit(); bw.IsSet() {
+		base = int(max(bw.Tx, bw.Rx) / fs.MebiByte)
+	}
+	limit := int64(nextThrottleLimit(int(s.BandwidthLimit), base, t.config.MinBandwidth, s.Overloaded))
+	if limit != s.BandwidthLimit {
+		if err := rcserver.SetThrottleBandwidth(fs.SizeSuffix(limit) * fs.MebiByte); err != nil {
+			return err
+		}
+		t.logger.Info(ctx, "Changed throttling bandwidth limit",
+			"limit", limit,
+			"overloaded", s.Overloaded,
+			"reason", s.Reason,
+		)
+	}
+	s.BandwidthLimit = limit
+
+	t.status = s
+	return nil
+}
+
+func (t *throttler) overloadReason(s models.ThrottleStatus) string {
+	c := t.config
+	switch {
+	case c.MaxCPUUtilization > 0 && s.CPUUtilization > c.MaxCPUUtilization:
+		return fmt.Sprintf("CPU utilization %.0f%% exceeds %.0f%%", s.CPUUtilization, c.MaxCPUUtilization)
+	case c.MaxReadLatency > 0 && s.ReadLatency > ms(c.MaxReadLatency):
+		return fmt.Sprintf("read latency %.1fms exceeds %s", s.ReadLatency, c.MaxReadLatency)
+	case c.MaxWriteLatency > 0 && s.WriteLatency > ms(c.MaxWriteLatency):
+		return fmt.Sprintf("write latency %.1fms exceeds %s", s.WriteLatency, c.MaxWriteLatency)
+	default:
+		return ""
+	}
+}
+
+func (t *throttler) scyllaLoad(ctx context.Context) (scyllaLoad, error) {
+	ctx, cancel := context.WithTimeout(ctx, t.config.Interval)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, t.metricsURL, http.NoBody)
+	if err != nil {
+		return scyllaLoad{}, err
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return scyllaLoad{}, errors.Wrap(err, "get metrics")
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return scyllaLoad{}, errors.Errorf("get metrics: %s", resp.Status)
+	}
+	return parseScyllaLoad(resp.Body)
+}
+
+func ms(d time.Duration) float64 {
+	return float64(d) / float64(time.Millisecond)
+}
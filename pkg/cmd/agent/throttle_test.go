@@ -0,0 +1,107 @@
+// Copyright (C) 2024 ScyllaDB
+
+package main
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+const scyllaLoadMetrics = `# HELP scylla_reactor_utilization CPU busy ratio
+# TYPE scylla_reactor_utilization gauge
+scylla_reactor_utilization{shard="0"} 80.000000
+scylla_reactor_utilization{shard="1"} 60.000000
+# HELP scylla_storage_proxy_coordinator_read_latency The general read latency histogram
+# TYPE scylla_storage_proxy_coordinator_read_latency histogram
+scylla_storage_proxy_coordinator_read_latency_sum{scheduling_group_name="statement",shard="0"} 3000
+scylla_storage_proxy_coordinator_read_latency_count{scheduling_group_name="statement",shard="0"} 10
+scylla_storage_proxy_coordinator_read_latency_bucket{le="+Inf",scheduling_group_name="statement",shard="0"} 10
+scylla_storage_proxy_coordinator_read_latency_sum{scheduling_group_name="statement",shard="1"} 1000
+scylla_storage_proxy_coordinator_read_latency_count{scheduling_group_name="statement",shard="1"} 10
+scylla_storage_proxy_coordinator_read_latency_bucket{le="+Inf",scheduling_group_name="statement",shard="1"} 10
+# HELP scylla_storage_proxy_coordinator_write_latency The general write latency histogram
+# TYPE scylla_storage_proxy_coordinator_write_latency histogram
+scylla_storage_proxy_coordinator_write_latency_sum{scheduling_group_name="statement",shard="0"} 500
+scylla_storage_proxy_coordinator_write_latency_count{scheduling_group_name="statement",shard="0"} 5
+scylla_storage_proxy_coordinator_write_latency_bucket{le="+Inf",scheduling_group_name="statement",shard="0"} 5
+`
+
+func TestParseScyllaLoad(t *testing.T) {
+	l, err := parseScyllaLoad(strings.NewReader(scyllaLoadMetrics))
+	if err != nil {
+		t.Fatal(err)
+	}
+	golden := scyllaLoad{
+		CPUUtilization:    70,
+		ReadLatencySum:    4000,
+		ReadLatencyCount:  20,
+		WriteLatencySum:   500,
+		WriteLatencyCount: 5,
+	}
+	if l != golden {
+		t.Fatalf("parseScyllaLoad() = %+v, expected %+v", l, golden)
+	}
+
+	if d := avgLatency(0, l.ReadLatencySum, 0, l.ReadLatencyCount); d != 200*time.Microsecond {
+		t.Fatalf("avgLatency() = %s, expected 200µs", d)
+	}
+	if d := avgLatency(l.ReadLatencySum, l.ReadLatencySum, l.ReadLatencyCount, l.ReadLatencyCount); d != 0 {
+		t.Fatalf("avgLatency() = %s, expected 0", d)
+	}
+}
+
+func TestNextThrottleLimit(t *testing.T) {
+	table := []struct {
+		Name       string
+		Limit      int
+		Overloaded bool
+		Golden     int
+	}{
+		{
+			Name:       "start throttling",
+			Limit:      0,
+			Overloaded: true,
+			Golden:     50,
+		},
+		{
+			Name:       "lower limit",
+			Limit:      50,
+			Overloaded: true,
+			Golden:     25,
+		},
+		{
+			Name:       "keep min limit",
+			Limit:      15,
+			Overloaded: true,
+			Golden:     10,
+		},
+		{
+			Name:       "raise limit",
+			Limit:      25,
+			Overloaded: false,
+			Golden:     50,
+		},
+		{
+			Name:       "stop throttling",
+			Limit:      50,
+			Overloaded: false,
+			Golden:     0,
+		},
+		{
+			Name:       "not throttled",
+			Limit:      0,
+			Overloaded: false,
+			Golden:     0,
+		},
+	}
+
+	for i := range table {
+		test := table[i]
+		t.Run(test.Name, func(t *testing.T) {
+			if v := nextThrottleLimit(test.Limit, 100, 10, test.Overloaded); v != test.Golden {
+				t.Fatalf("nextThrottleLimit() = %d, expected %d", v, test.Golden)
+			}
+		})
+	}
+}
@@ -6,9 +6,11 @@ import (
 	"crypto/tls"
 	"net/http"
 	"os"
+	"strings"
 
 	"github.com/pkg/errors"
 	"github.com/scylladb/scylla-manager/v3/pkg"
+	"github.com/scylladb/scylla-manager/v3/pkg/auth"
 	"github.com/scylladb/scylla-manager/v3/pkg/command/flag"
 	"github.com/scylladb/scylla-manager/v3/pkg/managerclient"
 	"github.com/scylladb/scylla-manager/v3/pkg/util/cfgutil"
@@ -22,6 +24,9 @@ type rootCommand struct {
 	apiURL      string
 	apiCertFile string
 	apiKeyFile  string
+
+	apiToken     string
+	apiTokenFile string
 }
 
 func newRootCommand(client *managerclient.Client) *cobra.Command {
@@ -45,6 +50,8 @@ func (cmd *rootCommand) init() {
 	w.GlobalAPIURL(&cmd.apiURL, apiURL())
 	w.GlobalAPICertFile(&cmd.apiCertFile)
 	w.GlobalAPIKeyFile(&cmd.apiKeyFile)
+	w.GlobalAPIToken(&cmd.apiToken)
+	w.GlobalAPITokenFile(&cmd.apiTokenFile)
 }
 
 func (cmd *rootCommand) preRun() error {
@@ -71,6 +78,22 @@ func (cmd *rootCommand) preRun() error {
 		})
 	}
 
+	if cmd.apiTokenFile != "" {
+		if cmd.apiToken != "" {
+			return errors.New("--api-token and --api-token-file flags can't be used together")
+		}
+		b, err := os.ReadFile(cmd.apiTokenFile)
+		if err != nil {
+			return errors.Wrap(err, "read API token file")
+		}
+		cmd.apiToken = strings.TrimSpace(string(b))
+	}
+	if cmd.apiToken != "" {
+		opts = append(opts, func(c *http.Client) {
+			c.Transport = auth.AddToken(c.Transport, cmd.apiToken)
+		})
+	}
+
 	c, err := managerclient.NewClient(cmd.apiURL, opts...)
 	if err != nil {
 		return err
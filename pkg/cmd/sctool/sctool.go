@@ -7,11 +7,21 @@ import (
 	"log"
 	"os"
 
+	"github.com/scylladb/scylla-manager/v3/pkg/command/audit/auditlist"
 	"github.com/scylladb/scylla-manager/v3/pkg/command/backup"
 	"github.com/scylladb/scylla-manager/v3/pkg/command/backup/backupdelete"
 	"github.com/scylladb/scylla-manager/v3/pkg/command/backup/backupfiles"
+	"github.com/scylladb/scylla-manager/v3/pkg/command/backup/backupkey/keydelete"
+	"github.com/scylladb/scylla-manager/v3/pkg/command/backup/backupkey/keyexport"
+	"github.com/scylladb/scylla-manager/v3/pkg/command/backup/backupkey/keyimport"
 	"github.com/scylladb/scylla-manager/v3/pkg/command/backup/backuplist"
+	"github.com/scylladb/scylla-manager/v3/pkg/command/backup/backupsla"
+	"github.com/scylladb/scylla-manager/v3/pkg/command/backup/backupsla/sladelete"
+	"github.com/scylladb/scylla-manager/v3/pkg/command/backup/backupsla/slaset"
 	"github.com/scylladb/scylla-manager/v3/pkg/command/backup/backupvalidate"
+	"github.com/scylladb/scylla-manager/v3/pkg/command/cluster/blackout/blackoutadd"
+	"github.com/scylladb/scylla-manager/v3/pkg/command/cluster/blackout/blackoutdelete"
+	"github.com/scylladb/scylla-manager/v3/pkg/command/cluster/blackout/blackoutlist"
 	"github.com/scylladb/scylla-manager/v3/pkg/command/cluster/clusteradd"
 	"github.com/scylladb/scylla-manager/v3/pkg/command/cluster/clusterdelete"
 	"github.com/scylladb/scylla-manager/v3/pkg/command/cluster/clusterlist"
@@ -24,6 +34,7 @@ import (
 	"github.com/scylladb/scylla-manager/v3/pkg/command/legacy/task/taskstart"
 	"github.com/scylladb/scylla-manager/v3/pkg/command/legacy/task/taskstop"
 	"github.com/scylladb/scylla-manager/v3/pkg/command/legacy/task/taskupdate"
+	"github.com/scylladb/scylla-manager/v3/pkg/command/overview"
 	"github.com/scylladb/scylla-manager/v3/pkg/command/progress"
 	"github.com/scylladb/scylla-manager/v3/pkg/command/repair"
 	"github.com/scylladb/scylla-manager/v3/pkg/command/repair/repaircontrol"
@@ -34,6 +45,9 @@ import (
 	"github.com/scylladb/scylla-manager/v3/pkg/command/stop"
 	"github.com/scylladb/scylla-manager/v3/pkg/command/suspend"
 	"github.com/scylladb/scylla-manager/v3/pkg/command/tasks"
+	"github.com/scylladb/scylla-manager/v3/pkg/command/token/tokencreate"
+	"github.com/scylladb/scylla-manager/v3/pkg/command/token/tokendelete"
+	"github.com/scylladb/scylla-manager/v3/pkg/command/token/tokenlist"
 	"github.com/scylladb/scylla-manager/v3/pkg/command/version"
 	"github.com/scylladb/scylla-manager/v3/pkg/managerclient"
 	"github.com/spf13/cobra"
@@ -54,27 +68,74 @@ func main() {
 func buildCommand() *cobra.Command {
 	var client managerclient.Client
 
+	auditCmd := &cobra.Command{
+		Use:   "audit",
+		Short: "Show audit log of API operations",
+	}
+	auditCmd.AddCommand(
+		auditlist.NewCommand(&client),
+	)
+
+	slaCmd := backupsla.NewCommand(&client)
+	slaCmd.AddCommand(
+		sladelete.NewCommand(&client),
+		slaset.NewCommand(&client),
+	)
+
+	keyCmd := &cobra.Command{
+		Use:   "key",
+		Short: "Export, import or delete backup encryption keys",
+	}
+	keyCmd.AddCommand(
+		keydelete.NewCommand(&client),
+		keyexport.NewCommand(&client),
+		keyimport.NewCommand(&client),
+	)
+
 	backupCmd := backup.NewCommand(&client)
 	backupCmd.AddCommand(
 		backupdelete.NewCommand(&client),
 		backupfiles.NewCommand(&client),
+		keyCmd,
 		backuplist.NewCommand(&client),
+		slaCmd,
 		backupvalidate.NewCommand(&client),
 	)
 
 	restoreCmd := restore.NewCommand(&client)
 
+	blackoutCmd := &cobra.Command{
+		Use:   "blackout",
+		Short: "Add, list or delete cluster blackout periods",
+	}
+	blackoutCmd.AddCommand(
+		blackoutadd.NewCommand(&client),
+		blackoutdelete.NewCommand(&client),
+		blackoutlist.NewCommand(&client),
+	)
+
 	clusterCmd := &cobra.Command{
 		Use:   "cluster",
 		Short: "Add or delete clusters",
 	}
 	clusterCmd.AddCommand(
+		blackoutCmd,
 		clusteradd.NewCommand(&client),
 		clusterdelete.NewCommand(&client),
 		clusterlist.NewCommand(&client),
 		clusterupdate.NewCommand(&client),
 	)
 
+	tokenCmd := &cobra.Command{
+		Use:   "token",
+		Short: "Create, list or delete API tokens",
+	}
+	tokenCmd.AddCommand(
+		tokencreate.NewCommand(&client),
+		tokendelete.NewCommand(&client),
+		tokenlist.NewCommand(&client),
+	)
+
 	repairCmd := repair.NewCommand(&client)
 	repairCmd.AddCommand(repaircontrol.NewCommand(&client))
 
@@ -95,10 +156,12 @@ func buildCommand() *cobra.Command {
 
 	rootCmd := newRootCommand(&client)
 	rootCmd.AddCommand(
+		auditCmd,
 		backupCmd,
 		restoreCmd,
 		clusterCmd,
 		info.NewCommand(&client),
+		overview.NewCommand(&client),
 		repairCmd,
 		resume.NewCommand(&client),
 		progress.NewCommand(&client),
@@ -108,6 +171,7 @@ func buildCommand() *cobra.Command {
 		suspend.NewCommand(&client),
 		taskCmd,
 		tasks.NewCommand(&client),
+		tokenCmd,
 		version.NewCommand(&client),
 	)
 	setCommandDefaults(rootCmd)
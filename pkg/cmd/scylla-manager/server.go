@@ -17,10 +17,14 @@ import (
 	"github.com/scylladb/scylla-manager/v3/pkg/metrics"
 	"github.com/scylladb/scylla-manager/v3/pkg/restapi"
 	"github.com/scylladb/scylla-manager/v3/pkg/schema/table"
+	"github.com/scylladb/scylla-manager/v3/pkg/service/access"
+	"github.com/scylladb/scylla-manager/v3/pkg/service/audit"
 	"github.com/scylladb/scylla-manager/v3/pkg/service/backup"
 	"github.com/scylladb/scylla-manager/v3/pkg/service/cluster"
 	"github.com/scylladb/scylla-manager/v3/pkg/service/configcache"
 	"github.com/scylladb/scylla-manager/v3/pkg/service/healthcheck"
+	"github.com/scylladb/scylla-manager/v3/pkg/service/leader"
+	"github.com/scylladb/scylla-manager/v3/pkg/service/notify"
 	"github.com/scylladb/scylla-manager/v3/pkg/service/repair"
 	"github.com/scylladb/scylla-manager/v3/pkg/service/restore"
 	"github.com/scylladb/scylla-manager/v3/pkg/service/scheduler"
@@ -36,14 +40,20 @@ type server struct {
 	session gocqlx.Session
 	logger  log.Logger
 
+	accessSvc      *access.Service
+	auditSvc       *audit.Service
 	clusterSvc     *cluster.Service
 	healthSvc      *healthcheck.Service
 	backupSvc      *backup.Service
 	restoreSvc     *restore.Service
 	repairSvc      *repair.Service
 	schedSvc       *scheduler.Service
+	notifySvc      *notify.Service
+	leaderSvc      *leader.Service
 	configCacheSvc configcache.ConfigCacher
 
+	leaderDone chan struct{}
+
 	httpServer       *http.Server
 	httpsServer      *http.Server
 	prometheusServer *http.Server
@@ -73,6 +83,16 @@ func (s *server) makeServices(ctx context.Context) error {
 	drawerStore := store.NewTableStore(s.session, table.Drawer)
 	secretsStore := store.NewTableStore(s.session, table.Secrets)
 
+	s.accessSvc, err = access.NewService(s.session, s.config.AuthToken, s.logger.Named("access"))
+	if err != nil {
+		return errors.Wrapf(err, "access service")
+	}
+
+	s.auditSvc, err = audit.NewService(s.session, s.logger.Named("audit"))
+	if err != nil {
+		return errors.Wrapf(err, "audit service")
+	}
+
 	s.clusterSvc, err = cluster.NewService(s.session, metrics.NewClusterMetrics().MustRegister(), secretsStore, s.config.TimeoutConfig,
 		s.config.ClientCacheTimeout, s.logger.Named("cluster"))
 	if err != nil {
@@ -115,6 +135,7 @@ func (s *server) makeServices(ctx context.Context) error {
 		s.clusterSvc.GetClusterName,
 		s.clusterSvc.Client,
 		s.clusterSvc.GetSession,
+		secretsStore,
 		s.logger.Named("backup"),
 	)
 	if err != nil {
@@ -128,6 +149,7 @@ func (s *server) makeServices(ctx context.Context) error {
 		metrics.NewRestoreMetrics().MustRegister(),
 		s.clusterSvc.Client,
 		s.clusterSvc.GetSession,
+		secretsStore,
 		s.logger.Named("restore"),
 	)
 	if err != nil {
@@ -144,6 +166,24 @@ func (s *server) makeServices(ctx context.Context) error {
 		return errors.Wrapf(err, "scheduler service")
 	}
 
+	s.notifySvc, err = notify.NewService(
+		s.config.Notifications,
+		s.session,
+		s.clusterSvc.GetClusterName,
+		s.logger.Named("notify"),
+	)
+	if err != nil {
+		return errors.Wrapf(err, "notify service")
+	}
+	s.schedSvc.SetNotifier(s.notifySvc, s.config.Notifications.StaleAfter)
+
+	if s.config.HA.Enabled {
+		s.leaderSvc, err = leader.NewService(s.config.HA, s.session, s.logger.Named("leader"))
+		if err != nil {
+			return errors.Wrapf(err, "leader service")
+		}
+	}
+
 	// Register the runners
 	s.schedSvc.SetRunner(scheduler.BackupTask, scheduler.PolicyRunner{Policy: scheduler.NewLockClusterPolicy(), Runner: s.backupSvc.Runner()})
 	s.schedSvc.SetRunner(scheduler.RestoreTask, scheduler.PolicyRunner{Policy: scheduler.NewLockClusterPolicy(), Runner: s.restoreSvc.Runner()})
@@ -203,6 +243,11 @@ func (s *server) makeServers(ctx context.Context) error {
 		Backup:      s.backupSvc,
 		Restore:     s.restoreSvc,
 		Scheduler:   s.schedSvc,
+		Access:      s.accessSvc,
+		Audit:       s.auditSvc,
+	}
+	if s.leaderSvc != nil {
+		services.Leader = s.leaderSvc
 	}
 	h := restapi.New(services, s.logger.Named("http"))
 
@@ -323,12 +368,34 @@ func (s *server) startServices(ctx context.Context) error {
 	if err := s.clusterSvc.Init(ctx); err != nil {
 		return errors.Wrapf(err, "cluster service")
 	}
-	if err := s.schedSvc.LoadTasks(ctx); err != nil {
-		return errors.Wrapf(err, "schedule service")
-	}
 
 	s.startConfigCacheSvcAsync(ctx)
 
+	// With HA enabled tasks are scheduled only when this instance is elected leader
+	if s.leaderSvc != nil {
+		s.leaderDone = make(chan struct{})
+		go func() {
+			defer close(s.leaderDone)
+			s.leaderSvc.Run(ctx, s.onElected, s.schedSvc.UnloadTasks)
+		}()
+		return nil
+	}
+
+	if err := s.onElected(ctx); err != nil {
+		return errors.Wrapf(err, "schedule service")
+	}
+	return nil
+}
+
+// onElected starts scheduling tasks, it's called on start or when instance
+// becomes the leader. Runs left RUNNING by the previous leader are marked as
+// aborted and continued.
+func (s *server) onElected(ctx context.Context) error {
+	if err := s.schedSvc.LoadTasks(ctx); err != nil {
+		return err
+	}
+	go s.schedSvc.WatchStaleTasks(ctx, s.config.Notifications.StaleCheckInterval)
+	go s.backupSvc.WatchSLA(ctx, s.notifySvc)
 	return nil
 }
 
@@ -397,7 +464,14 @@ func (s *server) shutdownHTTPServer(ctx context.Context, server *http.Server) fu
 func (s *server) close() {
 	// The cluster service needs to be closed last because it handles closing of
 	// connections to agent running on the nodes.
+	if s.leaderDone != nil {
+		<-s.leaderDone
+	}
 	s.schedSvc.Close()
+	if s.leaderSvc != nil {
+		s.leaderSvc.Release(context.Background())
+	}
+	s.notifySvc.Close()
 	s.clusterSvc.Close()
 
 	s.session.Close()
@@ -0,0 +1,77 @@
+// Copyright (C) 2024 ScyllaDB
+
+package auditlist
+
+import (
+	_ "embed"
+
+	"github.com/scylladb/scylla-manager/v3/pkg/command/flag"
+	"github.com/scylladb/scylla-manager/v3/pkg/managerclient"
+	"github.com/scylladb/scylla-manager/v3/pkg/util/uuid"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v2"
+)
+
+//go:embed res.yaml
+var res []byte
+
+type command struct {
+	cobra.Command
+	client *managerclient.Client
+
+	cluster  string
+	since    flag.Time
+	until    flag.Time
+	limit    int
+	showDiff bool
+}
+
+func NewCommand(client *managerclient.Client) *cobra.Command {
+	cmd := &command{
+		client: client,
+	}
+	if err := yaml.Unmarshal(res, &cmd.Command); err != nil {
+		panic(err)
+	}
+	cmd.init()
+	cmd.RunE = func(_ *cobra.Command, args []string) error {
+		return cmd.run()
+	}
+	return &cmd.Command
+}
+
+func (cmd *command) init() {
+	defer flag.MustSetUsages(&cmd.Command, res)
+
+	w := flag.Wrap(cmd.Flags())
+	w.Cluster(&cmd.cluster)
+	w.Unwrap().Var(&cmd.since, "since", "")
+	w.Unwrap().Var(&cmd.until, "until", "")
+	w.Unwrap().IntVar(&cmd.limit, "limit", 100, "")
+	w.Unwrap().BoolVar(&cmd.showDiff, "show-diff", false, "")
+}
+
+func (cmd *command) run() error {
+	clusterID := cmd.cluster
+	// Audit log is filtered by cluster ID so that entries of deleted
+	// clusters can be listed, names of existing clusters are resolved.
+	if clusterID != "" {
+		if _, err := uuid.Parse(clusterID); err != nil {
+			c, err := cmd.client.GetCluster(cmd.Context(), clusterID)
+			if err != nil {
+				return err
+			}
+			clusterID = c.ID
+		}
+	}
+
+	entries, err := cmd.client.ListAuditEntries(cmd.Context(), clusterID, cmd.since.Value(), cmd.until.Value(), cmd.limit)
+	if err != nil {
+		return err
+	}
+
+	return managerclient.AuditEntries{
+		AuditEntrySlice: entries,
+		ShowDiff:        cmd.showDiff,
+	}.Render(cmd.OutOrStdout())
+}
@@ -0,0 +1,71 @@
+// Copyright (C) 2024 ScyllaDB
+
+package keyexport
+
+import (
+	_ "embed"
+	"fmt"
+	"os"
+
+	"github.com/pkg/errors"
+	"github.com/scylladb/scylla-manager/v3/pkg/command/flag"
+	"github.com/scylladb/scylla-manager/v3/pkg/managerclient"
+	"github.com/scylladb/scylla-manager/v3/pkg/util/fsutil"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v2"
+)
+
+//go:embed res.yaml
+var res []byte
+
+type command struct {
+	cobra.Command
+	client *managerclient.Client
+
+	cluster string
+	output  string
+}
+
+func NewCommand(client *managerclient.Client) *cobra.Command {
+	cmd := &command{
+		client: client,
+	}
+	if err := yaml.Unmarshal(res, &cmd.Command); err != nil {
+		panic(err)
+	}
+	cmd.init()
+	cmd.RunE = func(_ *cobra.Command, args []string) error {
+		return cmd.run()
+	}
+	return &cmd.Command
+}
+
+func (cmd *command) init() {
+	defer flag.MustSetUsages(&cmd.Command, res, "cluster")
+
+	w := flag.Wrap(cmd.Flags())
+	w.Cluster(&cmd.cluster)
+	w.Unwrap().StringVarP(&cmd.output, "output", "o", "", "")
+}
+
+func (cmd *command) run() error {
+	k, err := cmd.client.GetBackupEncryptionKey(cmd.Context(), cmd.cluster)
+	if err != nil {
+		return err
+	}
+
+	if cmd.output == "" {
+		fmt.Fprintln(cmd.OutOrStdout(), k.Key)
+		return nil
+	}
+
+	p, err := fsutil.ExpandPath(cmd.output)
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(p, []byte(k.Key+"\n"), 0o600); err != nil {
+		return errors.Wrap(err, "write key file")
+	}
+	fmt.Fprintf(cmd.OutOrStderr(), "Exported key %s of cluster %s to %s\n", k.ID, k.ClusterID, p)
+	return nil
+}
@@ -0,0 +1,58 @@
+// Copyright (C) 2024 ScyllaDB
+
+package keyimport
+
+import (
+	_ "embed"
+	"strings"
+
+	"github.com/scylladb/scylla-manager/v3/pkg/command/flag"
+	"github.com/scylladb/scylla-manager/v3/pkg/managerclient"
+	"github.com/scylladb/scylla-manager/v3/pkg/util/fsutil"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v2"
+)
+
+//go:embed res.yaml
+var res []byte
+
+type command struct {
+	cobra.Command
+	client *managerclient.Client
+
+	cluster string
+	keyFile string
+}
+
+func NewCommand(client *managerclient.Client) *cobra.Command {
+	cmd := &command{
+		client: client,
+	}
+	if err := yaml.Unmarshal(res, &cmd.Command); err != nil {
+		panic(err)
+	}
+	cmd.init()
+	cmd.RunE = func(_ *cobra.Command, args []string) error {
+		return cmd.run()
+	}
+	return &cmd.Command
+}
+
+func (cmd *command) init() {
+	defer flag.MustSetUsages(&cmd.Command, res, "cluster", "key-file")
+
+	w := flag.Wrap(cmd.Flags())
+	w.Cluster(&cmd.cluster)
+	w.Unwrap().StringVar(&cmd.keyFile, "key-file", "", "")
+}
+
+func (cmd *command) run() error {
+	b, err := fsutil.ReadFile(cmd.keyFile)
+	if err != nil {
+		return err
+	}
+	k := &managerclient.BackupEncryptionKey{
+		Key: strings.TrimSpace(string(b)),
+	}
+	return cmd.client.PutBackupEncryptionKey(cmd.Context(), cmd.cluster, k)
+}
@@ -26,6 +26,7 @@ type command struct {
 	cluster             string
 	location            []string
 	deleteOrphanedFiles bool
+	verifyChecksums     bool
 	parallel            int
 }
 
@@ -69,6 +70,7 @@ func (cmd *command) init() {
 	w.Cluster(&cmd.cluster)
 	w.Location(&cmd.location)
 	w.Unwrap().BoolVar(&cmd.deleteOrphanedFiles, "delete-orphaned-files", false, "")
+	w.Unwrap().BoolVar(&cmd.verifyChecksums, "verify-checksums", false, "")
 	w.Unwrap().IntVar(&cmd.parallel, "parallel", 0, "")
 }
 
@@ -108,6 +110,10 @@ func (cmd *command) run(args []string) error {
 		props["delete_orphaned_files"] = cmd.deleteOrphanedFiles
 		ok = true
 	}
+	if cmd.Flag("verify-checksums").Changed {
+		props["verify_checksums"] = cmd.verifyChecksums
+		ok = true
+	}
 	if cmd.Flag("parallel").Changed {
 		props["parallel"] = cmd.parallel
 		ok = true
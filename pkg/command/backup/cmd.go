@@ -32,6 +32,7 @@ type command struct {
 	retention        int
 	retentionDays    int
 	rateLimit        []string
+	rateLimitProfile []string
 	transfers        int
 	snapshotParallel []string
 	uploadParallel   []string
@@ -39,6 +40,11 @@ type command struct {
 	showTables       bool
 	purgeOnly        bool
 	skipSchema       bool
+	encrypt          bool
+	compression      string
+	incremental      bool
+	fullEvery        int
+	objectLockMode   string
 }
 
 func NewCommand(client *managerclient.Client) *cobra.Command {
@@ -85,6 +91,7 @@ func (cmd *command) init() {
 	w.Unwrap().IntVar(&cmd.retention, "retention", 7, "")
 	w.Unwrap().IntVar(&cmd.retentionDays, "retention-days", 0, "")
 	w.Unwrap().StringSliceVar(&cmd.rateLimit, "rate-limit", nil, "")
+	w.Unwrap().StringSliceVar(&cmd.rateLimitProfile, "rate-limit-profile", nil, "")
 	w.Unwrap().IntVar(&cmd.transfers, "transfers", -1, "")
 	w.Unwrap().StringSliceVar(&cmd.snapshotParallel, "snapshot-parallel", nil, "")
 	w.Unwrap().StringSliceVar(&cmd.uploadParallel, "upload-parallel", nil, "")
@@ -92,6 +99,11 @@ func (cmd *command) init() {
 	w.Unwrap().BoolVar(&cmd.showTables, "show-tables", false, "")
 	w.Unwrap().BoolVar(&cmd.purgeOnly, "purge-only", false, "")
 	w.Unwrap().BoolVar(&cmd.skipSchema, "skip-schema", false, "")
+	w.Unwrap().BoolVar(&cmd.encrypt, "encrypt", false, "")
+	w.Unwrap().StringVar(&cmd.compression, "compression", "", "")
+	w.Unwrap().BoolVar(&cmd.incremental, "incremental", false, "")
+	w.Unwrap().IntVar(&cmd.fullEvery, "full-every", 0, "")
+	w.Unwrap().StringVar(&cmd.objectLockMode, "object-lock-mode", "", "")
 }
 
 func (cmd *command) run(args []string) error {
@@ -146,6 +158,10 @@ func (cmd *command) run(args []string) error {
 		props["rate_limit"] = cmd.rateLimit
 		ok = true
 	}
+	if cmd.Flag("rate-limit-profile").Changed {
+		props["rate_limit_profile"] = cmd.rateLimitProfile
+		ok = true
+	}
 	if cmd.Flag("transfers").Changed {
 		props["transfers"] = cmd.transfers
 		ok = true
@@ -166,6 +182,26 @@ func (cmd *command) run(args []string) error {
 		props["skip_schema"] = cmd.purgeOnly
 		ok = true
 	}
+	if cmd.Flag("encrypt").Changed {
+		props["encrypt"] = cmd.encrypt
+		ok = true
+	}
+	if cmd.Flag("compression").Changed {
+		props["compression"] = cmd.compression
+		ok = true
+	}
+	if cmd.Flag("incremental").Changed {
+		props["incremental"] = cmd.incremental
+		ok = true
+	}
+	if cmd.Flag("full-every").Changed {
+		props["full_every"] = cmd.fullEvery
+		ok = true
+	}
+	if cmd.Flag("object-lock-mode").Changed {
+		props["object_lock_mode"] = cmd.objectLockMode
+		ok = true
+	}
 
 	if cmd.dryRun {
 		stillWaiting := atomic.NewBool(true)
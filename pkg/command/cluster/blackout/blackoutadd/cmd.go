@@ -0,0 +1,68 @@
+// Copyright (C) 2024 ScyllaDB
+
+package blackoutadd
+
+import (
+	_ "embed"
+	"fmt"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/scylladb/scylla-manager/v3/pkg/command/flag"
+	"github.com/scylladb/scylla-manager/v3/pkg/managerclient"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v2"
+)
+
+//go:embed res.yaml
+var res []byte
+
+type command struct {
+	cobra.Command
+	client *managerclient.Client
+
+	cluster string
+	start   flag.Time
+	end     flag.Time
+	reason  string
+}
+
+func NewCommand(client *managerclient.Client) *cobra.Command {
+	cmd := &command{
+		client: client,
+	}
+	if err := yaml.Unmarshal(res, &cmd.Command); err != nil {
+		panic(err)
+	}
+	cmd.init()
+	cmd.RunE = func(_ *cobra.Command, args []string) error {
+		return cmd.run()
+	}
+	return &cmd.Command
+}
+
+func (cmd *command) init() {
+	defer flag.MustSetUsages(&cmd.Command, res, "cluster", "start", "end")
+
+	w := flag.Wrap(cmd.Flags())
+	w.Cluster(&cmd.cluster)
+	w.Unwrap().Var(&cmd.start, "start", "")
+	w.Unwrap().Var(&cmd.end, "end", "")
+	w.Unwrap().StringVar(&cmd.reason, "reason", "", "")
+}
+
+func (cmd *command) run() error {
+	b := &managerclient.Blackout{
+		StartTime: strfmt.DateTime(cmd.start.Value()),
+		EndTime:   strfmt.DateTime(cmd.end.Value()),
+		Reason:    cmd.reason,
+	}
+
+	id, err := cmd.client.CreateBlackout(cmd.Context(), cmd.cluster, b)
+	if err != nil {
+		return err
+	}
+
+	w := cmd.OutOrStdout()
+	fmt.Fprintln(w, id)
+	return nil
+}
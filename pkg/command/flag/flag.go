@@ -87,6 +87,14 @@ func (w Wrapper) GlobalAPIKeyFile(p *string) {
 	w.fs.StringVar(p, "api-key-file", os.Getenv("SCYLLA_MANAGER_API_KEY_FILE"), usage["api-key-file"])
 }
 
+func (w Wrapper) GlobalAPIToken(p *string) {
+	w.fs.StringVar(p, "api-token", os.Getenv("SCYLLA_MANAGER_API_TOKEN"), usage["api-token"])
+}
+
+func (w Wrapper) GlobalAPITokenFile(p *string) {
+	w.fs.StringVar(p, "api-token-file", os.Getenv("SCYLLA_MANAGER_API_TOKEN_FILE"), usage["api-token-file"])
+}
+
 //
 // Common flags
 //
@@ -160,6 +168,22 @@ func (w Wrapper) retryWait(p *Duration) {
 	w.fs.Var(p, "retry-wait", usage["retry-wait"])
 }
 
+func (w Wrapper) runAfter(p *string) {
+	w.fs.StringVar(p, "run-after", "", usage["run-after"])
+}
+
+func (w Wrapper) runAfterCondition(p *string) {
+	w.fs.StringVar(p, "run-after-condition", "", usage["run-after-condition"])
+}
+
+func (w Wrapper) notify(p *[]string) {
+	w.fs.StringSliceVar(p, "notify", nil, usage["notify"])
+}
+
+func (w Wrapper) notifyStaleAfter(p *Duration) {
+	w.fs.Var(p, "notify-stale-after", usage["notify-stale-after"])
+}
+
 func (w Wrapper) MustMarkDeprecated(name, usageMessage string) {
 	if err := w.fs.MarkDeprecated(name, usageMessage); err != nil {
 		panic(err)
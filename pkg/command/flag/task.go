@@ -26,6 +26,12 @@ type TaskBase struct {
 	startDate  StartDate
 	numRetries int
 	retryWait  Duration
+
+	runAfter          string
+	runAfterCondition string
+
+	notify           []string
+	notifyStaleAfter Duration
 }
 
 func MakeTaskBase() TaskBase {
@@ -55,6 +61,10 @@ func (cmd *TaskBase) Init() {
 	w.startDate(&cmd.startDate)
 	w.numRetries(&cmd.numRetries, cmd.numRetries)
 	w.retryWait(&cmd.retryWait)
+	w.runAfter(&cmd.runAfter)
+	w.runAfterCondition(&cmd.runAfterCondition)
+	w.notify(&cmd.notify)
+	w.notifyStaleAfter(&cmd.notifyStaleAfter)
 }
 
 // Update allows differentiating instances created with NewUpdateTaskBase.
@@ -79,6 +89,12 @@ func (cmd *TaskBase) CreateTask(taskType string) *managerclient.Task {
 			RetryWait:  cmd.retryWait.String(),
 		},
 		Properties: make(map[string]interface{}),
+
+		RunAfter:          cmd.runAfter,
+		RunAfterCondition: cmd.runAfterCondition,
+
+		Notify:           cmd.notify,
+		NotifyStaleAfter: cmd.notifyStaleAfter.String(),
 	}
 }
 
@@ -125,5 +141,24 @@ func (cmd *TaskBase) UpdateTask(task *managerclient.Task) bool {
 		task.Schedule.RetryWait = cmd.retryWait.String()
 		ok = true
 	}
+	if cmd.Flag("run-after").Changed {
+		task.RunAfter = cmd.runAfter
+		if cmd.runAfter == "" {
+			task.RunAfterCondition = ""
+		}
+		ok = true
+	}
+	if cmd.Flag("run-after-condition").Changed {
+		task.RunAfterCondition = cmd.runAfterCondition
+		ok = true
+	}
+	if cmd.Flag("notify").Changed {
+		task.Notify = cmd.notify
+		ok = true
+	}
+	if cmd.Flag("notify-stale-after").Changed {
+		task.NotifyStaleAfter = cmd.notifyStaleAfter.String()
+		ok = true
+	}
 	return ok
 }
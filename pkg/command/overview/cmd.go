@@ -0,0 +1,51 @@
+// Copyright (C) 2024 ScyllaDB
+
+package overview
+
+import (
+	_ "embed"
+
+	"github.com/scylladb/scylla-manager/v3/pkg/command/flag"
+	"github.com/scylladb/scylla-manager/v3/pkg/managerclient"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v2"
+)
+
+//go:embed res.yaml
+var res []byte
+
+type command struct {
+	cobra.Command
+	client *managerclient.Client
+
+	label []string
+}
+
+func NewCommand(client *managerclient.Client) *cobra.Command {
+	cmd := &command{
+		client: client,
+	}
+	if err := yaml.Unmarshal(res, &cmd.Command); err != nil {
+		panic(err)
+	}
+	cmd.init()
+	cmd.RunE = func(_ *cobra.Command, args []string) error {
+		return cmd.run()
+	}
+	return &cmd.Command
+}
+
+func (cmd *command) init() {
+	defer flag.MustSetUsages(&cmd.Command, res)
+
+	w := flag.Wrap(cmd.Flags())
+	w.Unwrap().StringSliceVar(&cmd.label, "label", nil, "")
+}
+
+func (cmd *command) run() error {
+	overview, err := cmd.client.Overview(cmd.Context(), cmd.label)
+	if err != nil {
+		return err
+	}
+	return overview.Render(cmd.OutOrStdout())
+}
@@ -3,7 +3,11 @@
 package progress
 
 import (
+	"bytes"
 	_ "embed"
+	"encoding/json"
+	"io"
+	"os"
 	"strings"
 
 	"github.com/pkg/errors"
@@ -12,6 +16,7 @@ import (
 	"github.com/scylladb/scylla-manager/v3/pkg/managerclient"
 	"github.com/scylladb/scylla-manager/v3/pkg/util/inexlist"
 	"github.com/scylladb/scylla-manager/v3/pkg/util/uuid"
+	"github.com/scylladb/scylla-manager/v3/swagger/gen/scylla-manager/models"
 	"github.com/spf13/cobra"
 	"gopkg.in/yaml.v2"
 )
@@ -28,6 +33,7 @@ type command struct {
 	details  bool
 	host     []string
 	runID    string
+	follow   bool
 }
 
 func NewCommand(client *managerclient.Client) *cobra.Command {
@@ -61,6 +67,7 @@ func (cmd *command) init() {
 	w.Unwrap().BoolVar(&cmd.details, "details", false, "")
 	w.Unwrap().StringSliceVar(&cmd.host, "host", nil, "")
 	w.Unwrap().StringVar(&cmd.runID, "run", latest, "Show progress of a particular run, see sctool info to get the `IDs`.")
+	w.Unwrap().BoolVarP(&cmd.follow, "follow", "f", false, "")
 }
 
 var supportedTaskTypes = strset.New(
@@ -90,22 +97,69 @@ func (cmd *command) run(args []string) error {
 		}
 	}
 
-	switch taskType {
+	if cmd.follow {
+		return cmd.followProgress(task)
+	}
+	return cmd.render(cmd.OutOrStdout(), task, nil)
+}
+
+// followProgress renders progress every time it changes until the run is done.
+func (cmd *command) followProgress(t *managerclient.Task) error {
+	out := cmd.OutOrStdout()
+	clearScreen := isTerminal(out)
+
+	var buf bytes.Buffer
+	return cmd.client.FollowProgress(cmd.Context(), cmd.cluster, t.Type, t.ID, cmd.runID, 0, func(doc json.RawMessage) error {
+		buf.Reset()
+		if clearScreen {
+			// Move cursor home and clear screen
+			buf.WriteString("\033[H\033[2J")
+		}
+		if err := cmd.render(&buf, t, doc); err != nil {
+			return err
+		}
+		_, err := buf.WriteTo(out)
+		return err
+	})
+}
+
+func isTerminal(w io.Writer) bool {
+	f, ok := w.(*os.File)
+	if !ok {
+		return false
+	}
+	fi, err := f.Stat()
+	return err == nil && fi.Mode()&os.ModeCharDevice != 0
+}
+
+// render renders task progress, if doc is nil progress is fetched from
+// the server, otherwise it's decoded from doc.
+func (cmd *command) render(w io.Writer, t *managerclient.Task, doc json.RawMessage) error {
+	switch t.Type {
 	case managerclient.RepairTask:
-		return cmd.renderRepairProgress(task)
+		return cmd.renderRepairProgress(w, t, doc)
 	case managerclient.BackupTask:
-		return cmd.renderBackupProgress(task)
+		return cmd.renderBackupProgress(w, t, doc)
 	case managerclient.RestoreTask:
-		return cmd.renderRestoreProgress(task)
+		return cmd.renderRestoreProgress(w, t, doc)
 	case managerclient.ValidateBackupTask:
-		return cmd.renderValidateBackupProgress(task)
+		return cmd.renderValidateBackupProgress(w, t, doc)
 	}
 
 	return nil
 }
 
-func (cmd *command) renderRepairProgress(t *managerclient.Task) error {
-	p, err := cmd.client.RepairProgress(cmd.Context(), cmd.cluster, t.ID, cmd.runID)
+func (cmd *command) renderRepairProgress(w io.Writer, t *managerclient.Task, doc json.RawMessage) error {
+	var (
+		p   managerclient.RepairProgress
+		err error
+	)
+	if doc == nil {
+		p, err = cmd.client.RepairProgress(cmd.Context(), cmd.cluster, t.ID, cmd.runID)
+	} else {
+		p.TaskRunRepairProgress = new(models.TaskRunRepairProgress)
+		err = p.TaskRunRepairProgress.UnmarshalBinary(doc)
+	}
 	if err != nil {
 		return err
 	}
@@ -119,11 +173,23 @@ func (cmd *command) renderRepairProgress(t *managerclient.Task) error {
 	}
 	p.Task = t
 
-	return p.Render(cmd.OutOrStdout())
+	return p.Render(w)
 }
 
-func (cmd *command) renderBackupProgress(t *managerclient.Task) error {
-	p, err := cmd.client.BackupProgress(cmd.Context(), cmd.cluster, t.ID, cmd.runID)
+func (cmd *command) renderBackupProgress(w io.Writer, t *managerclient.Task, doc json.RawMessage) error {
+	var (
+		p   managerclient.BackupProgress
+		err error
+	)
+	if doc == nil {
+		p, err = cmd.client.BackupProgress(cmd.Context(), cmd.cluster, t.ID, cmd.runID)
+	} else {
+		p.TaskRunBackupProgress = new(models.TaskRunBackupProgress)
+		err = p.TaskRunBackupProgress.UnmarshalBinary(doc)
+		if p.Progress == nil {
+			p.Progress = &models.BackupProgress{Stage: "INIT"}
+		}
+	}
 	if err != nil {
 		return err
 	}
@@ -138,11 +204,20 @@ func (cmd *command) renderBackupProgress(t *managerclient.Task) error {
 	p.Task = t
 	p.AggregateErrors()
 
-	return p.Render(cmd.OutOrStdout())
+	return p.Render(w)
 }
 
-func (cmd *command) renderRestoreProgress(t *managerclient.Task) error {
-	p, err := cmd.client.RestoreProgress(cmd.Context(), cmd.cluster, t.ID, cmd.runID)
+func (cmd *command) renderRestoreProgress(w io.Writer, t *managerclient.Task, doc json.RawMessage) error {
+	var (
+		p   managerclient.RestoreProgress
+		err error
+	)
+	if doc == nil {
+		p, err = cmd.client.RestoreProgress(cmd.Context(), cmd.cluster, t.ID, cmd.runID)
+	} else {
+		p.TaskRunRestoreProgress = new(models.TaskRunRestoreProgress)
+		err = p.TaskRunRestoreProgress.UnmarshalBinary(doc)
+	}
 	if err != nil {
 		return err
 	}
@@ -153,11 +228,20 @@ func (cmd *command) renderRestoreProgress(t *managerclient.Task) error {
 	}
 	p.Task = t
 
-	return p.Render(cmd.OutOrStdout())
+	return p.Render(w)
 }
 
-func (cmd *command) renderValidateBackupProgress(t *managerclient.Task) error {
-	p, err := cmd.client.ValidateBackupProgress(cmd.Context(), cmd.cluster, t.ID, cmd.runID)
+func (cmd *command) renderValidateBackupProgress(w io.Writer, t *managerclient.Task, doc json.RawMessage) error {
+	var (
+		p   managerclient.ValidateBackupProgress
+		err error
+	)
+	if doc == nil {
+		p, err = cmd.client.ValidateBackupProgress(cmd.Context(), cmd.cluster, t.ID, cmd.runID)
+	} else {
+		p.TaskRunValidateBackupProgress = new(models.TaskRunValidateBackupProgress)
+		err = p.TaskRunValidateBackupProgress.UnmarshalBinary(doc)
+	}
 	if err != nil {
 		return err
 	}
@@ -168,5 +252,5 @@ func (cmd *command) renderValidateBackupProgress(t *managerclient.Task) error {
 	}
 	p.Task = t
 
-	return p.Render(cmd.OutOrStdout())
+	return p.Render(w)
 }
@@ -32,6 +32,9 @@ type command struct {
 	intensity           *flag.Intensity
 	parallel            int
 	smallTableThreshold managerclient.SizeSuffix
+	adaptive            bool
+	incremental         bool
+	fullEvery           int
 	dryRun              bool
 	showTables          bool
 }
@@ -84,6 +87,9 @@ func (cmd *command) init() {
 	w.Unwrap().Var(cmd.intensity, "intensity", "")
 	w.Unwrap().IntVar(&cmd.parallel, "parallel", 0, "")
 	w.Unwrap().Var(&cmd.smallTableThreshold, "small-table-threshold", "")
+	w.Unwrap().BoolVar(&cmd.adaptive, "adaptive", false, "")
+	w.Unwrap().BoolVar(&cmd.incremental, "incremental", false, "")
+	w.Unwrap().IntVar(&cmd.fullEvery, "full-every", 0, "")
 	w.Unwrap().BoolVar(&cmd.dryRun, "dry-run", false, "")
 	w.Unwrap().BoolVar(&cmd.showTables, "show-tables", false, "")
 }
@@ -151,6 +157,18 @@ func (cmd *command) run(args []string) error {
 		props["small_table_threshold"] = int64(cmd.smallTableThreshold)
 		ok = true
 	}
+	if cmd.Flag("adaptive").Changed {
+		props["adaptive"] = cmd.adaptive
+		ok = true
+	}
+	if cmd.Flag("incremental").Changed {
+		props["incremental"] = cmd.incremental
+		ok = true
+	}
+	if cmd.Flag("full-every").Changed {
+		props["full_every"] = cmd.fullEvery
+		ok = true
+	}
 
 	if cmd.dryRun {
 		res, err := cmd.client.GetRepairTarget(cmd.Context(), cmd.cluster, task)
@@ -27,6 +27,7 @@ type command struct {
 	location        []string
 	keyspace        []string
 	snapshotTag     string
+	pointInTime     flag.Time
 	batchSize       int
 	parallel        int
 	transfers       int
@@ -35,6 +36,9 @@ type command struct {
 	unpinAgentCPU   bool
 	restoreSchema   bool
 	restoreTables   bool
+	rename          []string
+	dcMapping       []string
+	tokenRanges     []string
 	dryRun          bool
 	showTables      bool
 }
@@ -80,6 +84,7 @@ func (cmd *command) init() {
 	w.Location(&cmd.location)
 	w.Keyspace(&cmd.keyspace)
 	w.Unwrap().StringVarP(&cmd.snapshotTag, "snapshot-tag", "T", "", "")
+	w.Unwrap().Var(&cmd.pointInTime, "point-in-time", "")
 	w.Unwrap().IntVar(&cmd.batchSize, "batch-size", 2, "")
 	w.Unwrap().IntVar(&cmd.parallel, "parallel", 0, "")
 	w.Unwrap().IntVar(&cmd.transfers, "transfers", 0, "")
@@ -88,6 +93,9 @@ func (cmd *command) init() {
 	w.Unwrap().BoolVar(&cmd.unpinAgentCPU, "unpin-agent-cpu", false, "")
 	w.Unwrap().BoolVar(&cmd.restoreSchema, "restore-schema", false, "")
 	w.Unwrap().BoolVar(&cmd.restoreTables, "restore-tables", false, "")
+	w.Unwrap().StringSliceVar(&cmd.rename, "rename", nil, "")
+	w.Unwrap().StringSliceVar(&cmd.dcMapping, "dc-mapping", nil, "")
+	w.Unwrap().StringSliceVar(&cmd.tokenRanges, "token-ranges", nil, "")
 	w.Unwrap().BoolVar(&cmd.dryRun, "dry-run", false, "")
 	w.Unwrap().BoolVar(&cmd.showTables, "show-tables", false, "")
 }
@@ -144,6 +152,13 @@ func (cmd *command) run(args []string) error {
 		props["snapshot_tag"] = cmd.snapshotTag
 		ok = true
 	}
+	if cmd.Flag("point-in-time").Changed {
+		if cmd.Update() {
+			return wrapper("point-in-time")
+		}
+		props["point_in_time"] = cmd.pointInTime.Value()
+		ok = true
+	}
 	if cmd.Flag("batch-size").Changed {
 		props["batch_size"] = cmd.batchSize
 		ok = true
@@ -182,9 +197,30 @@ func (cmd *command) run(args []string) error {
 		props["restore_tables"] = cmd.restoreTables
 		ok = true
 	}
+	if cmd.Flag("rename").Changed {
+		if cmd.Update() {
+			return wrapper("rename")
+		}
+		props["rename"] = cmd.rename
+		ok = true
+	}
+	if cmd.Flag("dc-mapping").Changed {
+		if cmd.Update() {
+			return wrapper("dc-mapping")
+		}
+		props["dc_mapping"] = cmd.dcMapping
+		ok = true
+	}
+	if cmd.Flag("token-ranges").Changed {
+		if cmd.Update() {
+			return wrapper("token-ranges")
+		}
+		props["token_ranges"] = cmd.tokenRanges
+		ok = true
+	}
 
 	if cmd.dryRun {
-		res, err := cmd.client.GetRestoreTarget(cmd.Context(), cmd.cluster, task)
+		res, err := cmd.client.GetRestorePlan(cmd.Context(), cmd.cluster, task)
 		if err != nil {
 			return err
 		}
@@ -0,0 +1,71 @@
+// Copyright (C) 2024 ScyllaDB
+
+package tokencreate
+
+import (
+	_ "embed"
+	"fmt"
+
+	"github.com/scylladb/scylla-manager/v3/pkg/command/flag"
+	"github.com/scylladb/scylla-manager/v3/pkg/managerclient"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v2"
+)
+
+//go:embed res.yaml
+var res []byte
+
+type command struct {
+	cobra.Command
+	client *managerclient.Client
+
+	name    string
+	role    string
+	cluster string
+}
+
+func NewCommand(client *managerclient.Client) *cobra.Command {
+	cmd := &command{
+		client: client,
+	}
+	if err := yaml.Unmarshal(res, &cmd.Command); err != nil {
+		panic(err)
+	}
+	cmd.init()
+	cmd.RunE = func(_ *cobra.Command, args []string) error {
+		return cmd.run()
+	}
+	return &cmd.Command
+}
+
+func (cmd *command) init() {
+	defer flag.MustSetUsages(&cmd.Command, res, "name", "role")
+
+	w := cmd.Flags()
+	w.StringVarP(&cmd.name, "name", "n", "", "")
+	w.StringVar(&cmd.role, "role", "", "")
+	w.StringVarP(&cmd.cluster, "cluster", "c", "", "")
+}
+
+func (cmd *command) run() error {
+	t := &managerclient.Token{
+		Name: cmd.name,
+		Role: cmd.role,
+	}
+	if cmd.cluster != "" {
+		c, err := cmd.client.GetCluster(cmd.Context(), cmd.cluster)
+		if err != nil {
+			return err
+		}
+		t.ClusterID = c.ID
+	}
+
+	t, err := cmd.client.CreateToken(cmd.Context(), t)
+	if err != nil {
+		return err
+	}
+
+	w := cmd.OutOrStdout()
+	fmt.Fprintln(w, t.Secret)
+	return nil
+}
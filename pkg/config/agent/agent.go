@@ -4,6 +4,7 @@ package agent
 
 import (
 	"strings"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/scylladb/scylla-manager/v3/pkg/config"
@@ -41,6 +42,105 @@ func (c ScyllaConfig) Validate() (errs error) {
 	return
 }
 
+// CommitlogArchiveConfig specifies continuous upload of archived commitlog
+// segments to backup location. Segments uploaded to the location can be used
+// for point-in-time restore.
+type CommitlogArchiveConfig struct {
+	// Location is a backup location in <provider>:<bucket> format,
+	// archiving is disabled if location is not set.
+	Location string `yaml:"location"`
+	// ClusterID is ID of the cluster in Scylla Manager.
+	ClusterID string `yaml:"cluster_id"`
+	// Directory is a directory where Scylla puts archived commitlog segments.
+	Directory string `yaml:"directory"`
+	// Interval specifies how often the directory is checked for new segments.
+	Interval time.Duration `yaml:"interval"`
+}
+
+// Enabled returns true if commitlog archiving is configured.
+func (c CommitlogArchiveConfig) Enabled() bool {
+	return c.Location != ""
+}
+
+func (c CommitlogArchiveConfig) Validate() (errs error) {
+	if !c.Enabled() {
+		return nil
+	}
+	if c.ClusterID == "" {
+		errs = multierr.Append(errs, errors.New("missing cluster_id"))
+	}
+	if c.Directory == "" {
+		errs = multierr.Append(errs, errors.New("missing directory"))
+	}
+	if c.Interval <= 0 {
+		errs = multierr.Append(errs, errors.New("interval must be greater than zero"))
+	}
+	return
+}
+
+// AdaptiveThrottlingConfig specifies lowering of bandwidth of transfers
+// when Scylla node exceeds load thresholds. Thresholds are checked against
+// Scylla metrics averaged over the interval.
+type AdaptiveThrottlingConfig struct {
+	Enabled bool `yaml:"enabled"`
+	// Interval specifies how often Scylla metrics are checked.
+	Interval time.Duration `yaml:"interval"`
+	// MaxCPUUtilization is the max average reactor utilization in percent.
+	MaxCPUUtilization float64 `yaml:"max_cpu_utilization"`
+	// MaxReadLatency is the max average coordinator read latency.
+	MaxReadLatency time.Duration `yaml:"max_read_latency"`
+	// MaxWriteLatency is the max average coordinator write latency.
+	MaxWriteLatency time.Duration `yaml:"max_write_latency"`
+	// MinBandwidth is the lowest bandwidth limit in MiB/s set by throttling.
+	MinBandwidth int `yaml:"min_bandwidth"`
+	// MaxBandwidth is the bandwidth limit in MiB/s from which throttling
+	// starts if transfers are not limited.
+	MaxBandwidth int `yaml:"max_bandwidth"`
+}
+
+func (c AdaptiveThrottlingConfig) Validate() (errs error) {
+	if !c.Enabled {
+		return nil
+	}
+	if c.Interval <= 0 {
+		errs = multierr.Append(errs, errors.New("interval must be greater than zero"))
+	}
+	if c.MaxCPUUtilization <= 0 && c.MaxReadLatency <= 0 && c.MaxWriteLatency <= 0 {
+		errs = multierr.Append(errs, errors.New("at least one threshold must be set"))
+	}
+	if c.MinBandwidth <= 0 {
+		errs = multierr.Append(errs, errors.New("min_bandwidth must be greater than zero"))
+	}
+	if c.MaxBandwidth < c.MinBandwidth {
+		errs = multierr.Append(errs, errors.New("max_bandwidth must be greater or equal to min_bandwidth"))
+	}
+	return
+}
+
+// ResumableUploadsConfig specifies persisting state of multipart uploads
+// so that uploads of large files interrupted by an error or agent restart
+// are resumed instead of started from scratch.
+type ResumableUploadsConfig struct {
+	Enabled bool `yaml:"enabled"`
+	// Directory is a directory where states of uploads are kept.
+	Directory string `yaml:"directory"`
+	// MaxAge specifies after how long unfinished uploads are aborted.
+	MaxAge time.Duration `yaml:"max_age"`
+}
+
+func (c ResumableUploadsConfig) Validate() (errs error) {
+	if !c.Enabled {
+		return nil
+	}
+	if c.Directory == "" {
+		errs = multierr.Append(errs, errors.New("missing directory"))
+	}
+	if c.MaxAge <= 0 {
+		errs = multierr.Append(errs, errors.New("max_age must be greater than zero"))
+	}
+	return
+}
+
 // Config specifies the agent and scylla configuration.
 type Config struct {
 	AuthToken   string               `yaml:"auth_token"`
@@ -58,6 +158,16 @@ type Config struct {
 	S3          rclone.S3Options     `yaml:"s3"`
 	GCS         rclone.GCSOptions    `yaml:"gcs"`
 	Azure       rclone.AzureOptions  `yaml:"azure"`
+	NFS         rclone.NFSOptions    `yaml:"nfs"`
+	SFTP        rclone.SFTPOptions   `yaml:"sftp"`
+
+	// BandwidthProfile is the upper bound of bandwidth used by transfers
+	// in the rclone --bwlimit timetable format e.g. "08:00,50M 18:00,off".
+	BandwidthProfile string `yaml:"bandwidth_profile"`
+
+	CommitlogArchive   CommitlogArchiveConfig   `yaml:"commitlog_archive"`
+	AdaptiveThrottling AdaptiveThrottlingConfig `yaml:"adaptive_throttling"`
+	ResumableUploads   ResumableUploadsConfig   `yaml:"resumable_uploads"`
 }
 
 func DefaultConfig() Config {
@@ -77,6 +187,23 @@ func DefaultConfig() Config {
 		S3:     rclone.DefaultS3Options(),
 		GCS:    rclone.DefaultGCSOptions(),
 		Azure:  rclone.DefaultAzureOptions(),
+		CommitlogArchive: CommitlogArchiveConfig{
+			Directory: "/var/lib/scylla/commitlog_archive",
+			Interval:  time.Minute,
+		},
+		AdaptiveThrottling: AdaptiveThrottlingConfig{
+			Interval:          15 * time.Second,
+			MaxCPUUtilization: 90,
+			MaxReadLatency:    50 * time.Millisecond,
+			MaxWriteLatency:   50 * time.Millisecond,
+			MinBandwidth:      10,
+			MaxBandwidth:      500,
+		},
+		ResumableUploads: ResumableUploadsConfig{
+			Enabled:   true,
+			Directory: "/var/lib/scylla-manager-agent/uploads",
+			MaxAge:    7 * 24 * time.Hour,
+		},
 	}
 }
 
@@ -96,6 +223,20 @@ func (c Config) Validate() (errs error) {
 	// Validate S3 config
 	errs = multierr.Append(errs, errors.Wrap(c.S3.Validate(), "s3"))
 
+	// Validate bandwidth profile
+	if _, err := rclone.ParseBandwidthProfile(c.BandwidthProfile); err != nil {
+		errs = multierr.Append(errs, errors.Wrap(err, "bandwidth_profile"))
+	}
+
+	// Validate commitlog archive config
+	errs = multierr.Append(errs, errors.Wrap(c.CommitlogArchive.Validate(), "commitlog_archive"))
+
+	// Validate adaptive throttling config
+	errs = multierr.Append(errs, errors.Wrap(c.AdaptiveThrottling.Validate(), "adaptive_throttling"))
+
+	// Validate resumable uploads config
+	errs = multierr.Append(errs, errors.Wrap(c.ResumableUploads.Validate(), "resumable_uploads"))
+
 	return
 }
 
@@ -115,6 +256,9 @@ func Obfuscate(c Config) Config {
 		&c.GCS.ClientSecret,
 		&c.GCS.ServiceAccountCredentials,
 		&c.Azure.Key,
+		&c.SFTP.Pass,
+		&c.SFTP.KeyPem,
+		&c.SFTP.KeyFilePass,
 	}
 	for _, s := range secrets {
 		*s = strings.Repeat("*", len(*s))
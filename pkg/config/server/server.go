@@ -14,6 +14,8 @@ import (
 	"github.com/scylladb/scylla-manager/v3/pkg/scyllaclient"
 	"github.com/scylladb/scylla-manager/v3/pkg/service/backup"
 	"github.com/scylladb/scylla-manager/v3/pkg/service/healthcheck"
+	"github.com/scylladb/scylla-manager/v3/pkg/service/leader"
+	"github.com/scylladb/scylla-manager/v3/pkg/service/notify"
 	"github.com/scylladb/scylla-manager/v3/pkg/service/repair"
 	"github.com/scylladb/scylla-manager/v3/pkg/util/cfgutil"
 )
@@ -53,6 +55,7 @@ type Config struct {
 	TLSCertFile        string                     `yaml:"tls_cert_file"`
 	TLSKeyFile         string                     `yaml:"tls_key_file"`
 	TLSCAFile          string                     `yaml:"tls_ca_file"`
+	AuthToken          string                     `yaml:"auth_token"`
 	Prometheus         string                     `yaml:"prometheus"`
 	Debug              string                     `yaml:"debug"`
 	ClientCacheTimeout time.Duration              `yaml:"client_cache_timeout"`
@@ -65,6 +68,8 @@ type Config struct {
 	Restore            restore.Config             `yaml:"restore"`
 	Repair             repair.Config              `yaml:"repair"`
 	TimeoutConfig      scyllaclient.TimeoutConfig `yaml:"agent_client"`
+	Notifications      notify.Config              `yaml:"notifications"`
+	HA                 leader.Config              `yaml:"ha"`
 }
 
 func DefaultConfig() Config {
@@ -93,6 +98,8 @@ func DefaultConfig() Config {
 		Repair:             repair.DefaultConfig(),
 		TimeoutConfig:      scyllaclient.DefaultTimeoutConfig(),
 		ConfigCache:        configcache.DefaultConfig(),
+		Notifications:      notify.DefaultConfig(),
+		HA:                 leader.DefaultConfig(),
 	}
 }
 
@@ -119,6 +126,12 @@ func (c Config) Validate() error {
 	if err := c.Repair.Validate(); err != nil {
 		return errors.Wrap(err, "repair")
 	}
+	if err := c.Notifications.Validate(); err != nil {
+		return errors.Wrap(err, "notifications")
+	}
+	if err := c.HA.Validate(); err != nil {
+		return errors.Wrap(err, "ha")
+	}
 
 	return nil
 }
@@ -130,6 +143,26 @@ func (c Config) HasTLSCert() bool {
 
 // Obfuscate returns Config with secrets replaced with ******.
 func Obfuscate(c Config) Config {
+	c.AuthToken = strings.Repeat("*", len(c.AuthToken))
 	c.Database.Password = strings.Repeat("*", len(c.Database.Password))
+
+	sinks := make([]notify.SinkConfig, len(c.Notifications.Sinks))
+	for i, s := range c.Notifications.Sinks {
+		s.SMTP.Password = strings.Repeat("*", len(s.SMTP.Password))
+		// Slack webhook URL contains secret
+		if s.Type == notify.SinkSlack {
+			s.URL = strings.Repeat("*", len(s.URL))
+		}
+		if len(s.Headers) > 0 {
+			h := make(map[string]string, len(s.Headers))
+			for k, v := range s.Headers {
+				h[k] = strings.Repeat("*", len(v))
+			}
+			s.Headers = h
+		}
+		sinks[i] = s
+	}
+	c.Notifications.Sinks = sinks
+
 	return c
 }
@@ -19,6 +19,8 @@ import (
 	"github.com/scylladb/scylla-manager/v3/pkg/scyllaclient"
 	"github.com/scylladb/scylla-manager/v3/pkg/service/backup"
 	"github.com/scylladb/scylla-manager/v3/pkg/service/healthcheck"
+	"github.com/scylladb/scylla-manager/v3/pkg/service/leader"
+	"github.com/scylladb/scylla-manager/v3/pkg/service/notify"
 	"github.com/scylladb/scylla-manager/v3/pkg/service/repair"
 	"github.com/scylladb/scylla-manager/v3/pkg/testutils"
 )
@@ -44,6 +46,7 @@ func TestConfigModification(t *testing.T) {
 		TLSCertFile:        "tls.cert",
 		TLSKeyFile:         "tls.key",
 		TLSCAFile:          "ca.cert",
+		AuthToken:          "token",
 		Prometheus:         "127.0.0.1:9090",
 		Debug:              "127.0.0.1:112",
 		ClientCacheTimeout: 15 * time.Minute,
@@ -81,6 +84,7 @@ func TestConfigModification(t *testing.T) {
 			DiskSpaceFreeMinPercent:   1,
 			LongPollingTimeoutSeconds: 5,
 			AgeMax:                    24 * time.Hour,
+			SLACheckInterval:          5 * time.Minute,
 		},
 		Restore: restore.Config{
 			DiskSpaceFreeMinPercent:   1,
@@ -114,6 +118,41 @@ func TestConfigModification(t *testing.T) {
 		ConfigCache: configcache.Config{
 			UpdateFrequency: 5 * time.Minute,
 		},
+		Notifications: notify.Config{
+			Timeout:            5 * time.Second,
+			Retries:            5,
+			RetryWait:          time.Second,
+			StaleAfter:         48 * time.Hour,
+			StaleCheckInterval: time.Minute,
+			Sinks: []notify.SinkConfig{
+				{
+					Name:     "ops",
+					Type:     notify.SinkWebhook,
+					AllTasks: true,
+					URL:      "https://example.com/hook",
+					Headers:  map[string]string{"Authorization": "Bearer token"},
+				},
+				{
+					Name:   "oncall",
+					Type:   notify.SinkEmail,
+					Events: []notify.EventKind{notify.EventError, notify.EventStale},
+					SMTP: notify.SMTPConfig{
+						Host:     "smtp.example.com",
+						Port:     587,
+						Username: "user",
+						Password: "password",
+						From:     "manager@example.com",
+						To:       []string{"oncall@example.com"},
+					},
+				},
+			},
+		},
+		HA: leader.Config{
+			Enabled:       true,
+			InstanceID:    "manager-1",
+			LeaseTTL:      20 * time.Second,
+			RenewInterval: 5 * time.Second,
+		},
 	}
 
 	if diff := cmp.Diff(c, golden, configCmpOpts); diff != "" {
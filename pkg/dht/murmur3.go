@@ -0,0 +1,101 @@
+// Copyright (C) 2024 ScyllaDB
+
+package dht
+
+import (
+	"encoding/binary"
+	"math"
+	"math/bits"
+)
+
+const (
+	murmur3C1 = 0x87c37b91114253d5
+	murmur3C2 = 0x4cf5ad432745937f
+)
+
+// Murmur3Token returns token of the serialized partition key as computed by
+// Murmur3Partitioner. It's the first half of 128-bit MurmurHash3 x64 hash
+// including the quirk of sign extending bytes of the key tail inherited
+// from Cassandra.
+func Murmur3Token(key []byte) int64 {
+	var (
+		h1, h2 uint64
+		n      = len(key) / 16
+	)
+
+	for i := 0; i < n; i++ {
+		k1 := binary.LittleEndian.Uint64(key[i*16:])
+		k2 := binary.LittleEndian.Uint64(key[i*16+8:])
+
+		k1 *= murmur3C1
+		k1 = bits.RotateLeft64(k1, 31)
+		k1 *= murmur3C2
+		h1 ^= k1
+
+		h1 = bits.RotateLeft64(h1, 27)
+		h1 += h2
+		h1 = h1*5 + 0x52dce729
+
+		k2 *= murmur3C2
+		k2 = bits.RotateLeft64(k2, 33)
+		k2 *= murmur3C1
+		h2 ^= k2
+
+		h2 = bits.RotateLeft64(h2, 31)
+		h2 += h1
+		h2 = h2*5 + 0x38495ab5
+	}
+
+	var (
+		tail   = key[n*16:]
+		k1, k2 uint64
+	)
+	// Bytes are sign extended
+	b := func(i int) uint64 {
+		return uint64(int64(int8(tail[i])))
+	}
+	for i := len(tail) - 1; i >= 8; i-- {
+		k2 ^= b(i) << (8 * (i - 8))
+	}
+	if len(tail) > 8 {
+		k2 *= murmur3C2
+		k2 = bits.RotateLeft64(k2, 33)
+		k2 *= murmur3C1
+		h2 ^= k2
+	}
+	for i := min(len(tail), 8) - 1; i >= 0; i-- {
+		k1 ^= b(i) << (8 * i)
+	}
+	if len(tail) > 0 {
+		k1 *= murmur3C1
+		k1 = bits.RotateLeft64(k1, 31)
+		k1 *= murmur3C2
+		h1 ^= k1
+	}
+
+	h1 ^= uint64(len(key))
+	h2 ^= uint64(len(key))
+
+	h1 += h2
+	h2 += h1
+
+	h1 = fmix64(h1)
+	h2 = fmix64(h2)
+
+	h1 += h2
+
+	t := int64(h1)
+	if t == math.MinInt64 {
+		return math.MaxInt64
+	}
+	return t
+}
+
+func fmix64(k uint64) uint64 {
+	k ^= k >> 33
+	k *= 0xff51afd7ed558ccd
+	k ^= k >> 33
+	k *= 0xc4ceb9fe1a85ec53
+	k ^= k >> 33
+	return k
+}
@@ -0,0 +1,63 @@
+// Copyright (C) 2024 ScyllaDB
+
+package dht
+
+import (
+	"encoding/binary"
+	"testing"
+)
+
+func TestMurmur3Token(t *testing.T) {
+	intKey := func(v int32) []byte {
+		b := make([]byte, 4)
+		binary.BigEndian.PutUint32(b, uint32(v))
+		return b
+	}
+
+	table := []struct {
+		Name  string
+		Key   []byte
+		Token int64
+	}{
+		{Name: "int 0", Key: intKey(0), Token: -3485513579396041028},
+		{Name: "int 1", Key: intKey(1), Token: -4069959284402364209},
+		{Name: "int 2", Key: intKey(2), Token: -3248873570005575792},
+	}
+
+	for i := range table {
+		test := table[i]
+		t.Run(test.Name, func(t *testing.T) {
+			if token := Murmur3Token(test.Key); token != test.Token {
+				t.Fatalf("Murmur3Token() = %d, expected %d", token, test.Token)
+			}
+		})
+	}
+}
+
+func TestTokenRangeOverlaps(t *testing.T) {
+	table := []struct {
+		Range    string
+		First    int64
+		Last     int64
+		Expected bool
+	}{
+		{Range: "0:100", First: -10, Last: 0, Expected: false},
+		{Range: "0:100", First: -10, Last: 1, Expected: true},
+		{Range: "0:100", First: 100, Last: 200, Expected: true},
+		{Range: "0:100", First: 101, Last: 200, Expected: false},
+		{Range: "0:100", First: 10, Last: 20, Expected: true},
+		{Range: "100:-100", First: -50, Last: 50, Expected: false},
+		{Range: "100:-100", First: -200, Last: -150, Expected: true},
+		{Range: "100:-100", First: 150, Last: 200, Expected: true},
+	}
+
+	for _, test := range table {
+		tr, err := ParseTokenRange(test.Range)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := tr.Overlaps(test.First, test.Last); got != test.Expected {
+			t.Errorf("%s Overlaps(%d, %d) = %v, expected %v", tr, test.First, test.Last, got, test.Expected)
+		}
+	}
+}
@@ -0,0 +1,75 @@
+// Copyright (C) 2024 ScyllaDB
+
+package dht
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// TokenRange is a range of tokens (StartToken, EndToken].
+// Range with StartToken greater or equal to EndToken wraps around the ring.
+type TokenRange struct {
+	StartToken int64
+	EndToken   int64
+}
+
+// ParseTokenRange parses token range in format '<start>:<end>'.
+func ParseTokenRange(s string) (TokenRange, error) {
+	start, end, ok := strings.Cut(s, ":")
+	if !ok {
+		return TokenRange{}, errors.Errorf("invalid token range %q, expected format is '<start>:<end>'", s)
+	}
+	st, err := strconv.ParseInt(strings.TrimSpace(start), 10, 64)
+	if err != nil {
+		return TokenRange{}, errors.Wrapf(err, "invalid token range %q start token", s)
+	}
+	et, err := strconv.ParseInt(strings.TrimSpace(end), 10, 64)
+	if err != nil {
+		return TokenRange{}, errors.Wrapf(err, "invalid token range %q end token", s)
+	}
+	return TokenRange{StartToken: st, EndToken: et}, nil
+}
+
+func (tr TokenRange) String() string {
+	return fmt.Sprintf("%d:%d", tr.StartToken, tr.EndToken)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (tr TokenRange) MarshalText() ([]byte, error) {
+	return []byte(tr.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (tr *TokenRange) UnmarshalText(text []byte) error {
+	v, err := ParseTokenRange(string(text))
+	if err != nil {
+		return err
+	}
+	*tr = v
+	return nil
+}
+
+// Wraps returns true if range wraps around the ring.
+func (tr TokenRange) Wraps() bool {
+	return tr.StartToken >= tr.EndToken
+}
+
+// Contains returns true if token belongs to the range.
+func (tr TokenRange) Contains(t int64) bool {
+	if tr.Wraps() {
+		return t > tr.StartToken || t <= tr.EndToken
+	}
+	return t > tr.StartToken && t <= tr.EndToken
+}
+
+// Overlaps returns true if any token from [first, last] belongs to the range.
+func (tr TokenRange) Overlaps(first, last int64) bool {
+	if tr.Wraps() {
+		return last > tr.StartToken || first <= tr.EndToken
+	}
+	return first <= tr.EndToken && last > tr.StartToken
+}
@@ -16,6 +16,7 @@ import (
 	"github.com/rclone/rclone/fs/operations"
 	"github.com/rclone/rclone/fs/sync"
 	"github.com/scylladb/go-log"
+	"github.com/scylladb/scylla-manager/v3/pkg/rclone/compress"
 	backup "github.com/scylladb/scylla-manager/v3/pkg/service/backup/backupspec"
 	"github.com/scylladb/scylla-manager/v3/pkg/util/inexlist/ksfilter"
 	"github.com/scylladb/scylla-manager/v3/pkg/util/parallel"
@@ -35,6 +36,10 @@ type Downloader struct {
 
 	fsrc fs.Fs
 	fdst fs.Fs
+
+	// fcrypt is fsrc decrypting encrypted files, it's set if encryption key is provided.
+	fcrypt          fs.Fs
+	encryptionKeyID string
 }
 
 func New(l backup.Location, dataDir string, logger log.Logger, opts ...Option) (*Downloader, error) {
@@ -103,6 +108,11 @@ func (d *Downloader) download(ctx context.Context, m backup.ManifestInfoWithCont
 		return errors.New("empty manifest")
 	}
 
+	fsrc, err := d.sourceFs(ctx, m)
+	if err != nil {
+		return err
+	}
+
 	// Check if the current user is the data directory owner.
 	dir := d.fdst.Root()
 	o, err := dirOwner(dir)
@@ -161,7 +171,7 @@ func (d *Downloader) download(ctx context.Context, m backup.ManifestInfoWithCont
 			return nil
 		}
 
-		if err := d.downloadFiles(ctx, m, u); err != nil {
+		if err := d.downloadFiles(ctx, fsrc, m, u); err != nil {
 			return errors.Wrapf(err, "download table %s.%s", u.Keyspace, u.Table)
 		}
 
@@ -274,7 +284,37 @@ func (d *Downloader) clearTableIfNeeded(ctx context.Context, u backup.FilesMeta)
 	return nil
 }
 
-func (d *Downloader) downloadFiles(ctx context.Context, m backup.ManifestInfoWithContent, u backup.FilesMeta) error {
+// sourceFs returns Fs for downloading files of the backup described by manifest.
+// Files are decrypted only if the backup is encrypted, sizes of plain files
+// might be mistaken for sizes of encrypted ones. Files are decompressed only
+// if the backup is compressed.
+func (d *Downloader) sourceFs(ctx context.Context, m backup.ManifestInfoWithContent) (fs.Fs, error) {
+	fsrc := d.fsrc
+	if m.EncryptionKeyID != "" {
+		if d.fcrypt == nil {
+			return nil, errors.Errorf("backup is encrypted with key %s, provide the encryption key", m.EncryptionKeyID)
+		}
+		if d.encryptionKeyID != m.EncryptionKeyID {
+			return nil, errors.Errorf("backup is encrypted with key %s but key %s was provided", m.EncryptionKeyID, d.encryptionKeyID)
+		}
+		fsrc = d.fcrypt
+	}
+	if m.Compression != "" {
+		fsrc = compress.NewFs(ctx, fsrc, nil)
+	}
+	return fsrc, nil
+}
+
+// metaFs returns Fs for reading manifests, encrypted manifests are detected
+// and decrypted if encryption key is provided.
+func (d *Downloader) metaFs() fs.Fs {
+	if d.fcrypt != nil {
+		return d.fcrypt
+	}
+	return d.fsrc
+}
+
+func (d *Downloader) downloadFiles(ctx context.Context, fsrc fs.Fs, m backup.ManifestInfoWithContent, u backup.FilesMeta) error {
 	d.logger.Info(ctx, "Downloading",
 		"keyspace", u.Keyspace,
 		"table", u.Table,
@@ -292,7 +332,14 @@ func (d *Downloader) downloadFiles(ctx context.Context, m backup.ManifestInfoWit
 		return nil
 	}
 
-	return sync.CopyPaths(ctx, d.fdst, d.dstDir(u), d.fsrc, m.SSTableVersionDir(u.Keyspace, u.Table, u.Version), u.Files, false)
+	// Compressed files can only be read sequentially
+	if m.Compression != "" {
+		var ci *fs.ConfigInfo
+		ctx, ci = fs.AddConfig(ctx)
+		ci.MultiThreadStreams = 0
+	}
+
+	return sync.CopyPaths(ctx, d.fdst, d.dstDir(u), fsrc, m.SSTableVersionDir(u.Keyspace, u.Table, u.Version), u.Files, false)
 }
 
 func (d *Downloader) dstDir(u backup.FilesMeta) (dir string) {
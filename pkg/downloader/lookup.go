@@ -234,7 +234,7 @@ func (d *Downloader) ListNodes(ctx context.Context) (NodeInfoSlice, error) {
 
 func (d *Downloader) forEachMetaDirObject(ctx context.Context, fn func(o fs.Object) error) error {
 	baseDir := path.Join("backup", string(backup.MetaDirKind))
-	return walk.ListR(ctx, d.fsrc, baseDir, true, backup.RemoteManifestLevel(baseDir)+1, walk.ListObjects, func(e fs.DirEntries) error { return e.ForObjectError(fn) })
+	return walk.ListR(ctx, d.metaFs(), baseDir, true, backup.RemoteManifestLevel(baseDir)+1, walk.ListObjects, func(e fs.DirEntries) error { return e.ForObjectError(fn) })
 }
 
 func readManifestContentFromObject(ctx context.Context, o fs.Object, c *backup.ManifestContentWithIndex) error {
@@ -3,7 +3,10 @@
 package downloader
 
 import (
+	"context"
+
 	"github.com/pkg/errors"
+	"github.com/scylladb/scylla-manager/v3/pkg/rclone/crypt"
 	"github.com/scylladb/scylla-manager/v3/pkg/util/inexlist/ksfilter"
 )
 
@@ -37,3 +40,12 @@ func WithTableDirMode(mode TableDirMode) Option {
 		return nil
 	}
 }
+
+// WithEncryptionKey sets the key used for decryption of encrypted backups.
+func WithEncryptionKey(key crypt.Key) Option {
+	return func(d *Downloader) error {
+		d.fcrypt = crypt.NewFs(context.Background(), d.fsrc, key)
+		d.encryptionKeyID = key.ID()
+		return nil
+	}
+}
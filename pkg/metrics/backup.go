@@ -3,6 +3,8 @@
 package metrics
 
 import (
+	"time"
+
 	"github.com/prometheus/client_golang/prometheus"
 
 	"github.com/scylladb/scylla-manager/v3/pkg/util/uuid"
@@ -16,6 +18,8 @@ type BackupMetrics struct {
 	filesFailedBytes   *prometheus.GaugeVec
 	purgeFiles         *prometheus.GaugeVec
 	purgeDeletedFiles  *prometheus.GaugeVec
+	slaCompliant       *prometheus.GaugeVec
+	slaRPOSeconds      *prometheus.GaugeVec
 }
 
 func NewBackupMetrics() BackupMetrics {
@@ -36,6 +40,10 @@ func NewBackupMetrics() BackupMetrics {
 			"purge_files", "cluster", "host"),
 		purgeDeletedFiles: g("Number of files that were deleted.",
 			"purge_deleted_files", "cluster", "host"),
+		slaCompliant: g("Indicates if keyspace has a complete backup in location within SLA.",
+			"sla_compliant", "cluster", "keyspace", "location"),
+		slaRPOSeconds: g("Age of the newest complete backup of keyspace in location in seconds.",
+			"sla_rpo_seconds", "cluster", "keyspace", "location"),
 	}
 }
 
@@ -54,6 +62,8 @@ func (m BackupMetrics) all() []prometheus.Collector {
 		m.filesFailedBytes,
 		m.purgeFiles,
 		m.purgeDeletedFiles,
+		m.slaCompliant,
+		m.slaRPOSeconds,
 	}
 }
 
@@ -97,3 +107,30 @@ func (m BackupMetrics) SetPurgeFiles(clusterID uuid.UUID, host string, total, de
 	m.purgeFiles.WithLabelValues(clusterID.String(), host).Set(float64(total))
 	m.purgeDeletedFiles.WithLabelValues(clusterID.String(), host).Set(float64(deleted))
 }
+
+// SetSLA updates backup "sla_compliant" and "sla_rpo_seconds" metrics.
+// Negative rpo means there is no complete backup.
+func (m BackupMetrics) SetSLA(clusterID uuid.UUID, keyspace, location string, compliant bool, rpo time.Duration) {
+	l := prometheus.Labels{
+		"cluster":  clusterID.String(),
+		"keyspace": keyspace,
+		"location": location,
+	}
+	v := 0.
+	if compliant {
+		v = 1
+	}
+	m.slaCompliant.With(l).Set(v)
+	if rpo < 0 {
+		m.slaRPOSeconds.With(l).Set(unspecifiedValue)
+	} else {
+		m.slaRPOSeconds.With(l).Set(rpo.Seconds())
+	}
+}
+
+// ResetSLA removes SLA metrics of the cluster, it's used when policy changes.
+func (m BackupMetrics) ResetSLA(clusterID uuid.UUID) {
+	l := prometheus.Labels{"cluster": clusterID.String()}
+	m.slaCompliant.DeletePartialMatch(l)
+	m.slaRPOSeconds.DeletePartialMatch(l)
+}
@@ -3,6 +3,8 @@
 package metrics
 
 import (
+	"time"
+
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/scylladb/scylla-manager/v3/pkg/util/uuid"
 )
@@ -14,6 +16,7 @@ type RepairMetrics struct {
 	tokenRangesError    *prometheus.GaugeVec
 	inFlightJobs        *prometheus.GaugeVec
 	inFlightTokenRanges *prometheus.GaugeVec
+	tombstoneGCDeadline *prometheus.GaugeVec
 }
 
 func NewRepairMetrics() RepairMetrics {
@@ -31,6 +34,8 @@ func NewRepairMetrics() RepairMetrics {
 			"inflight_jobs", "cluster", "host"),
 		inFlightTokenRanges: g("Number of token ranges that are being repaired.",
 			"inflight_token_ranges", "cluster", "host"),
+		tombstoneGCDeadline: g("Unix time after which tombstones of not repaired table can be garbage collected, 0 if table has no known repair.",
+			"tombstone_gc_deadline_timestamp_seconds", "cluster", "keyspace", "table"),
 	}
 }
 
@@ -42,6 +47,7 @@ func (m RepairMetrics) all() []prometheus.Collector {
 		m.tokenRangesError,
 		m.inFlightJobs,
 		m.inFlightTokenRanges,
+		m.tombstoneGCDeadline,
 	}
 }
 
@@ -106,3 +112,18 @@ func (m RepairMetrics) AddProgress(clusterID uuid.UUID, delta float64) {
 	}
 	m.progress.With(l).Add(delta)
 }
+
+// SetTombstoneGCDeadline sets "tombstone_gc_deadline_timestamp_seconds" metric,
+// zero deadline is exported as 0.
+func (m RepairMetrics) SetTombstoneGCDeadline(clusterID uuid.UUID, keyspace, table string, deadline time.Time) {
+	l := prometheus.Labels{
+		"cluster":  clusterID.String(),
+		"keyspace": keyspace,
+		"table":    table,
+	}
+	var v float64
+	if !deadline.IsZero() {
+		v = float64(deadline.Unix())
+	}
+	m.tombstoneGCDeadline.With(l).Set(v)
+}
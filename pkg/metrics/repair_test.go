@@ -4,6 +4,7 @@ package metrics
 
 import (
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/scylladb/scylla-manager/v3/pkg/testutils"
@@ -39,4 +40,17 @@ func TestRepairMetrics(t *testing.T) {
 			t.Error(diff)
 		}
 	})
+
+	t.Run("SetTombstoneGCDeadline", func(t *testing.T) {
+		m.SetTombstoneGCDeadline(c, "k", "t1", time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+		m.SetTombstoneGCDeadline(c, "k", "t2", time.Time{})
+
+		text := Dump(t, m.tombstoneGCDeadline)
+
+		testutils.SaveGoldenTextFileIfNeeded(t, text)
+		golden := testutils.LoadGoldenTextFile(t)
+		if diff := cmp.Diff(text, golden); diff != "" {
+			t.Error(diff)
+		}
+	})
 }
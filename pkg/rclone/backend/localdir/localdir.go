@@ -22,7 +22,13 @@ const (
 
 // Init registers new data provider with rclone.
 func Init(name, description, rootDir string) {
-	fsi := &fs.RegInfo{
+	fs.Register(RegInfo(name, description, rootDir))
+}
+
+// RegInfo returns registration information of data provider rooted at
+// rootDir, it can be used by backends extending localdir.
+func RegInfo(name, description, rootDir string) *fs.RegInfo {
+	return &fs.RegInfo{
 		Name:        name,
 		Description: description,
 		NewFs:       NewFs(rootDir),
@@ -104,8 +110,6 @@ to override the default choice.`,
 			Advanced: true,
 		}},
 	}
-
-	fs.Register(fsi)
 }
 
 func NewFs(rootDir string) func(ctx context.Context, name, root string, m configmap.Mapper) (fs.Fs, error) {
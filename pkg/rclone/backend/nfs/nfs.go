@@ -0,0 +1,75 @@
+// Copyright (C) 2024 ScyllaDB
+
+// Package nfs is rclone backend for network file system mounted on the node.
+// It's based on localdir backend, the difference is that it does not report
+// itself as local so that it's treated as remote storage. Data can be synced
+// to it from local directories and backup data is encrypted and compressed.
+package nfs
+
+import (
+	"context"
+
+	"github.com/rclone/rclone/fs"
+	"github.com/rclone/rclone/fs/config/configmap"
+	"github.com/scylladb/scylla-manager/v3/pkg/rclone/backend/localdir"
+)
+
+// Init registers new data provider rooted at the NFS mount point with rclone.
+func Init(name, description, rootDir string) {
+	ri := localdir.RegInfo(name, description, rootDir)
+	newFs := ri.NewFs
+	ri.NewFs = func(ctx context.Context, name, root string, m configmap.Mapper) (fs.Fs, error) {
+		f, err := newFs(ctx, name, root, m)
+		// Fs is returned together with fs.ErrorIsFile if root points to a file.
+		if f == nil {
+			return nil, err
+		}
+		return newFsWrapper(f), err
+	}
+	fs.Register(ri)
+}
+
+// Fs wraps localdir Fs so that it does not report itself as local.
+type Fs struct {
+	fs.Fs
+	features *fs.Features
+}
+
+var (
+	_ fs.Fs        = &Fs{}
+	_ fs.DirMover  = &Fs{}
+	_ fs.UnWrapper = &Fs{}
+)
+
+func newFsWrapper(f fs.Fs) *Fs {
+	w := &Fs{Fs: f}
+	w.features = f.Features().Wrap(w)
+	w.features.IsLocal = false
+	if w.features.DirMove != nil {
+		w.features.DirMove = w.DirMove
+	}
+	return w
+}
+
+// Features returns the optional features of this Fs.
+func (f *Fs) Features() *fs.Features {
+	return f.features
+}
+
+// UnWrap returns the wrapped Fs.
+func (f *Fs) UnWrap() fs.Fs {
+	return f.Fs
+}
+
+// DirMove moves src, srcRemote to this remote at dstRemote using
+// server-side move operations.
+func (f *Fs) DirMove(ctx context.Context, src fs.Fs, srcRemote, dstRemote string) error {
+	do := f.Fs.Features().DirMove
+	if do == nil {
+		return fs.ErrorCantDirMove
+	}
+	if sf, ok := src.(*Fs); ok {
+		src = sf.Fs
+	}
+	return do(ctx, src, srcRemote, dstRemote)
+}
@@ -0,0 +1,121 @@
+// Copyright (C) 2024 ScyllaDB
+
+package rclone
+
+import (
+	"context"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"path"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+	"github.com/rclone/rclone/fs"
+	"github.com/rclone/rclone/fs/accounting"
+	"github.com/scylladb/scylla-manager/v3/pkg/util/parallel"
+)
+
+const (
+	sstableDataSuffix   = "-Data.db"
+	sstableDigestSuffix = "-Digest.crc32"
+)
+
+// Checksums returns CRC32 (IEEE) checksums of content of paths in remote
+// directory of f, checksums are hex encoded. If digest is true checksums of
+// SSTable Data components are read from their Digest.crc32 components,
+// which are written by Scylla, rather than computed.
+func Checksums(ctx context.Context, f fs.Fs, remote string, paths []string, digest bool) (map[string]string, error) {
+	var (
+		out = make(map[string]string, len(paths))
+		mu  sync.Mutex
+	)
+
+	sum := func(i int) error {
+		p := path.Join(remote, paths[i])
+
+		var (
+			v   string
+			err error
+		)
+		if digest && strings.HasSuffix(p, sstableDataSuffix) {
+			v, err = readDigest(ctx, f, strings.TrimSuffix(p, sstableDataSuffix)+sstableDigestSuffix)
+		}
+		if err == nil && v == "" {
+			v, err = checksum(ctx, f, p)
+		}
+		if err != nil {
+			return errors.Wrapf(err, "checksum of %s", paths[i])
+		}
+
+		mu.Lock()
+		out[paths[i]] = v
+		mu.Unlock()
+		return nil
+	}
+
+	if err := parallel.Run(len(paths), fs.GetConfig(ctx).Checkers, sum, nil); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// checksum reads object as a transfer so that reads are subject to bandwidth
+// limit and reported in stats.
+func checksum(ctx context.Context, f fs.Fs, remote string) (v string, err error) {
+	o, err := f.NewObject(ctx, remote)
+	if err != nil {
+		return "", err
+	}
+	tr := accounting.Stats(ctx).NewTransfer(o)
+	defer func() {
+		tr.Done(ctx, err)
+	}()
+
+	r, err := o.Open(ctx)
+	if err != nil {
+		return "", err
+	}
+	in := tr.Account(ctx, r)
+	defer in.Close()
+
+	h := crc32.NewIEEE()
+	if _, err := io.Copy(h, in); err != nil {
+		return "", err
+	}
+	return FormatChecksum(h.Sum32()), nil
+}
+
+// readDigest returns checksum from SSTable Digest.crc32 component, it returns
+// empty string if the component does not exist.
+func readDigest(ctx context.Context, f fs.Fs, remote string) (string, error) {
+	o, err := f.NewObject(ctx, remote)
+	if errors.Is(err, fs.ErrorObjectNotFound) {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	r, err := o.Open(ctx)
+	if err != nil {
+		return "", err
+	}
+	defer r.Close()
+
+	b, err := io.ReadAll(io.LimitReader(r, 32))
+	if err != nil {
+		return "", err
+	}
+	v, err := strconv.ParseUint(strings.TrimSpace(string(b)), 10, 32)
+	if err != nil {
+		return "", errors.Wrapf(err, "parse %s", path.Base(remote))
+	}
+	return FormatChecksum(uint32(v)), nil
+}
+
+// FormatChecksum returns hex encoded CRC32 checksum as stored in manifests.
+func FormatChecksum(v uint32) string {
+	return fmt.Sprintf("%08x", v)
+}
@@ -0,0 +1,81 @@
+// Copyright (C) 2024 ScyllaDB
+
+package rclone
+
+import (
+	"context"
+	"fmt"
+	"hash/crc32"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/rclone/rclone/backend/local"
+	"github.com/rclone/rclone/fs/config/configmap"
+)
+
+func TestChecksums(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+
+	files := map[string]string{
+		"me-1-big-Data.db":       "data 1",
+		"me-1-big-Digest.crc32":  fmt.Sprint(crc32.ChecksumIEEE([]byte("other data 1"))) + "\n",
+		"me-1-big-Index.db":      "index 1",
+		"me-2-big-Data.db":       "data 2",
+		"me-2-big-Statistics.db": "",
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	f, err := local.NewFs(ctx, "local", dir, configmap.Simple{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	paths := []string{"me-1-big-Data.db", "me-1-big-Index.db", "me-2-big-Data.db", "me-2-big-Statistics.db"}
+	crc := func(s string) string {
+		return FormatChecksum(crc32.ChecksumIEEE([]byte(s)))
+	}
+
+	t.Run("computed", func(t *testing.T) {
+		got, err := Checksums(ctx, f, "", paths, false)
+		if err != nil {
+			t.Fatal(err)
+		}
+		golden := map[string]string{
+			"me-1-big-Data.db":       crc("data 1"),
+			"me-1-big-Index.db":      crc("index 1"),
+			"me-2-big-Data.db":       crc("data 2"),
+			"me-2-big-Statistics.db": "00000000",
+		}
+		if diff := cmp.Diff(golden, got); diff != "" {
+			t.Fatalf("Checksums() diff\n%s", diff)
+		}
+	})
+
+	t.Run("digest", func(t *testing.T) {
+		got, err := Checksums(ctx, f, "", paths, true)
+		if err != nil {
+			t.Fatal(err)
+		}
+		golden := map[string]string{
+			"me-1-big-Data.db":       crc("other data 1"),
+			"me-1-big-Index.db":      crc("index 1"),
+			"me-2-big-Data.db":       crc("data 2"),
+			"me-2-big-Statistics.db": "00000000",
+		}
+		if diff := cmp.Diff(golden, got); diff != "" {
+			t.Fatalf("Checksums() diff\n%s", diff)
+		}
+	})
+
+	t.Run("missing", func(t *testing.T) {
+		if _, err := Checksums(ctx, f, "", []string{"me-3-big-Data.db"}, true); err == nil {
+			t.Fatal("Checksums() expected error")
+		}
+	})
+}
@@ -0,0 +1,249 @@
+// Copyright (C) 2024 ScyllaDB
+
+// Package compress implements compression of backup objects.
+//
+// Compressed objects start with a header holding the codec and the size
+// of the original data, objects without the header are read as is.
+// That allows for mixing compressed and not compressed objects in a single
+// backup location.
+package compress
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/klauspost/compress/s2"
+	"github.com/klauspost/compress/zstd"
+	"github.com/pierrec/lz4/v4"
+	"github.com/pkg/errors"
+)
+
+const (
+	magic = "SMCOMPR\x01"
+
+	// HeaderSize is the size of the header of a compressed object.
+	HeaderSize = len(magic) + 1 + 8
+)
+
+var errTruncated = errors.New("compressed object is truncated")
+
+// Codec compresses and decompresses data streams.
+type Codec interface {
+	// Name is the name of the codec used in configuration and manifests.
+	Name() string
+	// NewWriter returns a writer compressing data to w.
+	NewWriter(w io.Writer) (io.WriteCloser, error)
+	// NewReader returns a reader decompressing data from r.
+	NewReader(r io.Reader) (io.ReadCloser, error)
+
+	id() byte
+}
+
+var codecs = map[string]Codec{
+	"zstd": zstdCodec{},
+	"s2":   s2Codec{},
+	"lz4":  lz4Codec{},
+}
+
+// Codecs returns names of the supported codecs.
+func Codecs() []string {
+	var names []string
+	for name := range codecs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// ParseCodec returns codec of the given name.
+func ParseCodec(name string) (Codec, error) {
+	c, ok := codecs[name]
+	if !ok {
+		return nil, errors.Errorf("unsupported compression %q, supported are %s", name, strings.Join(Codecs(), ", "))
+	}
+	return c, nil
+}
+
+func codecByID(id byte) (Codec, error) {
+	for _, c := range codecs {
+		if c.id() == id {
+			return c, nil
+		}
+	}
+	return nil, errors.Errorf("unsupported compression codec id %d", id)
+}
+
+type zstdCodec struct{}
+
+func (zstdCodec) Name() string {
+	return "zstd"
+}
+
+func (zstdCodec) NewWriter(w io.Writer) (io.WriteCloser, error) {
+	return zstd.NewWriter(w, zstd.WithEncoderConcurrency(1))
+}
+
+func (zstdCodec) NewReader(r io.Reader) (io.ReadCloser, error) {
+	d, err := zstd.NewReader(r, zstd.WithDecoderConcurrency(1))
+	if err != nil {
+		return nil, err
+	}
+	return d.IOReadCloser(), nil
+}
+
+func (zstdCodec) id() byte {
+	return 1
+}
+
+type s2Codec struct{}
+
+func (s2Codec) Name() string {
+	return "s2"
+}
+
+func (s2Codec) NewWriter(w io.Writer) (io.WriteCloser, error) {
+	return s2.NewWriter(w, s2.WriterConcurrency(1)), nil
+}
+
+func (s2Codec) NewReader(r io.Reader) (io.ReadCloser, error) {
+	return io.NopCloser(s2.NewReader(r)), nil
+}
+
+func (s2Codec) id() byte {
+	return 2
+}
+
+type lz4Codec struct{}
+
+func (lz4Codec) Name() string {
+	return "lz4"
+}
+
+func (lz4Codec) NewWriter(w io.Writer) (io.WriteCloser, error) {
+	lw := lz4.NewWriter(w)
+	if err := lw.Apply(lz4.ConcurrencyOption(1)); err != nil {
+		return nil, err
+	}
+	// Frame header is written lazily, write it upfront so that
+	// compressed empty data is a valid frame.
+	if _, err := lw.Write(nil); err != nil {
+		return nil, err
+	}
+	return lw, nil
+}
+
+func (lz4Codec) NewReader(r io.Reader) (io.ReadCloser, error) {
+	return io.NopCloser(lz4.NewReader(r)), nil
+}
+
+func (lz4Codec) id() byte {
+	return 3
+}
+
+// Compressible returns true if name is an SSTable component that is not
+// compressed by Scylla and thus worth compressing. Data.db is compressible
+// only if table is not compressed i.e. there is no CompressionInfo.db
+// component, that needs to be checked by the caller.
+// Names with snapshot tag suffix of versioned files are also matched.
+func Compressible(name string) bool {
+	if i := strings.LastIndex(name, ".sm_"); i > 0 {
+		name = name[:i]
+	}
+	for _, c := range []string{"-Data.db", "-Index.db", "-Summary.db"} {
+		if strings.HasSuffix(name, c) {
+			return true
+		}
+	}
+	return false
+}
+
+// IsCompressed returns true if b starts with compressed object header.
+func IsCompressed(b []byte) bool {
+	return bytes.HasPrefix(b, []byte(magic))
+}
+
+// header returns header of object compressed with c holding size bytes of data.
+func header(c Codec, size int64) []byte {
+	h := make([]byte, 0, HeaderSize)
+	h = append(h, magic...)
+	h = append(h, c.id())
+	return binary.BigEndian.AppendUint64(h, uint64(size))
+}
+
+// parseHeader returns codec and size of data of compressed object.
+func parseHeader(h []byte) (Codec, int64, error) {
+	if len(h) < HeaderSize {
+		return nil, 0, errTruncated
+	}
+	c, err := codecByID(h[len(magic)])
+	if err != nil {
+		return nil, 0, err
+	}
+	return c, int64(binary.BigEndian.Uint64(h[len(magic)+1:])), nil
+}
+
+// NewCompressor returns a reader of compressed content of r, size is
+// the size of data in r or -1 if it's not known.
+// Compression runs in background, the reader must be closed to release
+// resources when it's not read till the end.
+func NewCompressor(c Codec, r io.Reader, size int64) io.ReadCloser {
+	pr, pw := io.Pipe()
+	go func() {
+		pw.CloseWithError(compress(c, pw, r, size))
+	}()
+	return pr
+}
+
+// writeChunkSize is the size of chunks of data written to codec writers.
+const writeChunkSize = 1024 * 1024
+
+func compress(c Codec, w io.Writer, r io.Reader, size int64) error {
+	if _, err := w.Write(header(c, size)); err != nil {
+		return err
+	}
+	cw, err := c.NewWriter(w)
+	if err != nil {
+		return err
+	}
+	// Data is written in chunks of the same size so that compressed content
+	// does not depend on how r is read, that allows for resuming uploads.
+	buf := make([]byte, writeChunkSize)
+	for {
+		n, err := io.ReadFull(r, buf)
+		if n > 0 {
+			if _, err := cw.Write(buf[:n]); err != nil {
+				cw.Close()
+				return err
+			}
+		}
+		if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+			break
+		}
+		if err != nil {
+			cw.Close()
+			return err
+		}
+	}
+	return cw.Close()
+}
+
+// NewDecompressor reads header of compressed content of r and returns
+// a reader of decompressed content.
+func NewDecompressor(r io.Reader) (io.ReadCloser, error) {
+	h := make([]byte, HeaderSize)
+	n, err := io.ReadFull(r, h)
+	if err != nil && !errors.Is(err, io.ErrUnexpectedEOF) && !errors.Is(err, io.EOF) {
+		return nil, err
+	}
+	if !IsCompressed(h[:n]) {
+		return nil, errors.New("object is not compressed")
+	}
+	c, _, err := parseHeader(h[:n])
+	if err != nil {
+		return nil, err
+	}
+	return c.NewReader(r)
+}
@@ -0,0 +1,207 @@
+// Copyright (C) 2024 ScyllaDB
+
+package compress
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"testing"
+	"testing/iotest"
+
+	"github.com/rclone/rclone/backend/local"
+	"github.com/rclone/rclone/fs"
+	"github.com/rclone/rclone/fs/config/configmap"
+)
+
+func testData(size int) []byte {
+	return bytes.Repeat([]byte("scylla manager "), size/15+1)[:size]
+}
+
+func TestCompressDecompress(t *testing.T) {
+	for _, name := range Codecs() {
+		c, err := ParseCodec(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, size := range []int{0, 1, 1024 * 1024} {
+			data := testData(size)
+			comp, err := io.ReadAll(NewCompressor(c, bytes.NewReader(data), int64(size)))
+			if err != nil {
+				t.Fatalf("%s size %d: compress error %s", name, size, err)
+			}
+			if !IsCompressed(comp) {
+				t.Fatalf("%s size %d: IsCompressed() = false", name, size)
+			}
+			if _, s, err := parseHeader(comp); err != nil || s != int64(size) {
+				t.Fatalf("%s size %d: parseHeader() = %d, %v", name, size, s, err)
+			}
+			if size > 1024 && len(comp) >= size/10 {
+				t.Fatalf("%s size %d: compressed to %d bytes", name, size, len(comp))
+			}
+
+			r, err := NewDecompressor(bytes.NewReader(comp))
+			if err != nil {
+				t.Fatalf("%s size %d: NewDecompressor() error %s", name, size, err)
+			}
+			dec, err := io.ReadAll(r)
+			if err != nil {
+				t.Fatalf("%s size %d: decompress error %s", name, size, err)
+			}
+			if !bytes.Equal(dec, data) {
+				t.Fatalf("%s size %d: decompressed data differs", name, size)
+			}
+		}
+	}
+}
+
+// TestCompressDeterministic checks that compressed content does not depend
+// on how the source is read, uploads of compressed objects are resumed
+// only if compressed content is the same.
+func TestCompressDeterministic(t *testing.T) {
+	data := make([]byte, 8*1024*1024)
+	rand.New(rand.NewSource(1)).Read(data[:len(data)/2])
+	copy(data[len(data)/2:], testData(len(data)/2))
+
+	for _, name := range Codecs() {
+		c, err := ParseCodec(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		comp, err := io.ReadAll(NewCompressor(c, bytes.NewReader(data), int64(len(data))))
+		if err != nil {
+			t.Fatal(err)
+		}
+		r := io.MultiReader(iotest.OneByteReader(bytes.NewReader(data[:1000])), iotest.HalfReader(bytes.NewReader(data[1000:])))
+		other, err := io.ReadAll(NewCompressor(c, r, int64(len(data))))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(comp, other) {
+			t.Fatalf("%s: compressed content differs", name)
+		}
+	}
+}
+
+func TestParseCodec(t *testing.T) {
+	if _, err := ParseCodec("lzma"); err == nil {
+		t.Fatal("ParseCodec() expected error")
+	}
+}
+
+func TestCompressible(t *testing.T) {
+	table := []struct {
+		Name     string
+		Expected bool
+	}{
+		{"me-3g7k_0b5o_1u6gw2j3oe0ngrgc1p-big-Data.db", true},
+		{"md-1-big-Index.db", true},
+		{"md-1-big-Summary.db", true},
+		{"md-1-big-Data.db.sm_20240101000000UTC", true},
+		{"md-1-big-CompressionInfo.db", false},
+		{"md-1-big-TOC.txt", false},
+		{"manifest.json.gz", false},
+	}
+	for i := range table {
+		if got := Compressible(table[i].Name); got != table[i].Expected {
+			t.Errorf("Compressible(%s) = %v, expected %v", table[i].Name, got, table[i].Expected)
+		}
+	}
+}
+
+func TestFs(t *testing.T) {
+	ctx := context.Background()
+	srcDir := t.TempDir()
+	dstDir := t.TempDir()
+
+	data := testData(1024 * 1024)
+	files := map[string]bool{
+		"md-1-big-Data.db":            true,
+		"md-1-big-Index.db":           true,
+		"md-1-big-TOC.txt":            false,
+		"md-2-big-Data.db":            false,
+		"md-2-big-CompressionInfo.db": false,
+	}
+	for name := range files {
+		if err := os.WriteFile(filepath.Join(srcDir, name), data, 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	sf, err := local.NewFs(ctx, "local", srcDir, configmap.Simple{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	lf, err := local.NewFs(ctx, "local", dstDir, configmap.Simple{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	c, err := ParseCodec("zstd")
+	if err != nil {
+		t.Fatal(err)
+	}
+	f := NewFs(ctx, lf, c)
+
+	for name := range files {
+		src, err := sf.NewObject(ctx, name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		in, err := src.Open(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, err = f.Put(ctx, in, src)
+		in.Close()
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	read := func(o fs.Object, options ...fs.OpenOption) []byte {
+		t.Helper()
+		rc, err := o.Open(ctx, options...)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer rc.Close()
+		b, err := io.ReadAll(rc)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return b
+	}
+
+	for name, compressed := range files {
+		raw, err := os.ReadFile(filepath.Join(dstDir, name))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if IsCompressed(raw) != compressed {
+			t.Fatalf("%s: IsCompressed() = %v, expected %v", name, !compressed, compressed)
+		}
+
+		o, err := f.NewObject(ctx, name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if Compressible(name) && o.Size() != -1 {
+			t.Fatalf("%s: Size() = %d before Open(), expected -1", name, o.Size())
+		}
+		if !bytes.Equal(read(o), data) {
+			t.Fatalf("%s: Open() returned different data", name)
+		}
+		if o.Size() != int64(len(data)) {
+			t.Fatalf("%s: Size() = %d, expected %d", name, o.Size(), len(data))
+		}
+		if got := read(o, &fs.RangeOption{Start: 100, End: 199}); !bytes.Equal(got, data[100:200]) {
+			t.Fatalf("%s: Open() with range returned different data", name)
+		}
+		if got := read(o, &fs.SeekOption{Offset: 1000}); !bytes.Equal(got, data[1000:]) {
+			t.Fatalf("%s: Open() with seek returned different data", name)
+		}
+	}
+}
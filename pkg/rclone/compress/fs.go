@@ -0,0 +1,165 @@
+// Copyright (C) 2024 ScyllaDB
+
+package compress
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"strings"
+	"sync/atomic"
+
+	"github.com/pkg/errors"
+	"github.com/rclone/rclone/fs"
+	"github.com/scylladb/scylla-manager/v3/pkg/rclone/internal/wrapfs"
+)
+
+// NewFs returns f wrapped with compression using codec c, compressible
+// objects are compressed on upload and compressed objects are decompressed
+// on download. Object names are not changed, objects that are not compressed
+// are read as is. If c is nil objects are only decompressed.
+func NewFs(ctx context.Context, f fs.Fs, c Codec) fs.Fs {
+	return wrapfs.New(ctx, f, transform{codec: c})
+}
+
+type transform struct {
+	codec Codec
+}
+
+func (t transform) Name() string {
+	return "compressed"
+}
+
+// Upload compresses content of compressible objects, size of compressed
+// content is not known upfront.
+func (t transform) Upload(ctx context.Context, f *wrapfs.Fs, in io.Reader, src fs.ObjectInfo) (io.ReadCloser, fs.ObjectInfo, error) {
+	if !t.shouldCompress(ctx, src) {
+		return io.NopCloser(in), src, nil
+	}
+	return NewCompressor(t.codec, in, src.Size()), f.ObjectInfo(src, -1), nil
+}
+
+// shouldCompress returns true if src is compressible, Data.db is compressed
+// only if the source has no CompressionInfo.db component.
+func (t transform) shouldCompress(ctx context.Context, src fs.ObjectInfo) bool {
+	if t.codec == nil || !Compressible(src.Remote()) {
+		return false
+	}
+	name := src.Remote()
+	if !strings.HasSuffix(name, "-Data.db") {
+		return true
+	}
+	sf, ok := src.Fs().(fs.Fs)
+	if !ok {
+		return true
+	}
+	_, err := sf.NewObject(ctx, strings.TrimSuffix(name, "Data.db")+"CompressionInfo.db")
+	return errors.Is(err, fs.ErrorObjectNotFound)
+}
+
+func (t transform) Object(o wrapfs.Object) fs.Object {
+	obj := &Object{Object: o}
+	obj.size.Store(-1)
+	return obj
+}
+
+// Object wraps fs.Object of compressed Fs.
+type Object struct {
+	wrapfs.Object
+
+	// size of the decompressed data, it's -1 until the object is opened.
+	size atomic.Int64
+}
+
+var (
+	_ fs.Object          = &Object{}
+	_ fs.ObjectUnWrapper = &Object{}
+)
+
+// Size returns the size of the decompressed data. Size of objects with
+// compressible names is not known, and -1 is returned, until the object
+// header is read on Open. Original sizes of compressed files are recorded
+// in backup manifests.
+func (o *Object) Size() int64 {
+	if !Compressible(o.Remote()) {
+		return o.Object.Size()
+	}
+	return o.size.Load()
+}
+
+// Open opens the object for reading decompressed data. Compressed data
+// can't be read from an arbitrary offset, for ranged reads the object is
+// decompressed from the start and data before offset is discarded.
+func (o *Object) Open(ctx context.Context, options ...fs.OpenOption) (io.ReadCloser, error) {
+	var (
+		openOptions []fs.OpenOption
+		seek        *fs.SeekOption
+		rng         *fs.RangeOption
+	)
+	for _, option := range options {
+		switch x := option.(type) {
+		case *fs.SeekOption:
+			seek = x
+		case *fs.RangeOption:
+			rng = x
+		default:
+			openOptions = append(openOptions, option)
+		}
+	}
+
+	rc, err := o.Object.Open(ctx, openOptions...)
+	if err != nil {
+		return nil, err
+	}
+
+	h := make([]byte, HeaderSize)
+	n, err := io.ReadFull(rc, h)
+	if err != nil && !errors.Is(err, io.EOF) && !errors.Is(err, io.ErrUnexpectedEOF) {
+		rc.Close()
+		return nil, err
+	}
+	h = h[:n]
+
+	if !IsCompressed(h) {
+		o.size.Store(o.Object.Size())
+		if seek == nil && rng == nil {
+			return wrapfs.ReadCloser{Reader: io.MultiReader(bytes.NewReader(h), rc), CloseFn: rc.Close}, nil
+		}
+		rc.Close()
+		return o.Object.Open(ctx, options...)
+	}
+	c, size, err := parseHeader(h)
+	if err != nil {
+		rc.Close()
+		return nil, errors.Wrapf(err, "open %s", o)
+	}
+	o.size.Store(size)
+
+	var offset, limit int64 = 0, -1
+	if seek != nil {
+		offset = seek.Offset
+	}
+	if rng != nil {
+		offset, limit = rng.Decode(size)
+	}
+
+	d, err := c.NewReader(rc)
+	if err != nil {
+		rc.Close()
+		return nil, errors.Wrapf(err, "open %s", o)
+	}
+	if _, err := io.CopyN(io.Discard, d, offset); err != nil {
+		d.Close()
+		rc.Close()
+		return nil, errors.Wrapf(err, "seek %s", o)
+	}
+
+	var r io.Reader = d
+	if limit >= 0 {
+		r = io.LimitReader(d, limit)
+	}
+	return wrapfs.ReadCloser{Reader: r, CloseFn: func() error {
+		d.Close()
+		return rc.Close()
+	}}, nil
+}
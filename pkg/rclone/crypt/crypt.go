@@ -0,0 +1,346 @@
+// Copyright (C) 2024 ScyllaDB
+
+// Package crypt implements client-side encryption of backup objects.
+//
+// Every object is encrypted with its own data key using AES-256-GCM.
+// The data key is derived from the envelope key and a random seed, it's
+// wrapped with the envelope key and stored in the object header. Uploads
+// of the same data with the same seed produce the same content, so that
+// they can be resumed.
+//
+// Data is sealed in chunks so that objects can be streamed and read from
+// an arbitrary offset, the last chunk is marked so that truncation of an
+// object is detected.
+package crypt
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"io"
+
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/hkdf"
+)
+
+const (
+	// KeySize is the size of the envelope key in bytes.
+	KeySize = 32
+	// ChunkSize is the size of plaintext sealed in a single chunk.
+	ChunkSize = 64 * 1024
+	// SeedSize is the size of seed of encryption of an object.
+	SeedSize = 32
+
+	magic          = "SMCRYPT\x01"
+	keyIDSize      = 8
+	nonceSize      = 12
+	tagSize        = 16
+	wrappedKeySize = nonceSize + KeySize + tagSize
+
+	// HeaderSize is the size of the header of an encrypted object.
+	HeaderSize = len(magic) + keyIDSize + wrappedKeySize
+
+	sealedChunkSize = ChunkSize + tagSize
+)
+
+var (
+	// ErrKeyMismatch is returned when object was encrypted with a different key.
+	ErrKeyMismatch = errors.New("object is encrypted with a different key")
+
+	errNotEncrypted = errors.New("object is not encrypted")
+	errTruncated    = errors.New("encrypted object is truncated")
+	errInvalidSize  = errors.New("invalid encrypted object size")
+)
+
+// Key is the envelope key used for wrapping data keys of objects.
+type Key [KeySize]byte
+
+// NewKey returns a new random key.
+func NewKey() (Key, error) {
+	var k Key
+	if _, err := io.ReadFull(rand.Reader, k[:]); err != nil {
+		return k, errors.Wrap(err, "generate key")
+	}
+	return k, nil
+}
+
+// ParseKey decodes base64 encoded key.
+func ParseKey(s string) (Key, error) {
+	var k Key
+	b, err := base64.StdEncoding.DecodeString(s)
+	if err != nil {
+		return k, errors.Wrap(err, "decode key")
+	}
+	if len(b) != KeySize {
+		return k, errors.Errorf("invalid key size %d, expected %d", len(b), KeySize)
+	}
+	copy(k[:], b)
+	return k, nil
+}
+
+// Encode returns base64 encoded key.
+func (k Key) Encode() string {
+	return base64.StdEncoding.EncodeToString(k[:])
+}
+
+// ID returns a fingerprint of the key that is safe to be stored and logged.
+func (k Key) ID() string {
+	return hex.EncodeToString(k.id())
+}
+
+func (k Key) id() []byte {
+	sum := sha256.Sum256(k[:])
+	return sum[:keyIDSize]
+}
+
+// IsEncrypted returns true if b starts with encrypted object header.
+func IsEncrypted(b []byte) bool {
+	return bytes.HasPrefix(b, []byte(magic))
+}
+
+// EncryptedSize returns the size of encrypted object holding size bytes of data.
+func EncryptedSize(size int64) int64 {
+	return int64(HeaderSize) + size + (size/ChunkSize+1)*tagSize
+}
+
+// DecryptedSize returns the size of data held in encrypted object of the given size.
+func DecryptedSize(size int64) (int64, error) {
+	size -= int64(HeaderSize)
+	if size < tagSize {
+		return 0, errInvalidSize
+	}
+	chunks := size / sealedChunkSize
+	rem := size % sealedChunkSize
+	if rem < tagSize {
+		return 0, errInvalidSize
+	}
+	return chunks*ChunkSize + rem - tagSize, nil
+}
+
+// NewEncrypter returns a reader of encrypted content of r.
+func NewEncrypter(key Key, r io.Reader) (io.Reader, error) {
+	seed, err := NewSeed()
+	if err != nil {
+		return nil, err
+	}
+	return NewSeededEncrypter(key, r, seed)
+}
+
+// NewSeed returns a new random seed.
+func NewSeed() ([]byte, error) {
+	seed := make([]byte, SeedSize)
+	if _, err := io.ReadFull(rand.Reader, seed); err != nil {
+		return nil, errors.Wrap(err, "generate seed")
+	}
+	return seed, nil
+}
+
+// NewSeededEncrypter returns a reader of encrypted content of r, the data
+// key and the nonce of the header are derived from the key and the seed.
+// Content encrypted with the same seed is the same, a seed must not be used
+// for encrypting different data.
+func NewSeededEncrypter(key Key, r io.Reader, seed []byte) (io.Reader, error) {
+	if len(seed) != SeedSize {
+		return nil, errors.Errorf("invalid seed size %d, expected %d", len(seed), SeedSize)
+	}
+	dataKey := make([]byte, KeySize)
+	nonce := make([]byte, nonceSize)
+	kdf := hkdf.New(sha256.New, key[:], seed, []byte(magic))
+	if _, err := io.ReadFull(kdf, dataKey); err != nil {
+		return nil, errors.Wrap(err, "derive data key")
+	}
+	if _, err := io.ReadFull(kdf, nonce); err != nil {
+		return nil, errors.Wrap(err, "derive nonce")
+	}
+	header, err := sealHeader(key, dataKey, nonce)
+	if err != nil {
+		return nil, err
+	}
+	aead, err := newAEAD(dataKey)
+	if err != nil {
+		return nil, err
+	}
+	return &encrypter{
+		r:      r,
+		aead:   aead,
+		buf:    make([]byte, ChunkSize),
+		sealed: make([]byte, 0, sealedChunkSize),
+		out:    header,
+	}, nil
+}
+
+// NewDecrypter reads header of encrypted content of r and returns a reader
+// of decrypted content.
+func NewDecrypter(key Key, r io.Reader) (io.Reader, error) {
+	header := make([]byte, HeaderSize)
+	n, err := io.ReadFull(r, header)
+	if err != nil && !errors.Is(err, io.ErrUnexpectedEOF) {
+		return nil, err
+	}
+	aead, err := openHeader(key, header[:n])
+	if err != nil {
+		return nil, err
+	}
+	return newDecrypter(aead, r, 0), nil
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	b, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(b)
+}
+
+// sealHeader returns header with data key wrapped with the key.
+func sealHeader(key Key, dataKey, nonce []byte) ([]byte, error) {
+	kek, err := newAEAD(key[:])
+	if err != nil {
+		return nil, err
+	}
+
+	h := make([]byte, 0, HeaderSize)
+	h = append(h, magic...)
+	h = append(h, key.id()...)
+	ad := len(h)
+	h = append(h, nonce...)
+
+	return kek.Seal(h, nonce, dataKey, h[:ad]), nil
+}
+
+// openHeader unwraps data key from the header and returns cipher for the data.
+func openHeader(key Key, h []byte) (cipher.AEAD, error) {
+	if !IsEncrypted(h) {
+		return nil, errNotEncrypted
+	}
+	if len(h) < HeaderSize {
+		return nil, errTruncated
+	}
+	ad := len(magic) + keyIDSize
+	if !bytes.Equal(h[len(magic):ad], key.id()) {
+		return nil, ErrKeyMismatch
+	}
+
+	kek, err := newAEAD(key[:])
+	if err != nil {
+		return nil, err
+	}
+	dataKey, err := kek.Open(nil, h[ad:ad+nonceSize], h[ad+nonceSize:HeaderSize], h[:ad])
+	if err != nil {
+		return nil, errors.Wrap(err, "unwrap data key")
+	}
+	return newAEAD(dataKey)
+}
+
+func chunkNonce(nonce *[nonceSize]byte, counter uint64) []byte {
+	binary.BigEndian.PutUint64(nonce[nonceSize-8:], counter)
+	return nonce[:]
+}
+
+func chunkAdditionalData(last bool) []byte {
+	if last {
+		return []byte{1}
+	}
+	return []byte{0}
+}
+
+type encrypter struct {
+	r       io.Reader
+	aead    cipher.AEAD
+	nonce   [nonceSize]byte
+	counter uint64
+	buf     []byte
+	sealed  []byte
+	out     []byte
+	done    bool
+}
+
+func (e *encrypter) Read(p []byte) (int, error) {
+	for len(e.out) == 0 {
+		if e.done {
+			return 0, io.EOF
+		}
+		if err := e.sealChunk(); err != nil {
+			return 0, err
+		}
+	}
+	n := copy(p, e.out)
+	e.out = e.out[n:]
+	return n, nil
+}
+
+// sealChunk encrypts next chunk of data, the last chunk is always shorter
+// than ChunkSize (possibly empty).
+func (e *encrypter) sealChunk() error {
+	n, err := io.ReadFull(e.r, e.buf)
+	switch {
+	case errors.Is(err, io.EOF), errors.Is(err, io.ErrUnexpectedEOF):
+		e.done = true
+	case err != nil:
+		return err
+	}
+	e.out = e.aead.Seal(e.sealed[:0], chunkNonce(&e.nonce, e.counter), e.buf[:n], chunkAdditionalData(e.done))
+	e.counter++
+	return nil
+}
+
+type decrypter struct {
+	r       io.Reader
+	aead    cipher.AEAD
+	nonce   [nonceSize]byte
+	counter uint64
+	buf     []byte
+	out     []byte
+	done    bool
+}
+
+func newDecrypter(aead cipher.AEAD, r io.Reader, chunk uint64) *decrypter {
+	return &decrypter{
+		r:       r,
+		aead:    aead,
+		counter: chunk,
+		buf:     make([]byte, sealedChunkSize),
+	}
+}
+
+func (d *decrypter) Read(p []byte) (int, error) {
+	for len(d.out) == 0 {
+		if d.done {
+			return 0, io.EOF
+		}
+		if err := d.openChunk(); err != nil {
+			return 0, err
+		}
+	}
+	n := copy(p, d.out)
+	d.out = d.out[n:]
+	return n, nil
+}
+
+func (d *decrypter) openChunk() error {
+	n, err := io.ReadFull(d.r, d.buf)
+	switch {
+	case errors.Is(err, io.ErrUnexpectedEOF):
+		d.done = true
+	case errors.Is(err, io.EOF):
+		return errTruncated
+	case err != nil:
+		return err
+	}
+	if n < tagSize {
+		return errTruncated
+	}
+
+	out, err := d.aead.Open(d.buf[:0], chunkNonce(&d.nonce, d.counter), d.buf[:n], chunkAdditionalData(d.done))
+	if err != nil {
+		return errors.Wrapf(err, "decrypt chunk %d", d.counter)
+	}
+	d.out = out
+	d.counter++
+	return nil
+}
@@ -0,0 +1,271 @@
+// Copyright (C) 2024 ScyllaDB
+
+package crypt
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/rclone/rclone/backend/local"
+	"github.com/rclone/rclone/fs"
+	"github.com/rclone/rclone/fs/config/configmap"
+	"github.com/rclone/rclone/fs/object"
+	"github.com/scylladb/scylla-manager/v3/pkg/util/timeutc"
+)
+
+var testSizes = []int{0, 1, ChunkSize - 1, ChunkSize, ChunkSize + 1, 3*ChunkSize + 7}
+
+func testKey(t *testing.T) Key {
+	t.Helper()
+	k, err := NewKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return k
+}
+
+func testData(size int) []byte {
+	b := make([]byte, size)
+	rand.New(rand.NewSource(int64(size))).Read(b)
+	return b
+}
+
+func encrypt(t *testing.T, key Key, data []byte) []byte {
+	t.Helper()
+	r, err := NewEncrypter(key, bytes.NewReader(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := io.ReadAll(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return b
+}
+
+func decrypt(key Key, data []byte) ([]byte, error) {
+	r, err := NewDecrypter(key, bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	return io.ReadAll(r)
+}
+
+func TestParseKey(t *testing.T) {
+	k := testKey(t)
+	p, err := ParseKey(k.Encode())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p != k {
+		t.Fatal("ParseKey() returned different key")
+	}
+	if _, err := ParseKey("Zm9v"); err == nil {
+		t.Fatal("ParseKey() expected error for short key")
+	}
+}
+
+func TestEncryptDecrypt(t *testing.T) {
+	key := testKey(t)
+
+	for _, size := range testSizes {
+		data := testData(size)
+		enc := encrypt(t, key, data)
+
+		if !IsEncrypted(enc) {
+			t.Fatalf("size %d: IsEncrypted() = false", size)
+		}
+		if s := EncryptedSize(int64(size)); s != int64(len(enc)) {
+			t.Fatalf("size %d: EncryptedSize() = %d, expected %d", size, s, len(enc))
+		}
+		if s, err := DecryptedSize(int64(len(enc))); err != nil || s != int64(size) {
+			t.Fatalf("size %d: DecryptedSize() = %d, %v", size, s, err)
+		}
+
+		dec, err := decrypt(key, enc)
+		if err != nil {
+			t.Fatalf("size %d: decrypt() error %s", size, err)
+		}
+		if !bytes.Equal(dec, data) {
+			t.Fatalf("size %d: decrypted data differs", size)
+		}
+	}
+}
+
+func TestSeededEncrypter(t *testing.T) {
+	key := testKey(t)
+	data := testData(3*ChunkSize + 7)
+
+	encryptSeeded := func(key Key, seed []byte) []byte {
+		t.Helper()
+		r, err := NewSeededEncrypter(key, bytes.NewReader(data), seed)
+		if err != nil {
+			t.Fatal(err)
+		}
+		b, err := io.ReadAll(r)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return b
+	}
+
+	seed, err := NewSeed()
+	if err != nil {
+		t.Fatal(err)
+	}
+	enc := encryptSeeded(key, seed)
+	if !bytes.Equal(enc, encryptSeeded(key, seed)) {
+		t.Fatal("Expected the same content for the same seed")
+	}
+	dec, err := decrypt(key, enc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(dec, data) {
+		t.Fatal("decrypted data differs")
+	}
+
+	otherSeed, err := NewSeed()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bytes.Equal(enc[HeaderSize:], encryptSeeded(key, otherSeed)[HeaderSize:]) {
+		t.Fatal("Expected different content for different seeds")
+	}
+	if bytes.Equal(enc[HeaderSize:], encryptSeeded(testKey(t), seed)[HeaderSize:]) {
+		t.Fatal("Expected different content for different keys")
+	}
+
+	if _, err := NewSeededEncrypter(key, bytes.NewReader(data), seed[1:]); err == nil {
+		t.Fatal("NewSeededEncrypter() expected error for invalid seed")
+	}
+}
+
+func TestDecryptErrors(t *testing.T) {
+	key := testKey(t)
+	data := testData(2*ChunkSize + 100)
+	enc := encrypt(t, key, data)
+
+	t.Run("wrong key", func(t *testing.T) {
+		if _, err := decrypt(testKey(t), enc); !errors.Is(err, ErrKeyMismatch) {
+			t.Fatalf("decrypt() error %v, expected %v", err, ErrKeyMismatch)
+		}
+	})
+
+	t.Run("truncated at chunk boundary", func(t *testing.T) {
+		if _, err := decrypt(key, enc[:HeaderSize+2*sealedChunkSize]); err == nil {
+			t.Fatal("decrypt() expected error")
+		}
+	})
+
+	t.Run("truncated header", func(t *testing.T) {
+		if _, err := decrypt(key, enc[:HeaderSize-1]); err == nil {
+			t.Fatal("decrypt() expected error")
+		}
+	})
+
+	t.Run("modified", func(t *testing.T) {
+		b := bytes.Clone(enc)
+		b[HeaderSize+ChunkSize] ^= 1
+		if _, err := decrypt(key, b); err == nil {
+			t.Fatal("decrypt() expected error")
+		}
+	})
+
+	t.Run("not encrypted", func(t *testing.T) {
+		if _, err := decrypt(key, data); err == nil {
+			t.Fatal("decrypt() expected error")
+		}
+	})
+}
+
+func TestFs(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+
+	lf, err := local.NewFs(ctx, "local", dir, configmap.Simple{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	key := testKey(t)
+	f := NewFs(ctx, lf, key)
+
+	data := testData(3*ChunkSize + 7)
+	src := object.NewStaticObjectInfo("file", timeutc.Now(), int64(len(data)), true, nil, nil)
+	if _, err := f.Put(ctx, bytes.NewReader(data), src); err != nil {
+		t.Fatal(err)
+	}
+	plain := []byte("plain")
+	if err := os.WriteFile(filepath.Join(dir, "plain"), plain, 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	raw, err := os.ReadFile(filepath.Join(dir, "file"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !IsEncrypted(raw) || int64(len(raw)) != EncryptedSize(int64(len(data))) {
+		t.Fatal("Expected file to be stored encrypted")
+	}
+
+	o, err := f.NewObject(ctx, "file")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if o.Size() != int64(len(data)) {
+		t.Fatalf("Size() = %d, expected %d", o.Size(), len(data))
+	}
+
+	read := func(o fs.Object, options ...fs.OpenOption) []byte {
+		t.Helper()
+		rc, err := o.Open(ctx, options...)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer rc.Close()
+		b, err := io.ReadAll(rc)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return b
+	}
+
+	if !bytes.Equal(read(o), data) {
+		t.Fatal("Open() returned different data")
+	}
+
+	ranges := []fs.RangeOption{
+		{Start: 0, End: 10},
+		{Start: ChunkSize - 5, End: ChunkSize + 5},
+		{Start: ChunkSize, End: 2*ChunkSize - 1},
+		{Start: 2*ChunkSize + 3, End: -1},
+		{Start: -1, End: 100},
+	}
+	for _, r := range ranges {
+		offset, limit := r.Decode(int64(len(data)))
+		expected := data[offset:]
+		if limit >= 0 {
+			expected = expected[:limit]
+		}
+		if got := read(o, &r); !bytes.Equal(got, expected) {
+			t.Fatalf("Open(%v) returned %d bytes different from expected %d bytes", r, len(got), len(expected))
+		}
+	}
+	if got := read(o, &fs.SeekOption{Offset: ChunkSize + 1}); !bytes.Equal(got, data[ChunkSize+1:]) {
+		t.Fatal("Open() with seek returned different data")
+	}
+
+	p, err := f.NewObject(ctx, "plain")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(read(p), plain) {
+		t.Fatal("Open() of not encrypted object returned different data")
+	}
+}
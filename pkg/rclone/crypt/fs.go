@@ -0,0 +1,209 @@
+// Copyright (C) 2024 ScyllaDB
+
+package crypt
+
+import (
+	"bytes"
+	"context"
+	"io"
+
+	"github.com/pkg/errors"
+	"github.com/rclone/rclone/fs"
+	"github.com/scylladb/scylla-manager/v3/pkg/rclone/internal/wrapfs"
+)
+
+// NewFs returns f wrapped with encryption using the key, objects are
+// encrypted on upload and decrypted on download. Unlike rclone crypt backend
+// it does not change object names. Objects that are not encrypted are read
+// as is.
+func NewFs(ctx context.Context, f fs.Fs, key Key) fs.Fs {
+	return wrapfs.New(ctx, f, transform{key: key})
+}
+
+// Seeder is implemented by Fs that keeps state of uploads, it returns
+// the seed to encrypt src with. The seed must be the same when upload of
+// the same data is resumed, and different otherwise. Data encrypted with
+// a reused seed must not be stored unless it's proven to be the same as
+// the stored data, as that would reuse nonces. Nil seed means that a random
+// seed should be used.
+type Seeder interface {
+	UploadSeed(ctx context.Context, src fs.ObjectInfo) ([]byte, error)
+}
+
+type transform struct {
+	key Key
+}
+
+func (t transform) Name() string {
+	return "encrypted"
+}
+
+func (t transform) Upload(ctx context.Context, f *wrapfs.Fs, in io.Reader, src fs.ObjectInfo) (io.ReadCloser, fs.ObjectInfo, error) {
+	seed, err := uploadSeed(ctx, f.Fs, src)
+	if err != nil {
+		return nil, nil, err
+	}
+	r, err := NewSeededEncrypter(t.key, in, seed)
+	if err != nil {
+		return nil, nil, err
+	}
+	size := src.Size()
+	if size >= 0 {
+		size = EncryptedSize(size)
+	}
+	return io.NopCloser(r), f.ObjectInfo(src, size), nil
+}
+
+// uploadSeed returns seed from f if it's a Seeder, or a random seed.
+func uploadSeed(ctx context.Context, f fs.Fs, src fs.ObjectInfo) ([]byte, error) {
+	if s, ok := f.(Seeder); ok {
+		seed, err := s.UploadSeed(ctx, src)
+		if err != nil {
+			fs.Errorf(src, "Failed to get encryption seed, upload will not be resumable: %v", err)
+		}
+		if seed != nil {
+			return seed, nil
+		}
+	}
+	return NewSeed()
+}
+
+func (t transform) Object(o wrapfs.Object) fs.Object {
+	return &Object{
+		Object: o,
+		key:    t.key,
+	}
+}
+
+// Object wraps fs.Object of encrypted Fs.
+type Object struct {
+	wrapfs.Object
+	key Key
+}
+
+var (
+	_ fs.Object          = &Object{}
+	_ fs.ObjectUnWrapper = &Object{}
+)
+
+// Size returns the size of the decrypted data. Objects with size that is
+// invalid for encrypted objects are assumed to be not encrypted.
+func (o *Object) Size() int64 {
+	size := o.Object.Size()
+	if size < 0 {
+		return size
+	}
+	if s, err := DecryptedSize(size); err == nil {
+		return s
+	}
+	return size
+}
+
+// Open opens the object for reading decrypted data.
+func (o *Object) Open(ctx context.Context, options ...fs.OpenOption) (io.ReadCloser, error) {
+	var (
+		openOptions   []fs.OpenOption
+		offset, limit int64 = 0, -1
+	)
+	for _, option := range options {
+		switch x := option.(type) {
+		case *fs.SeekOption:
+			offset = x.Offset
+		case *fs.RangeOption:
+			offset, limit = x.Decode(o.Size())
+		default:
+			openOptions = append(openOptions, option)
+		}
+	}
+	if offset == 0 && limit < 0 {
+		return o.open(ctx, openOptions)
+	}
+	return o.openRange(ctx, options, openOptions, offset, limit)
+}
+
+// open returns decrypted content of the whole object.
+func (o *Object) open(ctx context.Context, options []fs.OpenOption) (io.ReadCloser, error) {
+	rc, err := o.Object.Open(ctx, options...)
+	if err != nil {
+		return nil, err
+	}
+
+	header := make([]byte, HeaderSize)
+	n, err := io.ReadFull(rc, header)
+	if err != nil && !errors.Is(err, io.EOF) && !errors.Is(err, io.ErrUnexpectedEOF) {
+		rc.Close()
+		return nil, err
+	}
+	header = header[:n]
+
+	if !IsEncrypted(header) {
+		return wrapfs.ReadCloser{Reader: io.MultiReader(bytes.NewReader(header), rc), CloseFn: rc.Close}, nil
+	}
+	aead, err := openHeader(o.key, header)
+	if err != nil {
+		rc.Close()
+		return nil, errors.Wrapf(err, "open %s", o)
+	}
+	return wrapfs.ReadCloser{Reader: newDecrypter(aead, rc, 0), CloseFn: rc.Close}, nil
+}
+
+// openRange returns decrypted content starting at offset, it reads only
+// the header and the chunks holding the requested range.
+func (o *Object) openRange(ctx context.Context, options, openOptions []fs.OpenOption, offset, limit int64) (io.ReadCloser, error) {
+	header, err := o.readHeader(ctx, openOptions)
+	if err != nil {
+		return nil, err
+	}
+	if !IsEncrypted(header) {
+		return o.Object.Open(ctx, options...)
+	}
+	aead, err := openHeader(o.key, header)
+	if err != nil {
+		return nil, errors.Wrapf(err, "open %s", o)
+	}
+	if limit == 0 {
+		return io.NopCloser(bytes.NewReader(nil)), nil
+	}
+
+	chunk := offset / ChunkSize
+	start := int64(HeaderSize) + chunk*sealedChunkSize
+	end := int64(-1)
+	if limit > 0 {
+		last := (offset + limit - 1) / ChunkSize
+		end = int64(HeaderSize) + (last+1)*sealedChunkSize - 1
+		if end >= o.Object.Size() {
+			end = -1
+		}
+	}
+
+	rc, err := o.Object.Open(ctx, append(openOptions, &fs.RangeOption{Start: start, End: end})...)
+	if err != nil {
+		return nil, err
+	}
+	d := newDecrypter(aead, rc, uint64(chunk))
+	if _, err := io.CopyN(io.Discard, d, offset-chunk*ChunkSize); err != nil {
+		rc.Close()
+		return nil, errors.Wrapf(err, "seek %s", o)
+	}
+
+	var r io.Reader = d
+	if limit > 0 {
+		r = io.LimitReader(d, limit)
+	}
+	return wrapfs.ReadCloser{Reader: r, CloseFn: rc.Close}, nil
+}
+
+func (o *Object) readHeader(ctx context.Context, options []fs.OpenOption) ([]byte, error) {
+	rc, err := o.Object.Open(ctx, append(options, &fs.RangeOption{Start: 0, End: int64(HeaderSize) - 1})...)
+	if err != nil {
+		return nil, err
+	}
+	defer rc.Close()
+
+	header := make([]byte, HeaderSize)
+	n, err := io.ReadFull(rc, header)
+	if err != nil && !errors.Is(err, io.EOF) && !errors.Is(err, io.ErrUnexpectedEOF) {
+		return nil, err
+	}
+	return header[:n], nil
+}
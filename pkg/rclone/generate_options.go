@@ -22,6 +22,7 @@ import (
 	_ "github.com/rclone/rclone/backend/googlecloudstorage"
 	_ "github.com/rclone/rclone/backend/local"
 	_ "github.com/rclone/rclone/backend/s3"
+	_ "github.com/rclone/rclone/backend/sftp"
 )
 
 var rclonePkgName = map[string]string{
@@ -29,6 +30,7 @@ var rclonePkgName = map[string]string{
 	"google cloud storage": "GCS",
 	"local":                "Local",
 	"s3":                   "S3",
+	"sftp":                 "SFTP",
 }
 
 func main() {
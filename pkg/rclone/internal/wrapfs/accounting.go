@@ -0,0 +1,112 @@
+// Copyright (C) 2024 ScyllaDB
+
+package wrapfs
+
+import (
+	"context"
+	"io"
+	"sync"
+
+	"github.com/rclone/rclone/fs/accounting"
+)
+
+// Gate reads the source of an upload and accounts read bytes in transfer
+// stats. Accounting can be deferred until it's known if the read bytes are
+// uploaded or skipped, that allows for reporting resumed parts of uploads
+// as skipped even if the source is transformed before upload.
+// Bytes of the source read by a transform ahead of the upload are accounted
+// together with the upload, so for transformed content accounting of
+// skipped bytes is approximate.
+type Gate struct {
+	in    io.Reader
+	plain io.Reader
+	wrap  accounting.WrapFn
+
+	mu       sync.Mutex
+	deferred bool
+	pending  int64
+}
+
+// NewGate returns Gate reading in, it returns false if in is not accounted.
+func NewGate(in io.Reader) (*Gate, bool) {
+	if _, ok := in.(accounting.Accounter); !ok {
+		return nil, false
+	}
+	plain, wrap := accounting.UnWrap(in)
+	return &Gate{
+		in:    in,
+		plain: plain,
+		wrap:  wrap,
+	}, true
+}
+
+type gateKey struct{}
+
+// WithGate returns context carrying g, transformed uploads get it from
+// the context of the upload.
+func WithGate(ctx context.Context, g *Gate) context.Context {
+	return context.WithValue(ctx, gateKey{}, g)
+}
+
+// GateFrom returns Gate of the upload or nil if there is none.
+func GateFrom(ctx context.Context) *Gate {
+	g, _ := ctx.Value(gateKey{}).(*Gate)
+	return g
+}
+
+// Read reads from the source, read bytes are not accounted while
+// accounting is deferred.
+func (g *Gate) Read(p []byte) (int, error) {
+	g.mu.Lock()
+	deferred := g.deferred
+	g.mu.Unlock()
+
+	if !deferred {
+		return g.in.Read(p)
+	}
+	n, err := g.plain.Read(p)
+	g.mu.Lock()
+	g.pending += int64(n)
+	g.mu.Unlock()
+	return n, err
+}
+
+// Defer defers accounting of read bytes until Resolve is called.
+func (g *Gate) Defer() {
+	if g == nil {
+		return
+	}
+	g.mu.Lock()
+	g.deferred = true
+	g.mu.Unlock()
+}
+
+// Resolve accounts bytes read since Defer as skipped or as transferred,
+// and ends deferring accounting.
+func (g *Gate) Resolve(ctx context.Context, skipped bool) error {
+	if g == nil {
+		return nil
+	}
+	g.mu.Lock()
+	n := g.pending
+	g.pending = 0
+	g.deferred = false
+	g.mu.Unlock()
+
+	if n == 0 {
+		return nil
+	}
+	if skipped {
+		accounting.Stats(ctx).UpdateSkipped(n)
+		return nil
+	}
+	_, err := io.CopyN(io.Discard, g.wrap(zeroReader{}), n)
+	return err
+}
+
+type zeroReader struct{}
+
+func (zeroReader) Read(p []byte) (int, error) {
+	clear(p)
+	return len(p), nil
+}
@@ -0,0 +1,311 @@
+// Copyright (C) 2024 ScyllaDB
+
+// Package wrapfs implements fs.Fs wrapper which transforms content of
+// objects without changing their names. It's shared by encrypted and
+// compressed backup file systems which only differ in how content is
+// transformed.
+package wrapfs
+
+import (
+	"context"
+	"io"
+
+	"github.com/pkg/errors"
+	"github.com/rclone/rclone/fs"
+	"github.com/rclone/rclone/fs/hash"
+)
+
+// Transform transforms content of objects of wrapped Fs.
+// Implementations must be comparable, objects can be copied server-side
+// only between file systems with equal transforms.
+type Transform interface {
+	// Name describes the transform in Fs description e.g. "encrypted".
+	Name() string
+	// Upload returns reader of content of in as it's stored in the wrapped
+	// Fs together with description of the stored object, see Fs.ObjectInfo.
+	// The reader is closed when upload is done.
+	Upload(ctx context.Context, f *Fs, in io.Reader, src fs.ObjectInfo) (io.ReadCloser, fs.ObjectInfo, error)
+	// Object returns object of Fs reading transformed content of o.
+	Object(o Object) fs.Object
+}
+
+// Fs wraps fs.Fs so that content of objects is transformed on upload and
+// on download.
+type Fs struct {
+	fs.Fs
+	t        Transform
+	features *fs.Features
+}
+
+var (
+	_ fs.Fs          = &Fs{}
+	_ fs.PutStreamer = &Fs{}
+	_ fs.Copier      = &Fs{}
+	_ fs.Mover       = &Fs{}
+	_ fs.Purger      = &Fs{}
+	_ fs.ListRer     = &Fs{}
+	_ fs.UnWrapper   = &Fs{}
+)
+
+// New returns f wrapped with transform t.
+func New(ctx context.Context, f fs.Fs, t Transform) *Fs {
+	w := &Fs{
+		Fs: f,
+		t:  t,
+	}
+	// The features here are the ones we could support,
+	// and they are ANDed with the ones from the wrapped Fs.
+	w.features = (&fs.Features{
+		CaseInsensitive:         true,
+		DuplicateFiles:          true,
+		CanHaveEmptyDirectories: true,
+		BucketBased:             true,
+		BucketBasedRootOK:       true,
+		SetTier:                 true,
+		GetTier:                 true,
+		SlowModTime:             true,
+		SlowHash:                true,
+	}).Fill(ctx, w).Mask(ctx, f).WrapsFs(w, f)
+	w.features.IsLocal = f.Features().IsLocal
+	return w
+}
+
+// String returns a description of the Fs.
+func (f *Fs) String() string {
+	return f.t.Name() + " " + f.Fs.String()
+}
+
+// Features returns the optional features of this Fs.
+func (f *Fs) Features() *fs.Features {
+	return f.features
+}
+
+// Hashes returns the supported hash sets, hashes of transformed
+// objects do not match hashes of the data.
+func (f *Fs) Hashes() hash.Set {
+	return hash.Set(hash.None)
+}
+
+// UnWrap returns the wrapped Fs.
+func (f *Fs) UnWrap() fs.Fs {
+	return f.Fs
+}
+
+// List the objects and directories in dir into entries.
+func (f *Fs) List(ctx context.Context, dir string) (fs.DirEntries, error) {
+	entries, err := f.Fs.List(ctx, dir)
+	if err != nil {
+		return nil, err
+	}
+	return f.wrapEntries(entries), nil
+}
+
+// ListR lists the objects and directories of the Fs starting from dir recursively.
+func (f *Fs) ListR(ctx context.Context, dir string, callback fs.ListRCallback) error {
+	do := f.Fs.Features().ListR
+	if do == nil {
+		return errors.New("recursive listing not supported")
+	}
+	return do(ctx, dir, func(entries fs.DirEntries) error {
+		return callback(f.wrapEntries(entries))
+	})
+}
+
+func (f *Fs) wrapEntries(entries fs.DirEntries) fs.DirEntries {
+	for i, e := range entries {
+		if o, ok := e.(fs.Object); ok {
+			entries[i] = f.newObject(o)
+		}
+	}
+	return entries
+}
+
+// NewObject finds the Object at remote.
+func (f *Fs) NewObject(ctx context.Context, remote string) (fs.Object, error) {
+	o, err := f.Fs.NewObject(ctx, remote)
+	if err != nil {
+		return nil, err
+	}
+	return f.newObject(o), nil
+}
+
+type putFn func(ctx context.Context, in io.Reader, src fs.ObjectInfo, options ...fs.OpenOption) (fs.Object, error)
+
+// put uploads transformed content of in with put, if put is nil the wrapped
+// Fs Put or PutStream is used depending on size of transformed content
+// being known upfront.
+func (f *Fs) put(ctx context.Context, in io.Reader, src fs.ObjectInfo, options []fs.OpenOption, put putFn) (fs.Object, error) {
+	// Accounting of the source is passed with context to the wrapped Fs,
+	// as it only gets transformed content.
+	if GateFrom(ctx) == nil {
+		if g, ok := NewGate(in); ok {
+			ctx = WithGate(ctx, g)
+			in = g
+		}
+	}
+
+	r, info, err := f.t.Upload(ctx, f, in, src)
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+
+	if put == nil {
+		put = f.Fs.Put
+		if do := f.Fs.Features().PutStream; do != nil && info.Size() < 0 {
+			put = do
+		}
+	}
+	o, err := put(ctx, r, info, options...)
+	if err != nil {
+		return nil, err
+	}
+	return f.newObject(o), nil
+}
+
+// Put transforms in and uploads it to the remote path.
+func (f *Fs) Put(ctx context.Context, in io.Reader, src fs.ObjectInfo, options ...fs.OpenOption) (fs.Object, error) {
+	return f.put(ctx, in, src, options, nil)
+}
+
+// PutStream transforms in and uploads it to the remote path without knowing its size.
+func (f *Fs) PutStream(ctx context.Context, in io.Reader, src fs.ObjectInfo, options ...fs.OpenOption) (fs.Object, error) {
+	return f.put(ctx, in, src, options, f.Fs.Features().PutStream)
+}
+
+// unwrap returns object of the wrapped Fs if src belongs to Fs with
+// the same transform, such objects can be copied as is.
+func (f *Fs) unwrap(src fs.Object) (fs.Object, bool) {
+	sf, ok := src.Fs().(*Fs)
+	if !ok || sf.t != f.t {
+		return nil, false
+	}
+	o, ok := src.(fs.ObjectUnWrapper)
+	if !ok {
+		return nil, false
+	}
+	return o.UnWrap(), true
+}
+
+// Copy src to this remote using server-side copy operations.
+func (f *Fs) Copy(ctx context.Context, src fs.Object, remote string) (fs.Object, error) {
+	do := f.Fs.Features().Copy
+	o, ok := f.unwrap(src)
+	if do == nil || !ok {
+		return nil, fs.ErrorCantCopy
+	}
+	dst, err := do(ctx, o, remote)
+	if err != nil {
+		return nil, err
+	}
+	return f.newObject(dst), nil
+}
+
+// Move src to this remote using server-side move operations.
+func (f *Fs) Move(ctx context.Context, src fs.Object, remote string) (fs.Object, error) {
+	do := f.Fs.Features().Move
+	o, ok := f.unwrap(src)
+	if do == nil || !ok {
+		return nil, fs.ErrorCantMove
+	}
+	dst, err := do(ctx, o, remote)
+	if err != nil {
+		return nil, err
+	}
+	return f.newObject(dst), nil
+}
+
+// Purge all files in the directory specified.
+func (f *Fs) Purge(ctx context.Context, dir string) error {
+	do := f.Fs.Features().Purge
+	if do == nil {
+		return fs.ErrorCantPurge
+	}
+	return do(ctx, dir)
+}
+
+func (f *Fs) newObject(o fs.Object) fs.Object {
+	return f.t.Object(Object{Object: o, f: f})
+}
+
+// ObjectInfo returns description of object uploaded from src as seen by
+// the wrapped Fs, size is the size of transformed content or -1 if it's
+// not known upfront.
+func (f *Fs) ObjectInfo(src fs.ObjectInfo, size int64) fs.ObjectInfo {
+	return &objectInfo{
+		ObjectInfo: src,
+		f:          f,
+		size:       size,
+	}
+}
+
+// Object wraps fs.Object of the wrapped Fs, it's meant to be embedded in
+// objects returned by Transform which implement reading of transformed
+// content i.e. Size and Open.
+type Object struct {
+	fs.Object
+	f *Fs
+}
+
+// Fs returns the parent Fs.
+func (o Object) Fs() fs.Info {
+	return o.f
+}
+
+// UnWrap returns the wrapped Object.
+func (o Object) UnWrap() fs.Object {
+	return o.Object
+}
+
+// Hash is not supported for transformed objects.
+func (o Object) Hash(ctx context.Context, ty hash.Type) (string, error) {
+	return "", hash.ErrUnsupported
+}
+
+// Update transforms in and uploads it in place of the object.
+func (o Object) Update(ctx context.Context, in io.Reader, src fs.ObjectInfo, options ...fs.OpenOption) error {
+	update := func(ctx context.Context, in io.Reader, src fs.ObjectInfo, options ...fs.OpenOption) (fs.Object, error) {
+		return o.Object, o.Object.Update(ctx, in, src, options...)
+	}
+	_, err := o.f.put(ctx, in, src, options, update)
+	return err
+}
+
+// objectInfo describes source of an upload as seen by the wrapped Fs.
+type objectInfo struct {
+	fs.ObjectInfo
+	f    *Fs
+	size int64
+}
+
+// Fs returns the parent Fs.
+func (o *objectInfo) Fs() fs.Info {
+	return o.f
+}
+
+// Size returns the size of transformed content.
+func (o *objectInfo) Size() int64 {
+	return o.size
+}
+
+// Hash of transformed object is not known upfront.
+func (o *objectInfo) Hash(ctx context.Context, ty hash.Type) (string, error) {
+	return "", nil
+}
+
+// Source returns description of the source of the upload before it was
+// transformed.
+func (o *objectInfo) Source() fs.ObjectInfo {
+	return o.ObjectInfo
+}
+
+// ReadCloser is io.ReadCloser closing underlying readers with close.
+type ReadCloser struct {
+	io.Reader
+	CloseFn func() error
+}
+
+// Close closes the underlying readers.
+func (r ReadCloser) Close() error {
+	return r.CloseFn()
+}
@@ -0,0 +1,153 @@
+// Copyright (C) 2024 ScyllaDB
+
+package rclone
+
+import (
+	"context"
+	"net/http"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-storage-blob-go/azblob"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/pkg/errors"
+	rcs3 "github.com/rclone/rclone/backend/s3"
+	"github.com/rclone/rclone/fs"
+	"github.com/rclone/rclone/fs/config/configstruct"
+	"github.com/rclone/rclone/lib/bucket"
+	"github.com/scylladb/scylla-manager/v3/pkg/util/parallel"
+	"go.uber.org/atomic"
+	"google.golang.org/api/googleapi"
+)
+
+// SetObjectRetention sets S3 Object Lock retention of paths in remote
+// directory of f. Retention is never shortened, objects already retained
+// until the same or later date are skipped, so are objects that do not exist.
+// It returns the number of objects with updated retention.
+// The bucket must have Object Lock enabled.
+func SetObjectRetention(ctx context.Context, f fs.Fs, remote string, paths []string, mode string, retainUntil time.Time) (int64, error) {
+	if mode != "governance" && mode != "compliance" {
+		return 0, errors.Errorf("unsupported object lock mode %q", mode)
+	}
+	if t, _ := fs.ConfigFileGet(f.Name(), "type"); t != "s3" {
+		return 0, errors.Errorf("object lock retention is not supported by %s backend", t)
+	}
+
+	c, opt, err := NewS3Client(f.Name())
+	if err != nil {
+		return 0, errors.Wrap(err, "create s3 client")
+	}
+
+	var updated atomic.Int64
+	set := func(i int) error {
+		b, key := bucket.Split(path.Join(f.Root(), remote, paths[i]))
+		b, key = opt.Enc.FromStandardName(b), opt.Enc.FromStandardPath(key)
+
+		cur, err := c.GetObjectRetentionWithContext(ctx, &s3.GetObjectRetentionInput{
+			Bucket: aws.String(b),
+			Key:    aws.String(key),
+		})
+		if isAWSErrorCode(err, "NoSuchKey") {
+			return nil
+		}
+		if err != nil && !isAWSErrorCode(err, "NoSuchObjectLockConfiguration") {
+			return errors.Wrapf(err, "get retention of %s", paths[i])
+		}
+		if cur != nil && cur.Retention != nil && cur.Retention.RetainUntilDate != nil &&
+			!cur.Retention.RetainUntilDate.Before(retainUntil) {
+			return nil
+		}
+
+		_, err = c.PutObjectRetentionWithContext(ctx, &s3.PutObjectRetentionInput{
+			Bucket: aws.String(b),
+			Key:    aws.String(key),
+			Retention: &s3.ObjectLockRetention{
+				Mode:            aws.String(strings.ToUpper(mode)),
+				RetainUntilDate: aws.Time(retainUntil),
+			},
+		})
+		if err != nil {
+			return errors.Wrapf(err, "put retention of %s", paths[i])
+		}
+		updated.Inc()
+		return nil
+	}
+
+	err = parallel.Run(len(paths), fs.GetConfig(ctx).Checkers, set, nil)
+	return updated.Load(), err
+}
+
+// NewS3Client creates S3 client using configuration of the registered
+// s3 provider. Credentials not set explicitly are taken from the default
+// AWS credentials chain.
+func NewS3Client(name string) (*s3.S3, *rcs3.Options, error) {
+	info, err := fs.Find("s3")
+	if err != nil {
+		return nil, nil, err
+	}
+	opt := new(rcs3.Options)
+	if err := configstruct.Set(fs.ConfigMap(info, name), opt); err != nil {
+		return nil, nil, err
+	}
+
+	cfg := aws.NewConfig().WithS3ForcePathStyle(opt.ForcePathStyle)
+	if opt.Region != "" {
+		cfg.WithRegion(opt.Region)
+	} else {
+		cfg.WithRegion("us-east-1")
+	}
+	if opt.Endpoint != "" {
+		cfg.WithEndpoint(opt.Endpoint)
+	}
+	if opt.AccessKeyID != "" {
+		cfg.WithCredentials(credentials.NewStaticCredentials(opt.AccessKeyID, opt.SecretAccessKey, opt.SessionToken))
+	}
+
+	s, err := session.NewSessionWithOptions(session.Options{
+		Config:            *cfg,
+		SharedConfigState: session.SharedConfigEnable,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return s3.New(s), opt, nil
+}
+
+// IsObjectLockedError returns true if err was caused by deleting object
+// protected by S3 Object Lock, Azure immutability policy or GCS bucket
+// retention policy.
+func IsObjectLockedError(err error) bool {
+	// S3 does not have a dedicated error code, it returns AccessDenied
+	// with message stating that object is protected by object lock.
+	var s3err awserr.RequestFailure
+	if errors.As(err, &s3err) {
+		return s3err.StatusCode() == http.StatusForbidden && s3err.Code() == "AccessDenied" &&
+			strings.Contains(strings.ToLower(s3err.Message()), "object lock")
+	}
+	var azerr azblob.StorageError
+	if errors.As(err, &azerr) {
+		return azerr.ServiceCode() == "BlobImmutableDueToPolicy"
+	}
+	var gcserr *googleapi.Error
+	if errors.As(err, &gcserr) {
+		if gcserr.Code != http.StatusForbidden {
+			return false
+		}
+		for _, e := range gcserr.Errors {
+			if e.Reason == "retentionPolicyNotMet" {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func isAWSErrorCode(err error, code string) bool {
+	var aerr awserr.Error
+	return errors.As(err, &aerr) && aerr.Code() == code
+}
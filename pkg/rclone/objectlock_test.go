@@ -0,0 +1,93 @@
+// Copyright (C) 2024 ScyllaDB
+
+package rclone
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/Azure/azure-storage-blob-go/azblob"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/pkg/errors"
+	"google.golang.org/api/googleapi"
+)
+
+// azureError implements azblob.StorageError.
+type azureError struct {
+	code azblob.ServiceCodeType
+}
+
+func (e azureError) Error() string                       { return string(e.code) }
+func (e azureError) Timeout() bool                       { return false }
+func (e azureError) Temporary() bool                     { return false }
+func (e azureError) Response() *http.Response            { return nil }
+func (e azureError) ServiceCode() azblob.ServiceCodeType { return e.code }
+
+func TestIsObjectLockedError(t *testing.T) {
+	s3Error := func(code, msg string, status int) error {
+		return awserr.NewRequestFailure(awserr.New(code, msg, nil), status, "request-id")
+	}
+	gcsError := func(status int, reason string) error {
+		return &googleapi.Error{Code: status, Errors: []googleapi.ErrorItem{{Reason: reason}}}
+	}
+
+	table := []struct {
+		Name   string
+		Err    error
+		Locked bool
+	}{
+		{
+			Name: "nil",
+		},
+		{
+			Name:   "S3 object lock",
+			Err:    s3Error("AccessDenied", "Access Denied because object protected by object lock.", http.StatusForbidden),
+			Locked: true,
+		},
+		{
+			Name:   "S3 object lock wrapped",
+			Err:    errors.Wrap(s3Error("AccessDenied", "Access Denied because object protected by object lock.", http.StatusForbidden), "failed to delete 1 files"),
+			Locked: true,
+		},
+		{
+			Name: "S3 access denied",
+			Err:  s3Error("AccessDenied", "Access Denied", http.StatusForbidden),
+		},
+		{
+			Name: "S3 other error mentioning object lock",
+			Err:  s3Error("InvalidRequest", "Bucket is missing object lock configuration", http.StatusBadRequest),
+		},
+		{
+			Name:   "Azure immutability policy",
+			Err:    azureError{code: "BlobImmutableDueToPolicy"},
+			Locked: true,
+		},
+		{
+			Name: "Azure authorization failure",
+			Err:  azureError{code: "AuthorizationPermissionMismatch"},
+		},
+		{
+			Name:   "GCS retention policy",
+			Err:    gcsError(http.StatusForbidden, "retentionPolicyNotMet"),
+			Locked: true,
+		},
+		{
+			Name: "GCS forbidden",
+			Err:  gcsError(http.StatusForbidden, "forbidden"),
+		},
+		{
+			Name: "GCS retention policy reason with other status",
+			Err:  gcsError(http.StatusBadRequest, "retentionPolicyNotMet"),
+		},
+		{
+			Name: "untyped error with matching message",
+			Err:  errors.New("AccessDenied: object protected by object lock, BlobImmutableDueToPolicy, retentionPolicyNotMet"),
+		},
+	}
+
+	for _, test := range table {
+		if v := IsObjectLockedError(test.Err); v != test.Locked {
+			t.Errorf("%s: IsObjectLockedError(%v) = %v, expected %v", test.Name, test.Err, v, test.Locked)
+		}
+	}
+}
@@ -179,3 +179,20 @@ func (o *AzureOptions) AutoFill() {
 		o.UseMsi = _true
 	}
 }
+
+// NFSOptions specifies network file system mounted on the node.
+// Buckets are directories in the mount point directory.
+type NFSOptions struct {
+	// Path to the directory where network file system is mounted.
+	Path string `yaml:"path"`
+}
+
+// Enabled returns true if mount point is set.
+func (o NFSOptions) Enabled() bool {
+	return o.Path != ""
+}
+
+// Enabled returns true if SFTP host is set.
+func (o SFTPOptions) Enabled() bool {
+	return o.Host != ""
+}
@@ -9,9 +9,11 @@ import (
 
 	"github.com/pkg/errors"
 	"github.com/rclone/rclone/fs"
+	"github.com/rclone/rclone/fs/config/obscure"
 	"github.com/scylladb/go-reflectx"
 	"github.com/scylladb/go-set/strset"
 	"github.com/scylladb/scylla-manager/v3/pkg/rclone/backend/localdir"
+	"github.com/scylladb/scylla-manager/v3/pkg/rclone/backend/nfs"
 	"go.uber.org/multierr"
 )
 
@@ -96,6 +98,52 @@ func RegisterAzureProvider(opts AzureOptions) error {
 	return errors.Wrap(registerProvider(name, backend, opts), "register provider")
 }
 
+// RegisterNFSProvider must be called before server is started.
+// It allows for adding dynamically adding nfs provider rooted at the NFS
+// mount point. Unlike localdir providers it's treated as remote storage.
+// Provider is not registered if mount point is not set.
+func RegisterNFSProvider(opts NFSOptions) error {
+	const name = "nfs"
+
+	if !opts.Enabled() {
+		return nil
+	}
+	if _, err := os.Stat(opts.Path); os.IsNotExist(err) {
+		return errors.Wrapf(err, "register nfs provider %s", opts.Path)
+	}
+	nfs.Init(name, "Mounted network file system", opts.Path)
+
+	return errors.Wrap(registerProvider(name, name, LocalOptions{}), "register provider")
+}
+
+// RegisterSFTPProvider must be called before server is started.
+// It allows for adding dynamically adding sftp provider named sftp.
+// Provider is not registered if host is not set.
+func RegisterSFTPProvider(opts SFTPOptions) error {
+	const (
+		name    = "sftp"
+		backend = "sftp"
+	)
+
+	if !opts.Enabled() {
+		return nil
+	}
+
+	// Rclone expects passwords to be obscured in config
+	for _, p := range []*string{&opts.Pass, &opts.KeyFilePass} {
+		if *p == "" {
+			continue
+		}
+		v, err := obscure.Obscure(*p)
+		if err != nil {
+			return errors.Wrap(err, "obscure password")
+		}
+		*p = v
+	}
+
+	return errors.Wrap(registerProvider(name, backend, opts), "register provider")
+}
+
 func registerProvider(name, backend string, options interface{}) error {
 	var (
 		m     = reflectx.NewMapper("yaml").FieldMap(reflect.ValueOf(options))
@@ -110,7 +158,7 @@ func registerProvider(name, backend string, options interface{}) error {
 	for key, rval := range m {
 		if s := rval.String(); s != "" {
 			errs = multierr.Append(errs, fs.ConfigFileSet(name, key, s))
-			if strings.Contains(key, "secret") || strings.Contains(key, "key") {
+			if strings.Contains(key, "secret") || strings.Contains(key, "key") || strings.Contains(key, "pass") {
 				extra = append(extra, key+"="+strings.Repeat("*", len(s)))
 			} else {
 				extra = append(extra, key+"="+s)
@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"github.com/rclone/rclone/fs"
+	"github.com/rclone/rclone/fs/config/obscure"
 )
 
 func TestRegisterS3ProviderSetsS3Options(t *testing.T) {
@@ -51,3 +52,25 @@ func TestRegisterS3ProviderSetsS3Options(t *testing.T) {
 		}
 	}
 }
+
+func TestRegisterSFTPProviderObscuresPassword(t *testing.T) {
+	InitFsConfig()
+
+	opts := SFTPOptions{
+		Host: "a",
+		User: "b",
+		Pass: "c",
+	}
+
+	if err := RegisterSFTPProvider(opts); err != nil {
+		t.Fatal("RegisterSFTPProvider() error", err)
+	}
+
+	if v, _ := fs.ConfigFileGet("sftp", "host"); v != "a" {
+		t.Errorf("ConfigFileGet(host) = %s, expected %s", v, "a")
+	}
+	v, _ := fs.ConfigFileGet("sftp", "pass")
+	if p, err := obscure.Reveal(v); err != nil || p != "c" {
+		t.Errorf("Reveal(ConfigFileGet(pass)) = %s, %v expected %s", p, err, "c")
+	}
+}
@@ -3,6 +3,7 @@
 package rclone
 
 import (
+	"github.com/pkg/errors"
 	"github.com/rclone/rclone/fs"
 	"github.com/rclone/rclone/fs/accounting"
 )
@@ -17,3 +18,35 @@ func SetRateLimit(mib int) {
 	}
 	accounting.TokenBucket.SetBwLimit(bw)
 }
+
+// ParseBandwidthProfile parses bandwidth timetable in the rclone --bwlimit
+// format e.g. "08:00,50M 18:00,off". Time of day is in local time.
+// Empty profile is unlimited.
+func ParseBandwidthProfile(s string) (fs.BwTimetable, error) {
+	if s == "" {
+		return nil, nil
+	}
+	var tt fs.BwTimetable
+	if err := tt.Set(s); err != nil {
+		return nil, errors.Wrapf(err, "invalid bandwidth profile %q", s)
+	}
+	return tt, nil
+}
+
+// MinBandwidth returns the lower of the two bandwidth limits, unset limit
+// is unlimited.
+func MinBandwidth(a, b fs.BwPair) fs.BwPair {
+	minSize := func(x, y fs.SizeSuffix) fs.SizeSuffix {
+		if x <= 0 {
+			return y
+		}
+		if y <= 0 || x < y {
+			return x
+		}
+		return y
+	}
+	return fs.BwPair{
+		Tx: minSize(a.Tx, b.Tx),
+		Rx: minSize(a.Rx, b.Rx),
+	}
+}
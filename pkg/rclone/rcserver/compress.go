@@ -0,0 +1,65 @@
+// Copyright (C) 2024 ScyllaDB
+
+package rcserver
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/pkg/errors"
+	"github.com/rclone/rclone/fs"
+	"github.com/rclone/rclone/fs/rc"
+	"github.com/scylladb/scylla-manager/v3/pkg/rclone/compress"
+)
+
+// CompressionHeader is the HTTP header carrying name of the backup compression
+// codec. When it's set, compressible SSTable components uploaded to remote
+// locations are compressed with the codec, and compressed objects read from
+// remote locations are decompressed.
+const CompressionHeader = "X-Backup-Compression"
+
+// compressionParam is the name of the internal parameter passing the codec
+// to rc functions.
+const compressionParam = "_compression"
+
+// setCompression parses compression header and sets it as internal parameter.
+func setCompression(r *http.Request, extra rc.Params) error {
+	v := r.Header.Get(CompressionHeader)
+	if v == "" {
+		return nil
+	}
+	c, err := compress.ParseCodec(v)
+	if err != nil {
+		return errors.Wrap(err, "parse compression header")
+	}
+	extra[compressionParam] = c
+	return nil
+}
+
+// withCompression wraps remote f with compression if codec was set.
+// Local file systems are never compressed.
+func withCompression(ctx context.Context, in rc.Params, f fs.Fs) fs.Fs {
+	c, ok := in[compressionParam].(compress.Codec)
+	if !ok || f.Features().IsLocal {
+		return f
+	}
+	return compress.NewFs(ctx, f, c)
+}
+
+// withBackupFs wraps remote f with compression and encryption as requested.
+// Data is compressed before it's encrypted, uploads of transformed data are
+// resumable.
+func withBackupFs(ctx context.Context, in rc.Params, f fs.Fs) fs.Fs {
+	return withCompression(ctx, in, withEncryption(ctx, in, withResumableUploads(f)))
+}
+
+// withSingleThreadCopy disables multi-thread copy if compression is used,
+// compressed objects can only be read sequentially.
+func withSingleThreadCopy(ctx context.Context, in rc.Params) context.Context {
+	if _, ok := in[compressionParam]; !ok {
+		return ctx
+	}
+	ctx, ci := fs.AddConfig(ctx)
+	ci.MultiThreadStreams = 0
+	return ctx
+}
@@ -0,0 +1,67 @@
+// Copyright (C) 2024 ScyllaDB
+
+package rcserver
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/pkg/errors"
+	"github.com/rclone/rclone/fs"
+	rcops "github.com/rclone/rclone/fs/operations"
+	"github.com/rclone/rclone/fs/rc"
+	"github.com/scylladb/scylla-manager/v3/pkg/rclone/crypt"
+)
+
+// EncryptionKeyHeader is the HTTP header carrying base64 encoded backup
+// encryption key. When it's set, objects uploaded to remote locations are
+// encrypted with the key, and encrypted objects read from remote locations
+// are decrypted.
+const EncryptionKeyHeader = "X-Backup-Encryption-Key"
+
+// encryptionKeyParam is the name of the internal parameter passing the key
+// to rc functions, it's not a part of input so that it's never logged.
+const encryptionKeyParam = "_encryption_key"
+
+// setEncryptionKey parses encryption key header and sets it as internal parameter.
+func setEncryptionKey(r *http.Request, extra rc.Params) error {
+	v := r.Header.Get(EncryptionKeyHeader)
+	if v == "" {
+		return nil
+	}
+	k, err := crypt.ParseKey(v)
+	if err != nil {
+		return errors.Wrap(err, "parse encryption key header")
+	}
+	extra[encryptionKeyParam] = k
+	return nil
+}
+
+// withEncryption wraps remote f with encryption if encryption key was set.
+// Local file systems are never encrypted.
+func withEncryption(ctx context.Context, in rc.Params, f fs.Fs) fs.Fs {
+	k, ok := in[encryptionKeyParam].(crypt.Key)
+	if !ok || f.Features().IsLocal {
+		return f
+	}
+	return crypt.NewFs(ctx, f, k)
+}
+
+// rcCopyFile copies single file from source to destination.
+func rcCopyFile(ctx context.Context, in rc.Params) (rc.Params, error) {
+	srcFs, srcRemote, err := rc.GetFsAndRemoteNamed(ctx, in, "srcFs", "srcRemote")
+	if err != nil {
+		return nil, err
+	}
+	dstFs, dstRemote, err := rc.GetFsAndRemoteNamed(ctx, in, "dstFs", "dstRemote")
+	if err != nil {
+		return nil, err
+	}
+	ctx = withSingleThreadCopy(ctx, in)
+	return nil, rcops.CopyFile(ctx, withBackupFs(ctx, in, dstFs), withBackupFs(ctx, in, srcFs), dstRemote, srcRemote)
+}
+
+func init() {
+	c := rc.Calls.Get("operations/copyfile")
+	c.Fn = rcCopyFile
+}
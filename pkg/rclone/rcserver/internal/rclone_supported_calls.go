@@ -17,6 +17,7 @@ var RcloneSupportedCalls = strset.New(
 	"job/stop",
 	"operations/about",
 	"operations/check-permissions",
+	"operations/checksum",
 	"operations/copyfile",
 	"operations/deletefile",
 	"operations/deletepaths",
@@ -24,6 +25,7 @@ var RcloneSupportedCalls = strset.New(
 	"operations/list",
 	"operations/movefile",
 	"operations/purge",
+	"operations/retention",
 	"sync/copydir",
 	"sync/copypaths",
 	"sync/movedir",
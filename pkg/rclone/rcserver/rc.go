@@ -300,7 +300,8 @@ func rcCat(ctx context.Context, in rc.Params) (out rc.Params, err error) {
 	if err != nil {
 		return nil, err
 	}
-	o, err := f.NewObject(ctx, remote)
+	bf := withBackupFs(ctx, in, f)
+	o, err := bf.NewObject(ctx, remote)
 	if err != nil {
 		return nil, err
 	}
@@ -316,7 +317,10 @@ func rcCat(ctx context.Context, in rc.Params) (out rc.Params, err error) {
 
 	h := w.Header()
 	h.Set("Content-Type", "application/octet-stream")
-	h.Set("Content-Length", fmt.Sprint(o.Size()))
+	// Size of encrypted or compressed object is not known until it's read
+	if bf == f {
+		h.Set("Content-Length", fmt.Sprint(o.Size()))
+	}
 
 	n, err := io.Copy(w, r)
 	if err != nil {
@@ -355,6 +359,7 @@ func rcPut(ctx context.Context, in rc.Params) (out rc.Params, err error) {
 	if err != nil {
 		return nil, err
 	}
+	f = withBackupFs(ctx, in, f)
 
 	r, err := in.GetHTTPRequest()
 	if err != nil {
@@ -537,7 +542,7 @@ func rcMoveOrCopyDir(doMove bool) func(ctx context.Context, in rc.Params) (rc.Pa
 			return nil, err
 		}
 
-		return nil, sync.CopyDir2(ctx, dstFs, dstRemote, srcFs, srcRemote, doMove)
+		return nil, sync.CopyDir2(ctx, withBackupFs(ctx, in, dstFs), dstRemote, srcFs, srcRemote, doMove)
 	}
 }
 
@@ -563,7 +568,8 @@ func rcCopyPaths() func(ctx context.Context, in rc.Params) (rc.Params, error) {
 		if err := setGuardedConfig(in); err != nil {
 			return nil, err
 		}
-		return nil, sync.CopyPaths(ctx, dstFs, dstRemote, srcFs, srcRemote, paths, false)
+		ctx = withSingleThreadCopy(ctx, in)
+		return nil, sync.CopyPaths(ctx, dstFs, dstRemote, withBackupFs(ctx, in, srcFs), srcRemote, paths, false)
 	}
 }
 
@@ -622,6 +628,13 @@ func rcDeletePaths(ctx context.Context, in rc.Params) (out rc.Params, err error)
 	ctx = accounting.WithStatsGroup(ctx, "deletepaths/"+group)
 
 	err = rcops.Delete(ctx, f)
+	if err != nil {
+		// Delete only reports the number of failed deletes,
+		// add the last error so that the cause is not lost.
+		if lastErr := accounting.Stats(ctx).GetLastError(); lastErr != nil {
+			err = errors.Wrap(lastErr, err.Error())
+		}
+	}
 	out = make(rc.Params)
 	out["deletes"] = accounting.Stats(ctx).Deletes(0)
 
@@ -633,6 +646,69 @@ func rcDeletePaths(ctx context.Context, in rc.Params) (out rc.Params, err error)
 	return out, multierr.Combine(err, statsDeleteErr)
 }
 
+// rcRetention returns rc function that sets object lock retention of paths
+// in remote directory.
+func rcRetention(ctx context.Context, in rc.Params) (out rc.Params, err error) {
+	f, remote, err := rc.GetFsAndRemote(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	paths, err := getStringSlice(in, "paths")
+	if err != nil {
+		return nil, err
+	}
+	mode, err := in.GetString("mode")
+	if err != nil {
+		return nil, err
+	}
+	v, err := in.GetString("retain_until")
+	if err != nil {
+		return nil, err
+	}
+	retainUntil, err := time.Parse(time.RFC3339, v)
+	if err != nil {
+		return nil, errors.Wrap(err, "parse retain_until")
+	}
+
+	n, err := rclone.SetObjectRetention(ctx, f, remote, paths, mode, retainUntil)
+	out = rc.Params{"retained": n}
+	return out, err
+}
+
+// rcChecksum returns checksums of content of paths in remote directory.
+// Remote objects are decrypted and decompressed before they are checksummed.
+func rcChecksum(ctx context.Context, in rc.Params) (out rc.Params, err error) {
+	f, remote, err := rc.GetFsAndRemote(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	paths, err := getStringSlice(in, "paths")
+	if err != nil {
+		return nil, err
+	}
+	digest, err := in.GetBool("digest")
+	if err != nil && !rc.IsErrParamNotFound(err) {
+		return nil, err
+	}
+
+	// Run checksum in a separate group so that
+	// the stats won't mix with other rclone calls.
+	group := "checksum/" + uuid.NewTime().String()
+	ctx = accounting.WithStatsGroup(ctx, group)
+
+	checksums, err := rclone.Checksums(ctx, withBackupFs(ctx, in, f), remote, paths, digest)
+	if err == nil {
+		out = rc.Params{"checksums": checksums}
+	}
+
+	// Delete stats of created group
+	statsDeleteIn := make(rc.Params)
+	statsDeleteIn["group"] = group
+	_, statsDeleteErr := rc.Calls.Get("core/stats-delete").Fn(ctx, statsDeleteIn)
+
+	return out, multierr.Combine(err, statsDeleteErr)
+}
+
 // rcTransfers sets the default amount of transfers.
 // This change is not persisted after server restart.
 // Transfers correspond to the number of file transfers to run in parallel.
@@ -653,7 +729,38 @@ func rcTransfers(_ context.Context, in rc.Params) (out rc.Params, err error) {
 	return out, nil
 }
 
+// rcloneBwlimit is the rclone core/bwlimit call, it's replaced by rcBwlimit.
+var rcloneBwlimit = rc.Calls.Get("core/bwlimit")
+
+// rcBwlimit sets bandwidth limit or bandwidth profile.
+// This change is not persisted after server restart.
+// If the rate parameter is not supplied then the current limit is queried.
+func rcBwlimit(ctx context.Context, in rc.Params) (out rc.Params, err error) {
+	if in["rate"] != nil {
+		rate, err := in.GetString("rate")
+		if err != nil {
+			return out, err
+		}
+		if err := SetBandwidthLimit(rate); err != nil {
+			return nil, err
+		}
+	}
+	return rcloneBwlimit.Fn(ctx, rc.Params{})
+}
+
 func init() {
+	rc.Add(rc.Call{
+		Path:         "core/bwlimit",
+		AuthRequired: true,
+		Fn:           rcBwlimit,
+		Title:        "Set the bandwidth limit or bandwidth profile",
+		Help: `This takes the following parameters:
+
+- rate - bandwidth limit e.g. "10M" or bandwidth profile e.g. "08:00,10M 18:00,off"
+
+The limit set in agent config bandwidth_profile is the upper bound of the rate.`,
+	})
+
 	rc.Add(rc.Call{
 		Path:         "core/transfers",
 		AuthRequired: true,
@@ -752,6 +859,37 @@ func init() {
 - remote - a directory path within that remote
 - paths - slice of paths to be deleted from remote directory`,
 	})
+
+	rc.Add(rc.Call{
+		Path:         "operations/retention",
+		AuthRequired: true,
+		Fn:           rcRetention,
+		Title:        "Set object lock retention of paths in remote directory",
+		Help: `This takes the following parameters:
+
+- fs - a remote name string eg "s3:"
+- remote - a directory path within that remote
+- paths - slice of paths relative to remote directory
+- mode - object lock mode, governance or compliance
+- retain_until - date in RFC3339 format until objects are retained`,
+	})
+
+	rc.Add(rc.Call{
+		Path:         "operations/checksum",
+		AuthRequired: true,
+		Fn:           rcChecksum,
+		Title:        "Get checksums of content of paths in remote directory",
+		Help: `This takes the following parameters:
+
+- fs - a remote name string eg "s3:"
+- remote - a directory path within that remote
+- paths - slice of paths relative to remote directory
+- digest - read checksums of SSTable Data components from Digest.crc32 components
+
+Returns
+
+- checksums - map of paths to hex encoded CRC32 checksums`,
+	})
 }
 
 // rcCalls contains the original rc.Calls before filtering with all the added
@@ -5,11 +5,13 @@ package rcserver
 import (
 	"context"
 	"sync"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/rclone/rclone/fs"
 	"github.com/rclone/rclone/fs/cache"
 	"github.com/rclone/rclone/fs/rc"
+	"github.com/scylladb/scylla-manager/v3/pkg/rclone"
 	"go.uber.org/atomic"
 )
 
@@ -18,7 +20,7 @@ import (
 var globalConfigGuard = &configGuard{}
 
 // configGuard is a tool for performing global config changes.
-// It supports setting transfers and bandwidth limit.
+// It supports setting transfers and bandwidth limit or profile.
 // It does not re-set config values if they are already
 // set to the desired value.
 type configGuard struct {
@@ -28,6 +30,15 @@ type configGuard struct {
 	defaultTransfers int
 	transfers        int
 	bandwidthLimit   string
+	// bandwidthProfile is parsed bandwidthLimit.
+	bandwidthProfile fs.BwTimetable
+	// agentBandwidthProfile is the upper bound of bandwidth from agent config.
+	agentBandwidthProfile fs.BwTimetable
+	// throttleBandwidth is the upper bound of bandwidth set by adaptive
+	// throttling, it's not set if node is not throttled.
+	throttleBandwidth fs.SizeSuffix
+	// bandwidth is the limit currently set in token bucket.
+	bandwidth fs.BwPair
 }
 
 func (cg *configGuard) init() {
@@ -36,6 +47,7 @@ func (cg *configGuard) init() {
 		cg.defaultTransfers = defaultTransfers
 		cg.transfers = defaultTransfers
 		cg.bandwidthLimit = ""
+		cg.bandwidth = fs.BwPair{Tx: -1, Rx: -1}
 	}
 }
 
@@ -72,6 +84,9 @@ func SetTransfers(transfers int) error {
 }
 
 // SetBandwidthLimit sets global bandwidth limit in token bucket.
+// Limit can be a bandwidth profile in the rclone --bwlimit timetable format
+// e.g. "08:00,50M 18:00,off", the limit is then switched at time slot
+// boundaries by RunBandwidthScheduler without interrupting running transfers.
 func SetBandwidthLimit(limit string) error {
 	globalConfigGuard.mu.Lock()
 	defer globalConfigGuard.mu.Unlock()
@@ -81,12 +96,103 @@ func SetBandwidthLimit(limit string) error {
 		return nil
 	}
 
-	in := rc.Params{
-		"rate": limit,
+	tt, err := rclone.ParseBandwidthProfile(limit)
+	if err != nil {
+		return err
 	}
-	_, err := rcCalls.Get("core/bwlimit").Fn(context.Background(), in)
+	globalConfigGuard.bandwidthLimit = limit
+	globalConfigGuard.bandwidthProfile = tt
+
+	return globalConfigGuard.applyBandwidthLimit(time.Now())
+}
+
+// SetAgentBandwidthProfile sets bandwidth profile from agent config.
+// It's the upper bound of the bandwidth limit set with SetBandwidthLimit.
+func SetAgentBandwidthProfile(profile string) error {
+	globalConfigGuard.mu.Lock()
+	defer globalConfigGuard.mu.Unlock()
+	globalConfigGuard.init()
+
+	tt, err := rclone.ParseBandwidthProfile(profile)
 	if err != nil {
-		return errors.Wrapf(err, "set bandwidth to %s", limit)
+		return err
+	}
+	globalConfigGuard.agentBandwidthProfile = tt
+
+	return globalConfigGuard.applyBandwidthLimit(time.Now())
+}
+
+// SetThrottleBandwidth sets the upper bound of bandwidth set by adaptive
+// throttling. Set to 0 to stop throttling.
+func SetThrottleBandwidth(limit fs.SizeSuffix) error {
+	globalConfigGuard.mu.Lock()
+	defer globalConfigGuard.mu.Unlock()
+	globalConfigGuard.init()
+
+	globalConfigGuard.throttleBandwidth = limit
+
+	return globalConfigGuard.applyBandwidthLimit(time.Now())
+}
+
+// BandwidthLimit returns the lower of the requested and agent profile limits
+// at the current time, it does not take adaptive throttling into account.
+func BandwidthLimit() fs.BwPair {
+	globalConfigGuard.mu.Lock()
+	defer globalConfigGuard.mu.Unlock()
+	globalConfigGuard.init()
+
+	return globalConfigGuard.profileBandwidthLimit(time.Now())
+}
+
+// RunBandwidthScheduler switches bandwidth limit in token bucket according
+// to the bandwidth profiles every minute until ctx is canceled.
+func RunBandwidthScheduler(ctx context.Context) {
+	t := time.NewTicker(time.Minute)
+	defer t.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-t.C:
+			globalConfigGuard.mu.Lock()
+			globalConfigGuard.init()
+			if err := globalConfigGuard.applyBandwidthLimit(now); err != nil {
+				fs.Errorf(nil, "Failed to switch bandwidth limit: %s", err)
+			}
+			globalConfigGuard.mu.Unlock()
+		}
+	}
+}
+
+func (cg *configGuard) profileBandwidthLimit(now time.Time) fs.BwPair {
+	return rclone.MinBandwidth(
+		cg.bandwidthProfile.LimitAt(now).Bandwidth,
+		cg.agentBandwidthProfile.LimitAt(now).Bandwidth,
+	)
+}
+
+// applyBandwidthLimit sets the lowest of the requested, agent profile and
+// throttling limits at the given time in token bucket if it changed.
+// It must be called with mu held.
+func (cg *configGuard) applyBandwidthLimit(now time.Time) error {
+	bw := rclone.MinBandwidth(
+		cg.profileBandwidthLimit(now),
+		fs.BwPair{Tx: cg.throttleBandwidth, Rx: cg.throttleBandwidth},
+	)
+	if !bw.IsSet() {
+		bw = fs.BwPair{Tx: -1, Rx: -1}
+	}
+	if bw == cg.bandwidth {
+		return nil
+	}
+
+	in := rc.Params{
+		"rate": bw.String(),
+	}
+	if _, err := rcloneBwlimit.Fn(context.Background(), in); err != nil {
+		return errors.Wrapf(err, "set bandwidth to %s", bw.String())
 	}
+	cg.bandwidth = bw
 	return nil
 }
@@ -0,0 +1,55 @@
+// Copyright (C) 2024 ScyllaDB
+
+package rcserver
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/rclone/rclone/fs"
+	"github.com/rclone/rclone/fs/rc"
+	"github.com/scylladb/scylla-manager/v3/pkg/rclone"
+)
+
+func TestConfigGuardApplyBandwidthLimit(t *testing.T) {
+	mustParse := func(s string) fs.BwTimetable {
+		tt, err := rclone.ParseBandwidthProfile(s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return tt
+	}
+	at := func(hh, mm int) time.Time {
+		return time.Date(2024, 1, 30, hh, mm, 0, 0, time.Local)
+	}
+
+	cg := &configGuard{}
+	cg.init()
+	cg.bandwidthProfile = mustParse("08:00,50M 18:00,off")
+	cg.agentBandwidthProfile = mustParse("12:00,10M 13:00,off")
+
+	table := []struct {
+		Time time.Time
+		Rate string
+	}{
+		{Time: at(7, 59), Rate: "off"},
+		{Time: at(8, 0), Rate: "50M"},
+		{Time: at(12, 30), Rate: "10M"},
+		{Time: at(13, 0), Rate: "50M"},
+		{Time: at(18, 0), Rate: "off"},
+	}
+
+	for _, test := range table {
+		if err := cg.applyBandwidthLimit(test.Time); err != nil {
+			t.Fatal(err)
+		}
+		out, err := rcloneBwlimit.Fn(context.Background(), rc.Params{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if out["rate"] != test.Rate {
+			t.Errorf("At %s rate = %s, expected %s", test.Time.Format("15:04"), out["rate"], test.Rate)
+		}
+	}
+}
@@ -90,6 +90,8 @@ func (s Server) writeError(path string, in rc.Params, w http.ResponseWriter, err
 			status = http.StatusBadRequest
 		case isForbiddenErr(err):
 			status = http.StatusForbidden
+		case rclone.IsObjectLockedError(err):
+			status = http.StatusLocked
 		}
 	}
 	// Try to parse xml errors for increased readability
@@ -228,6 +230,14 @@ func (s Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		s.writeError(path, in, w, err, http.StatusBadRequest)
 		return
 	}
+	if err := setEncryptionKey(r, extra); err != nil {
+		s.writeError(path, in, w, err, http.StatusBadRequest)
+		return
+	}
+	if err := setCompression(r, extra); err != nil {
+		s.writeError(path, in, w, err, http.StatusBadRequest)
+		return
+	}
 
 	// Check to see if it is async or not
 	isAsync, err := in.GetBool("_async")
@@ -21,6 +21,8 @@ import (
 
 	"github.com/rclone/rclone/fs/rc"
 	"github.com/scylladb/scylla-manager/v3/pkg/rclone"
+	"github.com/scylladb/scylla-manager/v3/pkg/rclone/compress"
+	"github.com/scylladb/scylla-manager/v3/pkg/rclone/crypt"
 	"github.com/scylladb/scylla-manager/v3/pkg/rclone/rcserver/internal"
 )
 
@@ -405,6 +407,75 @@ func TestOperationsListPermissionError(t *testing.T) {
 	}
 }
 
+func TestSyncCopyDirToNFSEncryptedAndCompressed(t *testing.T) {
+	localDir, err := ioutil.TempDir("", "scylla-manager-agent-")
+	if err != nil {
+		t.Fatal(err, "create local tmp directory")
+	}
+	defer os.RemoveAll(localDir)
+	nfsDir, err := ioutil.TempDir("", "scylla-manager-agent-nfs-")
+	if err != nil {
+		t.Fatal(err, "create nfs tmp directory")
+	}
+	defer os.RemoveAll(nfsDir)
+
+	const name = "md-1-big-Index.db"
+	data := bytes.Repeat([]byte("index "), 1024)
+	if err := os.WriteFile(path.Join(localDir, name), data, 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	rclone.InitFsConfig()
+	rclone.MustRegisterLocalDirProvider("nfssrc", "testing provider", localDir)
+	if err := rclone.RegisterNFSProvider(rclone.NFSOptions{Path: nfsDir}); err != nil {
+		t.Fatal(err)
+	}
+
+	key, err := crypt.NewKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rcServer := New()
+
+	buf := bytes.NewBuffer(nil)
+	json.NewEncoder(buf).Encode(map[string]interface{}{
+		"srcFs":     "nfssrc:",
+		"srcRemote": "",
+		"dstFs":     "nfs:bucket",
+		"dstRemote": "sst",
+	})
+	req := httptest.NewRequest(http.MethodPost, "http://1.2.3.4/sync/copydir", buf)
+	req.Header.Add("Content-Type", "application/json")
+	req.Header.Add(EncryptionKeyHeader, key.Encode())
+	req.Header.Add(CompressionHeader, "zstd")
+	rec := httptest.NewRecorder()
+	rcServer.ServeHTTP(rec, req)
+
+	if rec.Result().StatusCode != http.StatusOK {
+		t.Fatalf("Expected %d status code, got %d: %s", http.StatusOK, rec.Result().StatusCode, rec.Body)
+	}
+
+	stored, err := os.ReadFile(path.Join(nfsDir, "bucket", "sst", name))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !crypt.IsEncrypted(stored) {
+		t.Fatal("Expected stored object to be encrypted")
+	}
+	r, err := crypt.NewDecrypter(key, bytes.NewReader(stored))
+	if err != nil {
+		t.Fatal(err)
+	}
+	decrypted, err := io.ReadAll(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !compress.IsCompressed(decrypted) {
+		t.Fatal("Expected stored object to be compressed")
+	}
+}
+
 // httpTest specifies expected request response cycle behavior needed to test
 // http handlers.
 type httpTest struct {
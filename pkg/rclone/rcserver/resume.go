@@ -0,0 +1,54 @@
+// Copyright (C) 2024 ScyllaDB
+
+package rcserver
+
+import (
+	"context"
+	"time"
+
+	"github.com/rclone/rclone/fs"
+	"github.com/scylladb/scylla-manager/v3/pkg/rclone/resume"
+)
+
+// uploadStore keeps states of multipart uploads, if it's nil uploads are not
+// resumable.
+var uploadStore *resume.Store
+
+// SetUploadStore enables resumable uploads with states kept in s.
+func SetUploadStore(s *resume.Store) {
+	uploadStore = s
+}
+
+// withResumableUploads wraps remote f with resumable uploads if enabled.
+// It must be the innermost wrapper, so that uploads of compressed and
+// encrypted data are resumed as well.
+func withResumableUploads(f fs.Fs) fs.Fs {
+	if uploadStore == nil || !resume.Supported(f) {
+		return f
+	}
+	return resume.NewFs(f, uploadStore)
+}
+
+// RunUploadStorePruner aborts multipart uploads started more than maxAge ago
+// every hour until context is canceled. Such uploads are unlikely to be
+// resumed, and their parts are billed as stored data.
+func RunUploadStorePruner(ctx context.Context, maxAge time.Duration) {
+	if uploadStore == nil {
+		return
+	}
+
+	t := time.NewTicker(time.Hour)
+	defer t.Stop()
+
+	for {
+		if err := uploadStore.Prune(ctx, maxAge); err != nil {
+			fs.Errorf(nil, "Failed to prune multipart uploads: %s", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-t.C:
+		}
+	}
+}
@@ -0,0 +1,318 @@
+// Copyright (C) 2024 ScyllaDB
+
+package resume
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Azure/azure-storage-blob-go/azblob"
+	"github.com/Azure/go-autorest/autorest/adal"
+	"github.com/pkg/errors"
+	rcazure "github.com/rclone/rclone/backend/azureblob"
+	"github.com/rclone/rclone/fs"
+	"github.com/rclone/rclone/fs/config/configstruct"
+	"github.com/rclone/rclone/lib/bucket"
+	"github.com/rclone/rclone/lib/env"
+)
+
+// Azure settings matching rclone azureblob backend.
+const (
+	azureMetaMtime         = "mtime"
+	azureTimeFormat        = "2006-01-02T15:04:05.000000000Z07:00"
+	azureDefaultEndpoint   = "blob.core.windows.net"
+	azureStorageResource   = "https://storage.azure.com/"
+	azureADEndpoint        = "https://login.microsoftonline.com/"
+	azureUploadConcurrency = 4
+	// azureMaxBlocks is the max number of blocks of a block blob.
+	azureMaxBlocks = 50000
+	// azureUploadIDLen is the length of random prefix of block IDs.
+	azureUploadIDLen = 16
+)
+
+// azureBackend uploads block blobs. The upload ID is the prefix of IDs of
+// blocks staged by the upload, staged blocks are committed when all parts
+// are uploaded.
+type azureBackend struct {
+	svc *azblob.ServiceURL
+	// cnt is set if SAS URL grants access to a single container.
+	cnt *azblob.ContainerURL
+	opt *rcazure.Options
+}
+
+var _ backend = &azureBackend{}
+
+var (
+	azureBackendsMu sync.Mutex
+	azureBackends   = make(map[string]*azureBackend)
+)
+
+// newAzureBackend returns backend of the azureblob remote with the given
+// name, clients are cached as they refresh tokens in the background.
+// It returns nil for the emulator and MSI identified by object ID.
+func newAzureBackend(name string) (backend, error) {
+	azureBackendsMu.Lock()
+	defer azureBackendsMu.Unlock()
+
+	if b, ok := azureBackends[name]; ok {
+		return b, nil
+	}
+
+	info, err := fs.Find("azureblob")
+	if err != nil {
+		return nil, err
+	}
+	opt := new(rcazure.Options)
+	if err := configstruct.Set(fs.ConfigMap(info, name), opt); err != nil {
+		return nil, err
+	}
+	if opt.Endpoint == "" {
+		opt.Endpoint = azureDefaultEndpoint
+	}
+	accountURL := fmt.Sprintf("https://%s.%s", opt.Account, opt.Endpoint)
+
+	var (
+		cred azblob.Credential
+		u    string
+	)
+	switch {
+	case opt.UseEmulator:
+		return nil, nil
+	case opt.UseMSI:
+		if opt.MSIObjectID != "" {
+			return nil, nil
+		}
+		cred, err = azureMSICredential(opt)
+		u = accountURL
+	case opt.Account != "" && opt.Key != "":
+		cred, err = azblob.NewSharedKeyCredential(opt.Account, opt.Key)
+		u = accountURL
+	case opt.SASURL != "":
+		cred = azblob.NewAnonymousCredential()
+		u = opt.SASURL
+	case opt.ServicePrincipalFile != "":
+		cred, err = azureServicePrincipalCredential(opt.ServicePrincipalFile)
+		u = accountURL
+	default:
+		return nil, errors.New("no authentication method configured")
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "create credentials")
+	}
+
+	pu, err := url.Parse(u)
+	if err != nil {
+		return nil, errors.Wrap(err, "parse storage URL")
+	}
+	p := azblob.NewPipeline(cred, azblob.PipelineOptions{})
+	b := &azureBackend{opt: opt}
+	if opt.SASURL != "" && azblob.NewBlobURLParts(*pu).ContainerName != "" {
+		cnt := azblob.NewContainerURL(*pu, p)
+		b.cnt = &cnt
+	} else {
+		svc := azblob.NewServiceURL(*pu, p)
+		b.svc = &svc
+	}
+
+	azureBackends[name] = b
+	return b, nil
+}
+
+// azureMSICredential returns credential with token of the managed identity.
+func azureMSICredential(opt *rcazure.Options) (azblob.Credential, error) {
+	endpoint, err := adal.GetMSIVMEndpoint()
+	if err != nil {
+		return nil, err
+	}
+	var spt *adal.ServicePrincipalToken
+	switch {
+	case opt.MSIClientID != "":
+		spt, err = adal.NewServicePrincipalTokenFromMSIWithUserAssignedID(endpoint, azureStorageResource, opt.MSIClientID)
+	case opt.MSIResourceID != "":
+		spt, err = adal.NewServicePrincipalTokenFromMSIWithIdentityResourceID(endpoint, azureStorageResource, opt.MSIResourceID)
+	default:
+		spt, err = adal.NewServicePrincipalTokenFromMSI(endpoint, azureStorageResource)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return azureTokenCredential(spt)
+}
+
+// azureServicePrincipalCredential returns credential with token of service
+// principal from file in format of rclone service_principal_file.
+func azureServicePrincipalCredential(file string) (azblob.Credential, error) {
+	b, err := os.ReadFile(env.ShellExpand(file))
+	if err != nil {
+		return nil, errors.Wrap(err, "read service principal file")
+	}
+	var creds struct {
+		AppID    string `json:"appId"`
+		Password string `json:"password"`
+		Tenant   string `json:"tenant"`
+	}
+	if err := json.Unmarshal(b, &creds); err != nil {
+		return nil, errors.Wrap(err, "parse service principal file")
+	}
+	oauthConfig, err := adal.NewOAuthConfig(azureADEndpoint, creds.Tenant)
+	if err != nil {
+		return nil, err
+	}
+	spt, err := adal.NewServicePrincipalToken(*oauthConfig, creds.AppID, creds.Password, azureStorageResource)
+	if err != nil {
+		return nil, err
+	}
+	return azureTokenCredential(spt)
+}
+
+// azureTokenCredential returns credential refreshing token spt a minute
+// before it expires.
+func azureTokenCredential(spt *adal.ServicePrincipalToken) (azblob.Credential, error) {
+	var refreshErr error
+	c := azblob.NewTokenCredential("", func(c azblob.TokenCredential) time.Duration {
+		if refreshErr = spt.Refresh(); refreshErr != nil {
+			fs.Errorf(nil, "Failed to refresh Azure token: %v", refreshErr)
+			return time.Minute
+		}
+		t := spt.Token()
+		c.SetToken(t.AccessToken)
+		if d := time.Until(t.Expires()) - time.Minute; d > 0 {
+			return d
+		}
+		return time.Minute
+	})
+	// Refresher is called right away by NewTokenCredential
+	if c.Token() == "" {
+		return nil, errors.Wrap(refreshErr, "get token")
+	}
+	return c, nil
+}
+
+func (b *azureBackend) cutoff() int64 {
+	return int64(b.opt.ChunkSize)
+}
+
+// partSize returns the chunk size, it's increased if the number of blocks
+// would exceed the limit.
+func (b *azureBackend) partSize(size int64) int64 {
+	ps := int64(b.opt.ChunkSize)
+	if size/ps >= azureMaxBlocks {
+		ps = (((size / azureMaxBlocks) >> 20) + 1) << 20
+	}
+	return ps
+}
+
+func (b *azureBackend) concurrency() int {
+	return azureUploadConcurrency
+}
+
+func (b *azureBackend) sequential() bool {
+	return false
+}
+
+func (b *azureBackend) split(p string) (container, key string) {
+	container, key = bucket.Split(p)
+	return b.opt.Enc.FromStandardName(container), b.opt.Enc.FromStandardPath(key)
+}
+
+func (b *azureBackend) blob(st *State) azblob.BlockBlobURL {
+	if b.cnt != nil {
+		return b.cnt.NewBlockBlobURL(st.Key)
+	}
+	return b.svc.NewContainerURL(st.Bucket).NewBlockBlobURL(st.Key)
+}
+
+// blockID returns ID of block with the given number, all IDs of a blob
+// must have the same length.
+func blockID(uploadID string, number int64) string {
+	return base64.StdEncoding.EncodeToString([]byte(fmt.Sprintf("%s%08d", uploadID, number)))
+}
+
+func (b *azureBackend) create(_ context.Context, _ *upload) (string, error) {
+	id := make([]byte, azureUploadIDLen/2)
+	if _, err := rand.Read(id); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(id), nil
+}
+
+// parts returns staged blocks of the upload, blocks are discarded by Azure
+// if they are not committed within a week.
+func (b *azureBackend) parts(ctx context.Context, _ *upload, st *State) (map[int64]Part, error) {
+	bl, err := b.blob(st).GetBlockList(ctx, azblob.BlockListUncommitted, azblob.LeaseAccessConditions{})
+	if isAzureBlobNotFound(err) {
+		return nil, errNoSuchUpload
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	listed := make(map[int64]Part)
+	for _, bb := range bl.UncommittedBlocks {
+		raw, err := base64.StdEncoding.DecodeString(bb.Name)
+		if err != nil {
+			continue
+		}
+		n, ok := strings.CutPrefix(string(raw), st.UploadID)
+		if !ok {
+			continue
+		}
+		number, err := strconv.ParseInt(n, 10, 64)
+		if err != nil {
+			continue
+		}
+		listed[number] = Part{
+			Number: number,
+			Size:   bb.Size,
+		}
+	}
+	return listed, nil
+}
+
+func (b *azureBackend) uploadPart(ctx context.Context, u *upload, p Part, buf []byte, _ int64) (string, error) {
+	sum, err := hex.DecodeString(p.MD5)
+	if err != nil {
+		return "", err
+	}
+	_, err = b.blob(u.state).StageBlock(ctx, blockID(u.state.UploadID, p.Number), bytes.NewReader(buf),
+		azblob.LeaseAccessConditions{}, sum, azblob.ClientProvidedKeyOptions{})
+	return "", err
+}
+
+func (b *azureBackend) complete(ctx context.Context, u *upload, parts []Part, _ int64) error {
+	ids := make([]string, len(parts))
+	for i := range parts {
+		ids[i] = blockID(u.state.UploadID, parts[i].Number)
+	}
+	h := azblob.BlobHTTPHeaders{
+		ContentType: fs.MimeType(ctx, u.src),
+	}
+	meta := azblob.Metadata{
+		azureMetaMtime: u.src.ModTime(ctx).Format(azureTimeFormat),
+	}
+	_, err := b.blob(u.state).CommitBlockList(ctx, ids, h, meta, azblob.BlobAccessConditions{},
+		azblob.AccessTierType(b.opt.AccessTier), nil, azblob.ClientProvidedKeyOptions{})
+	return err
+}
+
+// abort does nothing, staged blocks can't be deleted, they are discarded
+// when the blob is committed or after a week.
+func (b *azureBackend) abort(_ context.Context, _ *State) error {
+	return nil
+}
+
+func isAzureBlobNotFound(err error) bool {
+	var serr azblob.StorageError
+	return errors.As(err, &serr) && serr.ServiceCode() == azblob.ServiceCodeBlobNotFound
+}
@@ -0,0 +1,161 @@
+// Copyright (C) 2024 ScyllaDB
+
+// Package resume implements resumable multipart uploads.
+//
+// State of every multipart upload, the upload ID and uploaded parts,
+// is persisted in a Store while the upload is running. When upload of
+// an object fails, the multipart upload is not aborted, and the next
+// upload of the same object continues it. All parts are read again, but
+// only parts that differ from the uploaded ones are uploaded. Bytes of
+// resumed parts are reported as skipped in the transfer stats.
+//
+// S3 multipart uploads, GCS resumable uploads and Azure block blobs are
+// supported. Compressed and encrypted content is resumable as long as it's
+// the same on every upload, encryption uses the seed kept in the state,
+// see Fs.UploadSeed. Encrypted uploads are restarted with a new seed if
+// content of an uploaded part changed, or if the remote stores parts that
+// are not recorded in the state, so that nonces are never reused.
+package resume
+
+import (
+	"context"
+	"io"
+
+	"github.com/rclone/rclone/fs"
+	"github.com/scylladb/scylla-manager/v3/pkg/rclone/crypt"
+	"github.com/scylladb/scylla-manager/v3/pkg/util/timeutc"
+)
+
+// Fs wraps fs.Fs so that uploads of large objects are resumable.
+// Objects smaller than the upload cutoff of the remote are uploaded by
+// the wrapped Fs.
+type Fs struct {
+	fs.Fs
+	store    *Store
+	features *fs.Features
+}
+
+var (
+	_ fs.Fs          = &Fs{}
+	_ fs.PutStreamer = &Fs{}
+	_ fs.UnWrapper   = &Fs{}
+	_ crypt.Seeder   = &Fs{}
+)
+
+// Supported returns true if uploads to f can be resumed.
+func Supported(f fs.Fs) bool {
+	if f.Features().IsLocal {
+		return false
+	}
+	t, _ := fs.ConfigFileGet(f.Name(), "type")
+	for _, s := range supportedTypes {
+		if t == s {
+			return true
+		}
+	}
+	return false
+}
+
+// NewFs returns f wrapped with resumable uploads with states kept in store.
+func NewFs(f fs.Fs, store *Store) *Fs {
+	w := &Fs{
+		Fs:    f,
+		store: store,
+	}
+	w.features = f.Features().Wrap(w)
+	if w.features.PutStream != nil {
+		w.features.PutStream = w.PutStream
+	}
+	return w
+}
+
+// String returns a description of the Fs.
+func (f *Fs) String() string {
+	return f.Fs.String()
+}
+
+// Features returns the optional features of this Fs.
+func (f *Fs) Features() *fs.Features {
+	return f.features
+}
+
+// UnWrap returns the wrapped Fs.
+func (f *Fs) UnWrap() fs.Fs {
+	return f.Fs
+}
+
+type putFn func(ctx context.Context, in io.Reader, src fs.ObjectInfo, options ...fs.OpenOption) (fs.Object, error)
+
+// put uploads in with resumable upload, uploads that are not resumable
+// are done with put.
+func (f *Fs) put(ctx context.Context, in io.Reader, src fs.ObjectInfo, options []fs.OpenOption, put putFn) (fs.Object, error) {
+	if hasHeaders(options) {
+		return put(ctx, in, src, options...)
+	}
+	u, err := newUpload(ctx, f, src)
+	if err != nil {
+		return nil, err
+	}
+	if u == nil {
+		return put(ctx, in, src, options...)
+	}
+	if err := u.Upload(ctx, in); err != nil {
+		return nil, err
+	}
+	return f.Fs.NewObject(ctx, src.Remote())
+}
+
+// Put uploads in to the remote path, uploads of objects bigger than the
+// upload cutoff are resumable.
+func (f *Fs) Put(ctx context.Context, in io.Reader, src fs.ObjectInfo, options ...fs.OpenOption) (fs.Object, error) {
+	return f.put(ctx, in, src, options, f.Fs.Put)
+}
+
+// PutStream uploads in to the remote path without knowing its size,
+// uploads are resumable if size of the source of transformed content
+// is bigger than the upload cutoff.
+func (f *Fs) PutStream(ctx context.Context, in io.Reader, src fs.ObjectInfo, options ...fs.OpenOption) (fs.Object, error) {
+	return f.put(ctx, in, src, options, f.Fs.Features().PutStream)
+}
+
+// UploadSeed returns seed of encryption of src kept in the state of the
+// upload, so that resumed upload of the same source is encrypted the same
+// way. It returns nil if upload of src is not resumable.
+func (f *Fs) UploadSeed(ctx context.Context, src fs.ObjectInfo) ([]byte, error) {
+	u, err := newUpload(ctx, f, src)
+	if err != nil || u == nil {
+		return nil, err
+	}
+	prev, err := f.store.Load(u.state.ID())
+	if err != nil {
+		return nil, err
+	}
+	if prev != nil && prev.sameSource(u.state) && prev.Seed != nil {
+		return prev.Seed, nil
+	}
+	if prev != nil {
+		u.discard(ctx, prev)
+	}
+
+	seed, err := crypt.NewSeed()
+	if err != nil {
+		return nil, err
+	}
+	u.state.Seed = seed
+	u.state.CreatedAt = timeutc.Now()
+	if err := f.store.Save(u.state); err != nil {
+		return nil, err
+	}
+	return seed, nil
+}
+
+// hasHeaders returns true if any of the options sets an HTTP header,
+// such uploads are left to the wrapped Fs.
+func hasHeaders(options []fs.OpenOption) bool {
+	for _, o := range options {
+		if k, _ := o.Header(); k != "" {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,350 @@
+// Copyright (C) 2024 ScyllaDB
+
+package resume
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+	rcgcs "github.com/rclone/rclone/backend/googlecloudstorage"
+	"github.com/rclone/rclone/fs"
+	"github.com/rclone/rclone/fs/config/configstruct"
+	"github.com/rclone/rclone/fs/fserrors"
+	"github.com/rclone/rclone/fs/fshttp"
+	"github.com/rclone/rclone/lib/bucket"
+	"github.com/rclone/rclone/lib/env"
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/google"
+	"google.golang.org/api/googleapi"
+	"google.golang.org/api/storage/v1"
+)
+
+// Metadata of objects set by rclone gcs backend.
+const (
+	gcsMetaMtime      = "mtime"
+	gcsTimeFormat     = "2006-01-02T15:04:05.000000000Z07:00"
+	gcsUploadURL      = "https://storage.googleapis.com/upload/storage/v1/b/%s/o"
+	gcsChunkAlignment = 256 * 1024
+	// gcsResumeIncomplete is the status of response to upload of a chunk
+	// when the upload is not finished.
+	gcsResumeIncomplete = 308
+)
+
+// gcsBackend does GCS resumable uploads. The upload ID is the session URI,
+// parts are chunks appended to the session one after another.
+type gcsBackend struct {
+	c   *http.Client
+	opt *rcgcs.Options
+}
+
+var _ backend = &gcsBackend{}
+
+var (
+	gcsBackendsMu sync.Mutex
+	gcsBackends   = make(map[string]*gcsBackend)
+)
+
+// newGCSBackend returns backend of the gcs remote with the given name,
+// clients are cached as they hold tokens. It returns nil for anonymous
+// access.
+func newGCSBackend(ctx context.Context, name string) (backend, error) {
+	gcsBackendsMu.Lock()
+	defer gcsBackendsMu.Unlock()
+
+	if b, ok := gcsBackends[name]; ok {
+		return b, nil
+	}
+
+	info, err := fs.Find("gcs")
+	if err != nil {
+		return nil, err
+	}
+	opt := new(rcgcs.Options)
+	if err := configstruct.Set(fs.ConfigMap(info, name), opt); err != nil {
+		return nil, err
+	}
+	if opt.Anonymous {
+		return nil, nil
+	}
+	if opt.ObjectACL == "" {
+		opt.ObjectACL = "private"
+	}
+
+	// Client outlives the context of the upload
+	clientCtx := context.WithValue(context.Background(), oauth2.HTTPClient, fshttp.NewClient(ctx))
+	creds := []byte(opt.ServiceAccountCredentials)
+	if len(creds) == 0 && opt.ServiceAccountFile != "" {
+		if creds, err = os.ReadFile(env.ShellExpand(opt.ServiceAccountFile)); err != nil {
+			return nil, errors.Wrap(err, "read service account file")
+		}
+	}
+	var c *http.Client
+	if len(creds) > 0 {
+		conf, err := google.JWTConfigFromJSON(creds, storage.DevstorageReadWriteScope)
+		if err != nil {
+			return nil, errors.Wrap(err, "parse service account credentials")
+		}
+		c = conf.Client(clientCtx)
+	} else {
+		c, err = google.DefaultClient(clientCtx, storage.DevstorageReadWriteScope)
+		if err != nil {
+			return nil, errors.Wrap(err, "get default credentials")
+		}
+	}
+
+	b := &gcsBackend{c: c, opt: opt}
+	gcsBackends[name] = b
+	return b, nil
+}
+
+func (b *gcsBackend) cutoff() int64 {
+	return int64(b.opt.ChunkSize)
+}
+
+// partSize returns the chunk size, GCS requires chunks to be multiples of
+// 256KiB.
+func (b *gcsBackend) partSize(_ int64) int64 {
+	ps := int64(b.opt.ChunkSize)
+	if r := ps % gcsChunkAlignment; r != 0 || ps == 0 {
+		ps += gcsChunkAlignment - r
+	}
+	return ps
+}
+
+func (b *gcsBackend) concurrency() int {
+	return 1
+}
+
+func (b *gcsBackend) sequential() bool {
+	return true
+}
+
+func (b *gcsBackend) split(p string) (bucketName, key string) {
+	bucketName, key = bucket.Split(p)
+	return b.opt.Enc.FromStandardName(bucketName), b.opt.Enc.FromStandardPath(key)
+}
+
+func (b *gcsBackend) create(ctx context.Context, u *upload) (string, error) {
+	q := url.Values{}
+	q.Set("uploadType", "resumable")
+	q.Set("name", u.state.Key)
+	if !b.opt.BucketPolicyOnly {
+		q.Set("predefinedAcl", b.opt.ObjectACL)
+	}
+	mimeType := fs.MimeType(ctx, u.src)
+	body, err := json.Marshal(&storage.Object{
+		Name:         u.state.Key,
+		ContentType:  mimeType,
+		StorageClass: b.opt.StorageClass,
+		Metadata: map[string]string{
+			gcsMetaMtime: u.src.ModTime(ctx).Format(gcsTimeFormat),
+		},
+	})
+	if err != nil {
+		return "", err
+	}
+
+	endpoint := fmt.Sprintf(gcsUploadURL, url.PathEscape(u.state.Bucket)) + "?" + q.Encode()
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(body))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/json; charset=UTF-8")
+	req.Header.Set("X-Upload-Content-Type", mimeType)
+	if u.state.Size >= 0 {
+		req.Header.Set("X-Upload-Content-Length", strconv.FormatInt(u.state.Size, 10))
+	}
+
+	resp, err := b.do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	if err := checkResponse(resp); err != nil {
+		return "", err
+	}
+	loc := resp.Header.Get("Location")
+	if loc == "" {
+		return "", errors.New("missing session URI")
+	}
+	return loc, nil
+}
+
+// parts returns parts of st persisted in the session, and sets the number
+// of persisted bytes in u.
+func (b *gcsBackend) parts(ctx context.Context, u *upload, st *State) (map[int64]Part, error) {
+	committed, done, err := b.status(ctx, st.UploadID, "*")
+	if err != nil {
+		return nil, err
+	}
+	if done {
+		// Upload was finished but not recorded, it's uploaded again
+		return nil, errNoSuchUpload
+	}
+	u.committed = committed
+
+	listed := make(map[int64]Part)
+	for _, p := range st.Parts {
+		if (p.Number-1)*st.PartSize+p.Size <= committed {
+			listed[p.Number] = p
+		}
+	}
+	// Bytes persisted after the recorded parts are listed as a part that
+	// is not recorded, so that they are not trusted.
+	var end int64
+	n := int64(1)
+	for ; listed[n].Number == n; n++ {
+		end += listed[n].Size
+	}
+	if committed > end {
+		listed[n] = Part{Number: n, Size: committed - end}
+	}
+	return listed, nil
+}
+
+// uploadPart appends chunk to the session, bytes already persisted are
+// skipped.
+func (b *gcsBackend) uploadPart(ctx context.Context, u *upload, p Part, buf []byte, total int64) (string, error) {
+	offset := (p.Number - 1) * u.state.PartSize
+	end := offset + int64(len(buf))
+	size := "*"
+	if total >= 0 {
+		size = strconv.FormatInt(total, 10)
+	}
+
+	for u.committed < end {
+		if u.committed < offset {
+			return "", errors.Errorf("persisted %d bytes, expected %d", u.committed, offset)
+		}
+		chunk := buf[u.committed-offset:]
+		req, err := http.NewRequestWithContext(ctx, http.MethodPut, u.state.UploadID, bytes.NewReader(chunk))
+		if err != nil {
+			return "", err
+		}
+		req.ContentLength = int64(len(chunk))
+		req.Header.Set("Content-Range", fmt.Sprintf("bytes %d-%d/%s", u.committed, end-1, size))
+
+		committed, done, err := b.parseStatus(b.do(req))
+		if err != nil {
+			return "", err
+		}
+		if done {
+			u.committed = end
+			break
+		}
+		if committed <= u.committed {
+			return "", fserrors.RetryErrorf("no bytes persisted at offset %d", u.committed)
+		}
+		u.committed = committed
+	}
+	return "", nil
+}
+
+// complete finishes the session if content ended on a chunk boundary,
+// and checks that the object was created.
+func (b *gcsBackend) complete(ctx context.Context, u *upload, _ []Part, total int64) error {
+	committed, done, err := b.status(ctx, u.state.UploadID, strconv.FormatInt(total, 10))
+	if err != nil {
+		return err
+	}
+	if !done {
+		return errors.Errorf("persisted %d bytes out of %d", committed, total)
+	}
+	return nil
+}
+
+func (b *gcsBackend) abort(ctx context.Context, st *State) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, st.UploadID, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := b.do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	// Cancelled session responds with 499
+	if resp.StatusCode == 499 {
+		return nil
+	}
+	return checkResponse(resp)
+}
+
+// status returns the number of bytes persisted in the session, and true if
+// the upload is finished. If size is not "*" the upload is finished if all
+// bytes were persisted.
+func (b *gcsBackend) status(ctx context.Context, session, size string) (committed int64, done bool, err error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, session, http.NoBody)
+	if err != nil {
+		return 0, false, err
+	}
+	req.Header.Set("Content-Range", "bytes */"+size)
+	return b.parseStatus(b.do(req))
+}
+
+// parseStatus returns the number of bytes persisted in the session
+// from resp, and true if the upload is finished.
+func (b *gcsBackend) parseStatus(resp *http.Response, err error) (committed int64, done bool, _ error) {
+	if err != nil {
+		return 0, false, err
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK, http.StatusCreated:
+		_, err := io.Copy(io.Discard, resp.Body)
+		return 0, true, err
+	case gcsResumeIncomplete:
+		if _, err := io.Copy(io.Discard, resp.Body); err != nil {
+			return 0, false, err
+		}
+		r := resp.Header.Get("Range")
+		if r == "" {
+			return 0, false, nil
+		}
+		_, last, ok := strings.Cut(strings.TrimPrefix(r, "bytes="), "-")
+		if !ok {
+			return 0, false, errors.Errorf("invalid range %q", r)
+		}
+		n, err := strconv.ParseInt(last, 10, 64)
+		if err != nil {
+			return 0, false, errors.Wrapf(err, "invalid range %q", r)
+		}
+		return n + 1, false, nil
+	}
+	return 0, false, checkResponse(resp)
+}
+
+func (b *gcsBackend) do(req *http.Request) (*http.Response, error) {
+	resp, err := b.c.Do(req)
+	if err != nil {
+		return nil, fserrors.RetryError(err)
+	}
+	return resp, nil
+}
+
+// checkResponse returns error of unsuccessful response, a session that
+// does not exist is reported as errNoSuchUpload.
+func checkResponse(resp *http.Response) error {
+	err := googleapi.CheckResponse(resp)
+	if err == nil {
+		return nil
+	}
+	switch code := resp.StatusCode; {
+	case code == http.StatusNotFound || code == http.StatusGone:
+		return errNoSuchUpload
+	case code == http.StatusTooManyRequests || code >= 500:
+		return fserrors.RetryError(err)
+	}
+	return err
+}
@@ -0,0 +1,236 @@
+// Copyright (C) 2024 ScyllaDB
+
+package resume
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5"
+	"encoding/base64"
+	"encoding/hex"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/ncw/swift"
+	"github.com/pkg/errors"
+	rcs3 "github.com/rclone/rclone/backend/s3"
+	"github.com/rclone/rclone/fs"
+	"github.com/rclone/rclone/fs/hash"
+	"github.com/rclone/rclone/lib/bucket"
+	"github.com/scylladb/scylla-manager/v3/pkg/rclone"
+)
+
+// Metadata keys used by rclone s3 backend.
+const (
+	metaMtime   = "Mtime"
+	metaMD5Hash = "Md5chksum"
+)
+
+// maxUploadParts is the max number of parts in S3 multipart upload.
+const maxUploadParts = 10000
+
+// s3Backend does S3 multipart uploads.
+type s3Backend struct {
+	c   *s3.S3
+	opt *rcs3.Options
+}
+
+var _ backend = &s3Backend{}
+
+func newS3Backend(name string) (backend, error) {
+	c, opt, err := rclone.NewS3Client(name)
+	if err != nil {
+		return nil, errors.Wrap(err, "create s3 client")
+	}
+	if opt.SSECustomerKey != "" && opt.SSECustomerKeyMD5 == "" {
+		sum := md5.Sum([]byte(opt.SSECustomerKey))
+		opt.SSECustomerKeyMD5 = base64.StdEncoding.EncodeToString(sum[:])
+	}
+	return &s3Backend{c: c, opt: opt}, nil
+}
+
+func (b *s3Backend) cutoff() int64 {
+	return int64(b.opt.UploadCutoff)
+}
+
+func (b *s3Backend) partSize(size int64) int64 {
+	return partSize(b.opt, size)
+}
+
+// partSize returns size of parts of an object, it's calculated the same way
+// as in rclone so that the number of parts does not exceed the limit.
+func partSize(opt *rcs3.Options, size int64) int64 {
+	maxParts := opt.MaxUploadParts
+	if maxParts < 1 {
+		maxParts = 1
+	} else if maxParts > maxUploadParts {
+		maxParts = maxUploadParts
+	}
+	ps := int64(opt.ChunkSize)
+	if size/ps >= maxParts {
+		ps = (((size / maxParts) >> 20) + 1) << 20
+	}
+	return ps
+}
+
+func (b *s3Backend) concurrency() int {
+	return b.opt.UploadConcurrency
+}
+
+func (b *s3Backend) sequential() bool {
+	return false
+}
+
+func (b *s3Backend) split(p string) (bucketName, key string) {
+	bucketName, key = bucket.Split(p)
+	return b.opt.Enc.FromStandardName(bucketName), b.opt.Enc.FromStandardPath(key)
+}
+
+func (b *s3Backend) create(ctx context.Context, u *upload) (string, error) {
+	req := &s3.CreateMultipartUploadInput{
+		Bucket:       aws.String(u.state.Bucket),
+		Key:          aws.String(u.state.Key),
+		ContentType:  aws.String(fs.MimeType(ctx, u.src)),
+		Metadata:     b.metadata(ctx, u.src),
+		RequestPayer: b.requestPayer(),
+	}
+	if b.opt.ACL != "" {
+		req.ACL = aws.String(b.opt.ACL)
+	}
+	if b.opt.ServerSideEncryption != "" {
+		req.ServerSideEncryption = aws.String(b.opt.ServerSideEncryption)
+	}
+	if b.opt.SSEKMSKeyID != "" {
+		req.SSEKMSKeyId = aws.String(b.opt.SSEKMSKeyID)
+	}
+	if b.opt.StorageClass != "" {
+		req.StorageClass = aws.String(b.opt.StorageClass)
+	}
+	req.SSECustomerAlgorithm, req.SSECustomerKey, req.SSECustomerKeyMD5 = b.sseCustomer()
+
+	out, err := b.c.CreateMultipartUploadWithContext(ctx, req)
+	if err != nil {
+		return "", err
+	}
+	return aws.StringValue(out.UploadId), nil
+}
+
+// metadata returns object metadata set by rclone s3 backend on upload.
+func (b *s3Backend) metadata(ctx context.Context, src fs.ObjectInfo) map[string]*string {
+	m := map[string]*string{
+		metaMtime: aws.String(swift.TimeToFloatString(src.ModTime(ctx))),
+	}
+	if !b.opt.DisableChecksum {
+		if h, err := src.Hash(ctx, hash.MD5); err == nil && h != "" {
+			if raw, err := hex.DecodeString(h); err == nil {
+				m[metaMD5Hash] = aws.String(base64.StdEncoding.EncodeToString(raw))
+			}
+		}
+	}
+	return m
+}
+
+func (b *s3Backend) parts(ctx context.Context, _ *upload, st *State) (map[int64]Part, error) {
+	listed := make(map[int64]Part)
+	err := b.c.ListPartsPagesWithContext(ctx, &s3.ListPartsInput{
+		Bucket:       aws.String(st.Bucket),
+		Key:          aws.String(st.Key),
+		UploadId:     aws.String(st.UploadID),
+		RequestPayer: b.requestPayer(),
+	}, func(out *s3.ListPartsOutput, _ bool) bool {
+		for _, p := range out.Parts {
+			listed[aws.Int64Value(p.PartNumber)] = Part{
+				Number: aws.Int64Value(p.PartNumber),
+				ETag:   aws.StringValue(p.ETag),
+				Size:   aws.Int64Value(p.Size),
+			}
+		}
+		return true
+	})
+	if isNoSuchUpload(err) {
+		return nil, errNoSuchUpload
+	}
+	return listed, err
+}
+
+func (b *s3Backend) uploadPart(ctx context.Context, u *upload, p Part, buf []byte, _ int64) (string, error) {
+	sum, err := hex.DecodeString(p.MD5)
+	if err != nil {
+		return "", err
+	}
+	req := &s3.UploadPartInput{
+		Body:          bytes.NewReader(buf),
+		Bucket:        aws.String(u.state.Bucket),
+		Key:           aws.String(u.state.Key),
+		PartNumber:    aws.Int64(p.Number),
+		UploadId:      aws.String(u.state.UploadID),
+		ContentMD5:    aws.String(base64.StdEncoding.EncodeToString(sum)),
+		ContentLength: aws.Int64(p.Size),
+		RequestPayer:  b.requestPayer(),
+	}
+	req.SSECustomerAlgorithm, req.SSECustomerKey, req.SSECustomerKeyMD5 = b.sseCustomer()
+
+	out, err := b.c.UploadPartWithContext(ctx, req)
+	if err != nil {
+		return "", err
+	}
+	return aws.StringValue(out.ETag), nil
+}
+
+func (b *s3Backend) complete(ctx context.Context, u *upload, parts []Part, _ int64) error {
+	completed := make([]*s3.CompletedPart, len(parts))
+	for i := range parts {
+		completed[i] = &s3.CompletedPart{
+			PartNumber: aws.Int64(parts[i].Number),
+			ETag:       aws.String(parts[i].ETag),
+		}
+	}
+
+	_, err := b.c.CompleteMultipartUploadWithContext(ctx, &s3.CompleteMultipartUploadInput{
+		Bucket:          aws.String(u.state.Bucket),
+		Key:             aws.String(u.state.Key),
+		UploadId:        aws.String(u.state.UploadID),
+		MultipartUpload: &s3.CompletedMultipartUpload{Parts: completed},
+		RequestPayer:    b.requestPayer(),
+	})
+	return err
+}
+
+func (b *s3Backend) abort(ctx context.Context, st *State) error {
+	_, err := b.c.AbortMultipartUploadWithContext(ctx, &s3.AbortMultipartUploadInput{
+		Bucket:       aws.String(st.Bucket),
+		Key:          aws.String(st.Key),
+		UploadId:     aws.String(st.UploadID),
+		RequestPayer: b.requestPayer(),
+	})
+	if isNoSuchUpload(err) {
+		return errNoSuchUpload
+	}
+	return err
+}
+
+func (b *s3Backend) requestPayer() *string {
+	if b.opt.RequesterPays {
+		return aws.String(s3.RequestPayerRequester)
+	}
+	return nil
+}
+
+func (b *s3Backend) sseCustomer() (algorithm, key, keyMD5 *string) {
+	if b.opt.SSECustomerAlgorithm != "" {
+		algorithm = aws.String(b.opt.SSECustomerAlgorithm)
+	}
+	if b.opt.SSECustomerKey != "" {
+		key = aws.String(b.opt.SSECustomerKey)
+	}
+	if b.opt.SSECustomerKeyMD5 != "" {
+		keyMD5 = aws.String(b.opt.SSECustomerKeyMD5)
+	}
+	return
+}
+
+func isNoSuchUpload(err error) bool {
+	var aerr awserr.Error
+	return errors.As(err, &aerr) && aerr.Code() == s3.ErrCodeNoSuchUpload
+}
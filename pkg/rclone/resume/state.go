@@ -0,0 +1,220 @@
+// Copyright (C) 2024 ScyllaDB
+
+package resume
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// State is the persisted state of a multipart upload.
+type State struct {
+	// Fs is the name of the rclone remote the object is uploaded to.
+	Fs     string `json:"fs"`
+	Bucket string `json:"bucket"`
+	Key    string `json:"key"`
+	// UploadID identifies the upload, for GCS it's the resumable upload
+	// session URI, for Azure it's the prefix of IDs of uploaded blocks.
+	UploadID string `json:"upload_id"`
+	// SrcSize is the size of the source of the upload, it differs from Size
+	// if content is compressed or encrypted.
+	SrcSize int64 `json:"src_size"`
+	// Size is the size of the uploaded object, or -1 if it's not known
+	// until the whole content is read.
+	Size     int64     `json:"size"`
+	ModTime  time.Time `json:"mod_time"`
+	PartSize int64     `json:"part_size"`
+	// Seed is the seed of encryption of the object, see crypt.Seeder.
+	// It's reused only as long as uploaded parts are proven to be the same,
+	// otherwise upload is restarted with a new seed.
+	Seed []byte `json:"seed,omitempty"`
+	// Parts holds parts that were successfully uploaded.
+	Parts     []Part    `json:"parts"`
+	CreatedAt time.Time `json:"created_at"`
+
+	mu sync.Mutex
+}
+
+// Part is an uploaded part of a multipart upload.
+type Part struct {
+	Number int64  `json:"number"`
+	ETag   string `json:"etag"`
+	Size   int64  `json:"size"`
+	// MD5 is hex encoded MD5 of the part content, resumed parts are
+	// uploaded again if their content differs.
+	MD5 string `json:"md5"`
+}
+
+// ID returns ID of the state, there can be only one upload of an object.
+func (s *State) ID() string {
+	return stateID(s.Fs, s.Bucket, s.Key)
+}
+
+func stateID(fsName, bucket, key string) string {
+	h := sha256.Sum256([]byte(fsName + ":" + bucket + "/" + key))
+	return hex.EncodeToString(h[:])
+}
+
+// NumParts returns the number of parts the object is uploaded in,
+// it must not be called if size is not known.
+func (s *State) NumParts() int64 {
+	if s.Size == 0 {
+		return 1
+	}
+	return (s.Size + s.PartSize - 1) / s.PartSize
+}
+
+// PartLen returns size of part with the given number, if size is not known
+// all parts are assumed to be full.
+func (s *State) PartLen(number int64) int64 {
+	if s.Size < 0 || number < s.NumParts() {
+		return s.PartSize
+	}
+	return s.Size - (number-1)*s.PartSize
+}
+
+// sameSource returns true if o is a state of upload of the same source.
+func (s *State) sameSource(o *State) bool {
+	return s.SrcSize == o.SrcSize && s.ModTime.Equal(o.ModTime)
+}
+
+// sameUpload returns true if o is a state of upload of the same source
+// uploaded in the same parts.
+func (s *State) sameUpload(o *State) bool {
+	return s.sameSource(o) && s.Size == o.Size && s.PartSize == o.PartSize
+}
+
+// addPart records an uploaded part, it replaces part with the same number.
+func (s *State) addPart(p Part) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for i := range s.Parts {
+		if s.Parts[i].Number == p.Number {
+			s.Parts[i] = p
+			return
+		}
+	}
+	s.Parts = append(s.Parts, p)
+}
+
+// sortedParts returns copy of parts sorted by part number.
+func (s *State) sortedParts() []Part {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	parts := append([]Part(nil), s.Parts...)
+	sort.Slice(parts, func(i, j int) bool {
+		return parts[i].Number < parts[j].Number
+	})
+	return parts
+}
+
+// Store persists states of multipart uploads as JSON files in a directory,
+// so that uploads can be resumed after a failure or restart.
+type Store struct {
+	dir string
+	mu  sync.Mutex
+}
+
+// NewStore returns Store keeping states in dir, the directory is created
+// if it does not exist.
+func NewStore(dir string) (*Store, error) {
+	if err := os.MkdirAll(dir, 0o750); err != nil {
+		return nil, errors.Wrapf(err, "create directory %s", dir)
+	}
+	return &Store{dir: dir}, nil
+}
+
+// Dir returns the directory of the store.
+func (s *Store) Dir() string {
+	return s.dir
+}
+
+func (s *Store) path(id string) string {
+	return filepath.Join(s.dir, id+".json")
+}
+
+// Load returns state with the given ID or nil if it does not exist.
+func (s *Store) Load(id string) (*State, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	b, err := os.ReadFile(s.path(id))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	st := new(State)
+	if err := json.Unmarshal(b, st); err != nil {
+		return nil, errors.Wrapf(err, "parse %s", s.path(id))
+	}
+	return st, nil
+}
+
+// Save atomically writes state to the store.
+func (s *Store) Save(st *State) error {
+	st.mu.Lock()
+	b, err := json.Marshal(st)
+	st.mu.Unlock()
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	p := s.path(st.ID())
+	tmp := p + ".tmp"
+	if err := os.WriteFile(tmp, b, 0o640); err != nil {
+		return err
+	}
+	return os.Rename(tmp, p)
+}
+
+// Delete removes state with the given ID, it's not an error if the state
+// does not exist.
+func (s *Store) Delete(id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := os.Remove(s.path(id)); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+// List returns all states in the store.
+func (s *Store) List() ([]*State, error) {
+	entries, err := os.ReadDir(s.dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var out []*State
+	for _, e := range entries {
+		id, ok := strings.CutSuffix(e.Name(), ".json")
+		if !ok || e.IsDir() {
+			continue
+		}
+		st, err := s.Load(id)
+		if err != nil {
+			return nil, err
+		}
+		if st != nil {
+			out = append(out, st)
+		}
+	}
+	return out, nil
+}
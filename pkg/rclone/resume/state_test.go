@@ -0,0 +1,126 @@
+// Copyright (C) 2024 ScyllaDB
+
+package resume
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	rcs3 "github.com/rclone/rclone/backend/s3"
+	"github.com/rclone/rclone/fs"
+)
+
+func TestStore(t *testing.T) {
+	s, err := NewStore(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	st := &State{
+		Fs:        "s3",
+		Bucket:    "backups",
+		Key:       "sst/me-1-big-Data.db",
+		UploadID:  "upload-1",
+		Size:      10,
+		ModTime:   time.Date(2024, 1, 1, 0, 0, 0, 123, time.UTC),
+		PartSize:  4,
+		CreatedAt: time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC),
+	}
+	st.addPart(Part{Number: 2, ETag: "b", Size: 4})
+	st.addPart(Part{Number: 1, ETag: "a", Size: 4})
+	st.addPart(Part{Number: 2, ETag: "c", Size: 4})
+
+	if err := s.Save(st); err != nil {
+		t.Fatal(err)
+	}
+	got, err := s.Load(st.ID())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(st, got, cmpopts.IgnoreUnexported(State{})); diff != "" {
+		t.Fatalf("Load() diff\n%s", diff)
+	}
+	if diff := cmp.Diff([]Part{{Number: 1, ETag: "a", Size: 4}, {Number: 2, ETag: "c", Size: 4}}, got.sortedParts()); diff != "" {
+		t.Fatalf("sortedParts() diff\n%s", diff)
+	}
+
+	list, err := s.List()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(list) != 1 || list[0].ID() != st.ID() {
+		t.Fatalf("List() = %v, expected state %s", list, st.ID())
+	}
+
+	if err := s.Delete(st.ID()); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.Delete(st.ID()); err != nil {
+		t.Fatal("Delete() of missing state", err)
+	}
+	got, err = s.Load(st.ID())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != nil {
+		t.Fatalf("Load() = %v, expected nil", got)
+	}
+}
+
+func TestStateParts(t *testing.T) {
+	st := &State{Size: 10, PartSize: 4}
+	if n := st.NumParts(); n != 3 {
+		t.Fatalf("NumParts() = %d, expected 3", n)
+	}
+	for n, golden := range map[int64]int64{1: 4, 2: 4, 3: 2} {
+		if l := st.PartLen(n); l != golden {
+			t.Fatalf("PartLen(%d) = %d, expected %d", n, l, golden)
+		}
+	}
+}
+
+func TestResumableParts(t *testing.T) {
+	st := &State{
+		Size:     10,
+		PartSize: 4,
+		Parts: []Part{
+			{Number: 1, ETag: "a", Size: 4},
+			{Number: 2, ETag: "b", Size: 4},
+			{Number: 3, ETag: "c", Size: 2},
+			{Number: 4, ETag: "d", Size: 2},
+		},
+	}
+	listed := map[int64]Part{
+		1: {Number: 1, ETag: "a", Size: 4},
+		2: {Number: 2, ETag: "x", Size: 4},
+		3: {Number: 3, ETag: "c", Size: 2},
+		5: {Number: 5, ETag: "e", Size: 2},
+	}
+
+	done := resumableParts(st, listed)
+	golden := []Part{{Number: 1, ETag: "a", Size: 4}, {Number: 3, ETag: "c", Size: 2}}
+	if diff := cmp.Diff(map[int64]Part{1: golden[0], 3: golden[1]}, done); diff != "" {
+		t.Fatalf("resumableParts() diff\n%s", diff)
+	}
+	if diff := cmp.Diff(golden, st.Parts); diff != "" {
+		t.Fatalf("Parts diff\n%s", diff)
+	}
+}
+
+func TestPartSize(t *testing.T) {
+	opt := &rcs3.Options{
+		ChunkSize:      50 * fs.MebiByte,
+		MaxUploadParts: maxUploadParts,
+	}
+	if ps := partSize(opt, 1024*int64(fs.MebiByte)); ps != int64(50*fs.MebiByte) {
+		t.Fatalf("partSize() = %d, expected chunk size", ps)
+	}
+
+	size := 1024 * 1024 * int64(fs.MebiByte)
+	ps := partSize(opt, size)
+	if (size+ps-1)/ps > maxUploadParts {
+		t.Fatalf("partSize() = %d, exceeds max number of parts", ps)
+	}
+}
@@ -0,0 +1,445 @@
+// Copyright (C) 2024 ScyllaDB
+
+package resume
+
+import (
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"io"
+	"path"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/rclone/rclone/fs"
+	"github.com/rclone/rclone/fs/fserrors"
+	"github.com/scylladb/scylla-manager/v3/pkg/rclone/internal/wrapfs"
+	"github.com/scylladb/scylla-manager/v3/pkg/util/timeutc"
+	"go.uber.org/multierr"
+	"golang.org/x/sync/errgroup"
+)
+
+// backend does multipart uploads to a remote.
+type backend interface {
+	// cutoff returns size from which objects are uploaded in parts.
+	cutoff() int64
+	// partSize returns size of parts of an object of the given size.
+	partSize(size int64) int64
+	// concurrency returns the max number of parts uploaded in parallel.
+	concurrency() int
+	// sequential returns true if parts are appended to the object in order,
+	// and uploaded parts can't be replaced.
+	sequential() bool
+	// split returns bucket and key of object at path p.
+	split(p string) (bucket, key string)
+	// create starts a new upload and returns its ID.
+	create(ctx context.Context, u *upload) (string, error)
+	// parts returns parts of upload st stored by the remote, it returns
+	// errNoSuchUpload if the upload does not exist.
+	parts(ctx context.Context, u *upload, st *State) (map[int64]Part, error)
+	// uploadPart uploads part p with content buf and returns its ETag,
+	// total is the size of the object or -1 if it's not known yet.
+	uploadPart(ctx context.Context, u *upload, p Part, buf []byte, total int64) (string, error)
+	// complete creates the object of the given size from uploaded parts.
+	complete(ctx context.Context, u *upload, parts []Part, total int64) error
+	// abort aborts upload st and removes its parts.
+	abort(ctx context.Context, st *State) error
+}
+
+var errNoSuchUpload = errors.New("no such upload")
+
+// supportedTypes are types of remotes with resumable uploads.
+var supportedTypes = []string{"s3", "gcs", "azureblob"}
+
+// newBackend returns backend of the remote with the given name, it returns
+// nil if uploads to the remote can't be resumed.
+func newBackend(ctx context.Context, name string) (backend, error) {
+	t, _ := fs.ConfigFileGet(name, "type")
+	switch t {
+	case "s3":
+		return newS3Backend(name)
+	case "gcs":
+		return newGCSBackend(ctx, name)
+	case "azureblob":
+		return newAzureBackend(name)
+	}
+	return nil, nil
+}
+
+type upload struct {
+	f     *Fs
+	b     backend
+	src   fs.ObjectInfo
+	state *State
+	// committed is the number of bytes persisted by sequential backend.
+	committed int64
+}
+
+// newUpload returns upload of src or nil if src is smaller than the upload
+// cutoff or uploads to f can't be resumed.
+func newUpload(ctx context.Context, f *Fs, src fs.ObjectInfo) (*upload, error) {
+	srcSize := sourceSize(src)
+	if srcSize <= 0 {
+		return nil, nil
+	}
+	b, err := newBackend(ctx, f.Name())
+	if err != nil {
+		return nil, errors.Wrap(err, "create client")
+	}
+	if b == nil || srcSize < b.cutoff() {
+		return nil, nil
+	}
+
+	size := src.Size()
+	maxSize := size
+	if maxSize < 0 {
+		maxSize = maxTransformedSize(srcSize)
+	}
+	bucket, key := b.split(path.Join(f.Root(), src.Remote()))
+	return &upload{
+		f:   f,
+		b:   b,
+		src: src,
+		state: &State{
+			Fs:       f.Name(),
+			Bucket:   bucket,
+			Key:      key,
+			SrcSize:  srcSize,
+			Size:     size,
+			ModTime:  src.ModTime(ctx).UTC(),
+			PartSize: b.partSize(maxSize),
+		},
+	}, nil
+}
+
+// sourceSize returns size of the source of upload of transformed content.
+func sourceSize(src fs.ObjectInfo) int64 {
+	for {
+		s, ok := src.(interface{ Source() fs.ObjectInfo })
+		if !ok {
+			return src.Size()
+		}
+		src = s.Source()
+	}
+}
+
+// maxTransformedSize returns upper bound of size of compressed and
+// encrypted content of size bytes of data.
+func maxTransformedSize(size int64) int64 {
+	return size + size/100 + 1024*1024
+}
+
+// Upload uploads object read from in, it continues the previous upload
+// of the object if possible.
+func (u *upload) Upload(ctx context.Context, in io.Reader) error {
+	done, err := u.resume(ctx)
+	if err != nil {
+		return err
+	}
+	if u.state.UploadID == "" {
+		if err := u.create(ctx); err != nil {
+			return err
+		}
+	} else {
+		fs.Infof(u.src, "Resuming upload, %d parts uploaded", len(done))
+	}
+
+	// Resumed parts are read before it's known if they have to be uploaded
+	// again, bytes read are accounted when that is decided.
+	g := wrapfs.GateFrom(ctx)
+	if g == nil {
+		if gg, ok := wrapfs.NewGate(in); ok {
+			g, in = gg, gg
+		}
+	}
+
+	concurrency := u.b.concurrency()
+	if concurrency < 1 {
+		concurrency = 1
+	}
+	bufs := make(chan []byte, concurrency)
+	for i := 0; i < concurrency; i++ {
+		bufs <- nil
+	}
+
+	var (
+		eg, egCtx = errgroup.WithContext(ctx)
+		total     = u.state.Size
+		numParts  int64
+		offset    int64
+		restart   bool
+	)
+loop:
+	for n := int64(1); total < 0 || n <= u.state.NumParts(); n++ {
+		var buf []byte
+		select {
+		case buf = <-bufs:
+		case <-egCtx.Done():
+			break loop
+		}
+		if egCtx.Err() != nil {
+			break
+		}
+		if buf == nil {
+			buf = make([]byte, u.state.PartSize)
+		}
+
+		prev, resumed := done[n]
+		if resumed {
+			g.Defer()
+		}
+		m, rerr := io.ReadFull(in, buf[:u.state.PartLen(n)])
+		buf = buf[:m]
+		last := total >= 0 && n == u.state.NumParts()
+		if total < 0 && (errors.Is(rerr, io.EOF) || errors.Is(rerr, io.ErrUnexpectedEOF)) {
+			total = offset + int64(m)
+			last = true
+		} else if rerr != nil {
+			err = errors.Wrap(rerr, "read source")
+			break
+		}
+		offset += int64(m)
+
+		// Content ended on the boundary of parts
+		if m == 0 && n > 1 {
+			numParts = n - 1
+			break
+		}
+		numParts = n
+
+		p := Part{
+			Number: n,
+			Size:   int64(m),
+			MD5:    md5Hex(buf),
+		}
+		if resumed && prev.Size == p.Size && prev.MD5 == p.MD5 {
+			if err = g.Resolve(ctx, true); err != nil {
+				break
+			}
+			bufs <- buf[:cap(buf)]
+			if last {
+				break
+			}
+			continue
+		}
+		if err = g.Resolve(ctx, false); err != nil {
+			break
+		}
+		// Parts of sequential uploads can't be replaced, and encrypting
+		// different data with the same seed would reuse nonces.
+		if resumed && (u.b.sequential() || u.state.Seed != nil) {
+			err = errors.Errorf("content of uploaded part %d changed", n)
+			restart = true
+			break
+		}
+
+		t := total
+		eg.Go(func() error {
+			defer func() {
+				bufs <- buf[:cap(buf)]
+			}()
+			return u.uploadPart(egCtx, p, buf, t)
+		})
+		if last {
+			break
+		}
+	}
+	if werr := eg.Wait(); err == nil {
+		err = werr
+	}
+	if err == nil {
+		err = ctx.Err()
+	}
+	if restart {
+		return u.restart(ctx, u.state, err)
+	}
+	if err != nil {
+		return err
+	}
+
+	return u.complete(ctx, numParts, total)
+}
+
+func md5Hex(b []byte) string {
+	sum := md5.Sum(b)
+	return hex.EncodeToString(sum[:])
+}
+
+// resume loads state of the previous upload of the object and returns
+// parts that may not have to be uploaded again. Parts are trusted only if
+// they are recorded in the state and stored by the remote.
+//
+// Encrypted uploads reuse seed of the previous upload, if the previous
+// upload can't be continued it's restarted with a new seed, see restart.
+func (u *upload) resume(ctx context.Context) (map[int64]Part, error) {
+	id := u.state.ID()
+	prev, err := u.f.store.Load(id)
+	if err != nil {
+		fs.Errorf(u.src, "Failed to load upload state: %v", err)
+		return nil, nil
+	}
+	if prev == nil {
+		return nil, nil
+	}
+	if prev.sameSource(u.state) {
+		u.state.Seed = prev.Seed
+	}
+	if prev.UploadID == "" {
+		return nil, nil
+	}
+	encrypted := u.state.Seed != nil
+	if !prev.sameUpload(u.state) {
+		if encrypted {
+			return nil, u.restart(ctx, prev, errors.New("object changed"))
+		}
+		fs.Debugf(u.src, "Object changed, aborting previous upload")
+		u.discard(ctx, prev)
+		return nil, nil
+	}
+
+	listed, err := u.b.parts(ctx, u, prev)
+	if errors.Is(err, errNoSuchUpload) {
+		if encrypted {
+			return nil, u.restart(ctx, prev, err)
+		}
+		fs.Debugf(u.src, "Previous upload does not exist")
+		return nil, u.f.store.Delete(id)
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "list uploaded parts")
+	}
+
+	done := resumableParts(prev, listed)
+	if (u.b.sequential() || encrypted) && len(done) != len(listed) {
+		// Stored parts that are not recorded can't be verified
+		return nil, u.restart(ctx, prev, errors.New("uploaded parts not recorded in state"))
+	}
+	u.state = prev
+	return done, nil
+}
+
+// restart aborts upload st and removes its state together with the seed of
+// encryption. It returns retry error so that upload is started from scratch,
+// and data is encrypted with a new seed.
+func (u *upload) restart(ctx context.Context, st *State, err error) error {
+	fs.Debugf(u.src, "Restarting upload: %v", err)
+	u.discard(ctx, st)
+	return fserrors.RetryError(err)
+}
+
+// resumableParts removes parts that were not stored by the remote from
+// state, and returns the remaining parts.
+func resumableParts(st *State, listed map[int64]Part) map[int64]Part {
+	var (
+		parts []Part
+		done  = make(map[int64]Part)
+	)
+	for _, p := range st.Parts {
+		if _, ok := done[p.Number]; ok || p.Number < 1 {
+			continue
+		}
+		if st.Size >= 0 && (p.Number > st.NumParts() || p.Size != st.PartLen(p.Number)) {
+			continue
+		}
+		if l, ok := listed[p.Number]; ok && l.ETag == p.ETag && l.Size == p.Size {
+			parts = append(parts, p)
+			done[p.Number] = p
+		}
+	}
+	st.Parts = parts
+	return done
+}
+
+func (u *upload) create(ctx context.Context) error {
+	id, err := u.b.create(ctx, u)
+	if err != nil {
+		return errors.Wrap(err, "create upload")
+	}
+	u.state.UploadID = id
+	u.state.CreatedAt = timeutc.Now()
+	u.save()
+	return nil
+}
+
+func (u *upload) save() {
+	if err := u.f.store.Save(u.state); err != nil {
+		fs.Errorf(u.src, "Failed to save upload state: %v", err)
+	}
+}
+
+func (u *upload) uploadPart(ctx context.Context, p Part, buf []byte, total int64) error {
+	etag, err := u.b.uploadPart(ctx, u, p, buf, total)
+	if err != nil {
+		return errors.Wrapf(err, "upload part %d", p.Number)
+	}
+	p.ETag = etag
+	u.state.addPart(p)
+	u.save()
+	return nil
+}
+
+func (u *upload) complete(ctx context.Context, numParts, total int64) error {
+	var parts []Part
+	for _, p := range u.state.sortedParts() {
+		if p.Number <= numParts {
+			parts = append(parts, p)
+		}
+	}
+	if int64(len(parts)) != numParts {
+		return errors.Errorf("uploaded %d parts out of %d", len(parts), numParts)
+	}
+	if err := u.b.complete(ctx, u, parts, total); err != nil {
+		return errors.Wrap(err, "complete upload")
+	}
+	if err := u.f.store.Delete(u.state.ID()); err != nil {
+		fs.Errorf(u.src, "Failed to remove upload state: %v", err)
+	}
+	return nil
+}
+
+// discard aborts upload st and removes its state.
+func (u *upload) discard(ctx context.Context, st *State) {
+	if err := abort(ctx, u.b, st); err != nil {
+		fs.Errorf(u.src, "Failed to abort upload: %v", err)
+	}
+	if err := u.f.store.Delete(st.ID()); err != nil {
+		fs.Errorf(u.src, "Failed to remove upload state: %v", err)
+	}
+}
+
+// abort aborts upload st if it was started, it's not an error if the upload
+// does not exist.
+func abort(ctx context.Context, b backend, st *State) error {
+	if st.UploadID == "" {
+		return nil
+	}
+	if err := b.abort(ctx, st); err != nil && !errors.Is(err, errNoSuchUpload) {
+		return err
+	}
+	return nil
+}
+
+// Prune aborts uploads started more than maxAge ago and removes their
+// states.
+func (s *Store) Prune(ctx context.Context, maxAge time.Duration) error {
+	states, err := s.List()
+	if err != nil {
+		return err
+	}
+
+	var errs error
+	for _, st := range states {
+		if timeutc.Since(st.CreatedAt) < maxAge {
+			continue
+		}
+		b, err := newBackend(ctx, st.Fs)
+		if err == nil && b != nil {
+			err = abort(ctx, b, st)
+		}
+		if err != nil {
+			errs = multierr.Append(errs, errors.Wrapf(err, "abort upload of %s/%s", st.Bucket, st.Key))
+		}
+		errs = multierr.Append(errs, s.Delete(st.ID()))
+	}
+	return errs
+}
@@ -0,0 +1,300 @@
+// Copyright (C) 2024 ScyllaDB
+
+package resume
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"github.com/rclone/rclone/fs/fserrors"
+	"github.com/rclone/rclone/fs/object"
+)
+
+// fakeBackend keeps uploads in memory.
+type fakeBackend struct {
+	ps      int64
+	seq     bool
+	uploads map[string]map[int64][]byte
+	// failAt is the number of part which fails to upload.
+	failAt   int64
+	uploaded []int64
+	object   []byte
+}
+
+func newFakeBackend(ps int64, seq bool) *fakeBackend {
+	return &fakeBackend{
+		ps:      ps,
+		seq:     seq,
+		uploads: make(map[string]map[int64][]byte),
+	}
+}
+
+func (b *fakeBackend) cutoff() int64                       { return 1 }
+func (b *fakeBackend) partSize(int64) int64                { return b.ps }
+func (b *fakeBackend) concurrency() int                    { return 1 }
+func (b *fakeBackend) sequential() bool                    { return b.seq }
+func (b *fakeBackend) split(p string) (bucket, key string) { return "bucket", p }
+
+func (b *fakeBackend) create(context.Context, *upload) (string, error) {
+	id := fmt.Sprint("upload-", len(b.uploads))
+	b.uploads[id] = make(map[int64][]byte)
+	return id, nil
+}
+
+func (b *fakeBackend) parts(_ context.Context, _ *upload, st *State) (map[int64]Part, error) {
+	parts, ok := b.uploads[st.UploadID]
+	if !ok {
+		return nil, errNoSuchUpload
+	}
+	listed := make(map[int64]Part)
+	for n, buf := range parts {
+		listed[n] = Part{Number: n, ETag: md5Hex(buf), Size: int64(len(buf))}
+	}
+	return listed, nil
+}
+
+func (b *fakeBackend) uploadPart(_ context.Context, u *upload, p Part, buf []byte, _ int64) (string, error) {
+	if p.Number == b.failAt {
+		b.failAt = 0
+		return "", errors.New("failed")
+	}
+	b.uploads[u.state.UploadID][p.Number] = bytes.Clone(buf)
+	b.uploaded = append(b.uploaded, p.Number)
+	return md5Hex(buf), nil
+}
+
+func (b *fakeBackend) complete(_ context.Context, u *upload, parts []Part, _ int64) error {
+	b.object = nil
+	for _, p := range parts {
+		b.object = append(b.object, b.uploads[u.state.UploadID][p.Number]...)
+	}
+	delete(b.uploads, u.state.UploadID)
+	return nil
+}
+
+func (b *fakeBackend) abort(_ context.Context, st *State) error {
+	if _, ok := b.uploads[st.UploadID]; !ok {
+		return errNoSuchUpload
+	}
+	delete(b.uploads, st.UploadID)
+	return nil
+}
+
+func TestUploadResume(t *testing.T) {
+	const partSize = 100
+	modTime := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	data := make([]byte, 1050)
+	rand.New(rand.NewSource(1)).Read(data)
+	changed := bytes.Clone(data)
+	changed[partSize+1] ^= 1
+
+	table := []struct {
+		Name       string
+		Sequential bool
+		Size       int64
+		Data       []byte
+		Resume     []byte
+		Uploaded   []int64
+	}{
+		{
+			Name:     "known size",
+			Size:     int64(len(data)),
+			Data:     data,
+			Resume:   data,
+			Uploaded: []int64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11},
+		},
+		{
+			Name:     "unknown size",
+			Size:     -1,
+			Data:     data,
+			Resume:   data,
+			Uploaded: []int64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11},
+		},
+		{
+			Name:     "unknown size ending on part boundary",
+			Size:     -1,
+			Data:     data[:1000],
+			Resume:   data[:1000],
+			Uploaded: []int64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10},
+		},
+		{
+			Name:     "changed content",
+			Size:     int64(len(data)),
+			Data:     data,
+			Resume:   changed,
+			Uploaded: []int64{1, 2, 3, 4, 2, 5, 6, 7, 8, 9, 10, 11},
+		},
+		{
+			Name:       "sequential",
+			Sequential: true,
+			Size:       -1,
+			Data:       data,
+			Resume:     data,
+			Uploaded:   []int64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11},
+		},
+	}
+
+	for i := range table {
+		test := table[i]
+		t.Run(test.Name, func(t *testing.T) {
+			ctx := context.Background()
+			store, err := NewStore(t.TempDir())
+			if err != nil {
+				t.Fatal(err)
+			}
+			b := newFakeBackend(partSize, test.Sequential)
+			b.failAt = 5
+
+			newUpload := func(data []byte) *upload {
+				size := test.Size
+				if size >= 0 {
+					size = int64(len(data))
+				}
+				return &upload{
+					f:   &Fs{store: store},
+					b:   b,
+					src: object.NewStaticObjectInfo("file", modTime, int64(len(data)), true, nil, nil),
+					state: &State{
+						Fs:       "fake",
+						Bucket:   "bucket",
+						Key:      "file",
+						SrcSize:  int64(len(test.Data)),
+						Size:     size,
+						ModTime:  modTime,
+						PartSize: partSize,
+					},
+				}
+			}
+
+			if err := newUpload(test.Data).Upload(ctx, bytes.NewReader(test.Data)); err == nil {
+				t.Fatal("Upload() expected error")
+			}
+			st, err := store.Load(newUpload(test.Data).state.ID())
+			if err != nil {
+				t.Fatal(err)
+			}
+			if st == nil || len(st.Parts) != 4 {
+				t.Fatalf("Expected state with 4 parts, got %+v", st)
+			}
+
+			if err := newUpload(test.Resume).Upload(ctx, bytes.NewReader(test.Resume)); err != nil {
+				t.Fatal("Upload() error", err)
+			}
+			if diff := cmp.Diff(test.Uploaded, b.uploaded); diff != "" {
+				t.Fatalf("Uploaded parts diff\n%s", diff)
+			}
+			if !bytes.Equal(b.object, test.Resume) {
+				t.Fatal("Uploaded object differs")
+			}
+			states, err := store.List()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(states) != 0 {
+				t.Fatalf("Expected no states, got %v", states)
+			}
+		})
+	}
+}
+
+func TestUploadRestart(t *testing.T) {
+	const partSize = 100
+	modTime := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	table := []struct {
+		Name       string
+		Sequential bool
+		Seed       []byte
+		// Change modifies data or stored parts after the first upload.
+		Change func(data []byte, b *fakeBackend)
+	}{
+		{
+			Name:       "sequential changed content",
+			Sequential: true,
+			Change: func(data []byte, _ *fakeBackend) {
+				data[0] = 1
+			},
+		},
+		{
+			Name: "encrypted changed content",
+			Seed: []byte("seed"),
+			Change: func(data []byte, _ *fakeBackend) {
+				data[partSize] = 1
+			},
+		},
+		{
+			Name: "encrypted part not recorded",
+			Seed: []byte("seed"),
+			Change: func(data []byte, b *fakeBackend) {
+				for _, parts := range b.uploads {
+					parts[7] = data[6*partSize : 7*partSize]
+				}
+			},
+		},
+	}
+
+	for i := range table {
+		test := table[i]
+		t.Run(test.Name, func(t *testing.T) {
+			ctx := context.Background()
+			store, err := NewStore(t.TempDir())
+			if err != nil {
+				t.Fatal(err)
+			}
+			b := newFakeBackend(partSize, test.Sequential)
+			b.failAt = 3
+
+			data := make([]byte, 1000)
+			newUpload := func() *upload {
+				return &upload{
+					f:   &Fs{store: store},
+					b:   b,
+					src: object.NewStaticObjectInfo("file", modTime, int64(len(data)), true, nil, nil),
+					state: &State{
+						Fs:       "fake",
+						Bucket:   "bucket",
+						Key:      "file",
+						SrcSize:  int64(len(data)),
+						Size:     int64(len(data)),
+						ModTime:  modTime,
+						PartSize: partSize,
+						Seed:     test.Seed,
+					},
+				}
+			}
+
+			if err := newUpload().Upload(ctx, bytes.NewReader(data)); err == nil {
+				t.Fatal("Upload() expected error")
+			}
+			test.Change(data, b)
+			err = newUpload().Upload(ctx, bytes.NewReader(data))
+			if err == nil || !fserrors.IsRetryError(err) {
+				t.Fatalf("Upload() error %v, expected retry error", err)
+			}
+			if len(b.uploads) != 0 {
+				t.Fatalf("Expected upload to be aborted, got %v", b.uploads)
+			}
+			states, err := store.List()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(states) != 0 {
+				t.Fatalf("Expected state with seed to be removed, got %v", states)
+			}
+
+			if err := newUpload().Upload(ctx, bytes.NewReader(data)); err != nil {
+				t.Fatal("Upload() error", err)
+			}
+			if !bytes.Equal(b.object, data) {
+				t.Fatal("Uploaded object differs")
+			}
+		})
+	}
+}
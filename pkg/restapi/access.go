@@ -0,0 +1,221 @@
+// Copyright (C) 2024 ScyllaDB
+
+package restapi
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/render"
+	"github.com/pkg/errors"
+	"github.com/scylladb/go-log"
+	"github.com/scylladb/scylla-manager/v3/pkg/auth"
+	"github.com/scylladb/scylla-manager/v3/pkg/service/access"
+	"github.com/scylladb/scylla-manager/v3/pkg/util/uuid"
+)
+
+// unauthorizedPenalty is the time for which response to a request with
+// invalid token is held to slow down brute force attacks.
+const unauthorizedPenalty = time.Second
+
+// authenticate is a middleware that checks API token provided in
+// the Authorization header and puts it into request context.
+// Requests reading resources require at least read_only role,
+// all other requests require at least operator role.
+// If service is nil or service reports that authentication is disabled,
+// requests are passed without token.
+func authenticate(svc AccessService) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		if svc == nil {
+			return next
+		}
+
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			t, err := svc.Authenticate(r.Context(), auth.BearerAuth(r))
+			if err != nil {
+				if errors.Is(err, access.ErrInvalidToken) {
+					time.Sleep(unauthorizedPenalty)
+					respondUnauthorized(w, r, err)
+				} else {
+					respondError(w, r, errors.Wrap(err, "authenticate"))
+				}
+				return
+			}
+			if t == nil {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			required := access.RoleOperator
+			if r.Method == http.MethodGet || r.Method == http.MethodHead {
+				required = access.RoleReadOnly
+			}
+			setAuditIdentity(r, t.Name)
+			if !t.Role.Allows(required) {
+				respondForbidden(w, r, errors.Errorf("token %q with role %s can't perform this operation", t.Name, t.Role))
+				return
+			}
+
+			next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), ctxToken, t)))
+		})
+	}
+}
+
+// requireRole is a middleware that checks if token in request context
+// has at least the required role. It passes requests without token.
+func requireRole(required access.Role) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if t := tokenFromCtx(r); t != nil && !t.Role.Allows(required) {
+				respondForbidden(w, r, errors.Errorf("token %q with role %s can't perform this operation, role %s is required", t.Name, t.Role, required))
+				return
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// requireToken is a middleware that rejects requests without token in
+// request context. Tokens can be managed only by authenticated clients
+// even if authentication of other requests is disabled, the first token
+// is created with auth_token set in Scylla Manager config.
+func requireToken(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if tokenFromCtx(r) == nil {
+			respondUnauthorized(w, r, errors.New("missing token, use auth_token from Scylla Manager config to create the first token"))
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// requireAllClusters is a middleware that checks if token in request context
+// is not limited to a single cluster. It passes requests without token.
+func requireAllClusters(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if t := tokenFromCtx(r); t != nil && !t.AllClusters() {
+			respondForbidden(w, r, errors.Errorf("token %q is limited to cluster %s", t.Name, t.ClusterID))
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// checkClusterAccess returns error if token in request context
+// can't access the cluster.
+func checkClusterAccess(r *http.Request, clusterID uuid.UUID) error {
+	if t := tokenFromCtx(r); t != nil && !t.CanAccessCluster(clusterID) {
+		return errors.Errorf("token %q can't access cluster %s", t.Name, clusterID)
+	}
+	return nil
+}
+
+func tokenFromCtx(r *http.Request) *access.Token {
+	t, _ := r.Context().Value(ctxToken).(*access.Token) // nolint: errcheck
+	return t
+}
+
+func respondUnauthorized(w http.ResponseWriter, r *http.Request, err error) {
+	render.Respond(w, r, &httpError{
+		StatusCode: http.StatusUnauthorized,
+		Message:    err.Error(),
+		TraceID:    log.TraceID(r.Context()),
+	})
+}
+
+func respondForbidden(w http.ResponseWriter, r *http.Request, err error) {
+	render.Respond(w, r, &httpError{
+		StatusCode: http.StatusForbidden,
+		Message:    err.Error(),
+		TraceID:    log.TraceID(r.Context()),
+	})
+}
+
+type tokenHandler struct {
+	svc AccessService
+}
+
+func newTokensHandler(svc AccessService) *chi.Mux {
+	m := chi.NewMux()
+	h := tokenHandler{
+		svc: svc,
+	}
+
+	m.Use(
+		requireToken,
+		requireRole(access.RoleAdmin),
+		requireAllClusters,
+	)
+	m.Get("/", h.listTokens)
+	m.Post("/", h.createToken)
+
+	return m
+}
+
+func newTokenHandler(svc AccessService) *chi.Mux {
+	m := chi.NewMux()
+	h := tokenHandler{
+		svc: svc,
+	}
+
+	m.Use(
+		requireToken,
+		requireRole(access.RoleAdmin),
+		requireAllClusters,
+	)
+	m.Delete("/{token_id}", h.deleteToken)
+
+	return m
+}
+
+func (h tokenHandler) listTokens(w http.ResponseWriter, r *http.Request) {
+	tokens, err := h.svc.ListTokens(r.Context())
+	if err != nil {
+		respondError(w, r, errors.Wrap(err, "list tokens"))
+		return
+	}
+	if len(tokens) == 0 {
+		render.Respond(w, r, []struct{}{})
+		return
+	}
+	render.Respond(w, r, tokens)
+}
+
+// tokenWithSecret is returned only once, when token is created.
+type tokenWithSecret struct {
+	*access.Token
+	Secret string `json:"secret"`
+}
+
+func (h tokenHandler) createToken(w http.ResponseWriter, r *http.Request) {
+	var t access.Token
+	if err := render.DecodeJSON(r.Body, &t); err != nil {
+		respondBadRequest(w, r, err)
+		return
+	}
+
+	secret, err := h.svc.CreateToken(r.Context(), &t)
+	if err != nil {
+		respondError(w, r, errors.Wrap(err, "create token"))
+		return
+	}
+
+	setAuditResource(r, "token", t.ID.String(), nil, &t)
+	render.Respond(w, r, tokenWithSecret{Token: &t, Secret: secret})
+}
+
+func (h tokenHandler) deleteToken(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(chi.URLParam(r, "token_id"))
+	if err != nil {
+		respondBadRequest(w, r, err)
+		return
+	}
+	setAuditResource(r, "token", id.String(), nil, nil)
+	if err := h.svc.DeleteToken(r.Context(), id); err != nil {
+		respondError(w, r, errors.Wrapf(err, "delete token %s", id))
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
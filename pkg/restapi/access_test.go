@@ -0,0 +1,137 @@
+// Copyright (C) 2024 ScyllaDB
+
+//go:generate mockgen -destination mock_accessservice_test.go -mock_names AccessService=MockAccessService -package restapi github.com/scylladb/scylla-manager/v3/pkg/restapi AccessService
+
+package restapi_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/scylladb/go-log"
+	"github.com/scylladb/scylla-manager/v3/pkg/restapi"
+	"github.com/scylladb/scylla-manager/v3/pkg/service/access"
+	"github.com/scylladb/scylla-manager/v3/pkg/service/cluster"
+)
+
+func TestAccess(t *testing.T) {
+	t.Parallel()
+
+	c := givenCluster()
+	other := givenCluster()
+
+	tokens := map[string]*access.Token{
+		"ro":       {Name: "ro", Role: access.RoleReadOnly},
+		"operator": {Name: "operator", Role: access.RoleOperator},
+		"admin":    {Name: "admin", Role: access.RoleAdmin},
+		"scoped":   {Name: "scoped", Role: access.RoleAdmin, ClusterID: &c.ID},
+	}
+
+	testCases := []struct {
+		Name   string
+		Token  string
+		Method string
+		Path   string
+		Status int
+	}{
+		{Name: "ping without token", Method: http.MethodGet, Path: "/ping", Status: http.StatusNoContent},
+		{Name: "invalid token", Token: "invalid", Method: http.MethodGet, Path: "/api/v1/clusters", Status: http.StatusUnauthorized},
+		{Name: "read only list clusters", Token: "ro", Method: http.MethodGet, Path: "/api/v1/clusters", Status: http.StatusOK},
+		{Name: "read only stop task", Token: "ro", Method: http.MethodPut, Path: "/api/v1/cluster/" + c.ID.String() + "/task/repair/" + c.ID.String() + "/stop", Status: http.StatusForbidden},
+		{Name: "operator delete cluster", Token: "operator", Method: http.MethodDelete, Path: "/api/v1/cluster/" + c.ID.String(), Status: http.StatusForbidden},
+		{Name: "operator list tokens", Token: "operator", Method: http.MethodGet, Path: "/api/v1/tokens", Status: http.StatusForbidden},
+		{Name: "admin delete cluster", Token: "admin", Method: http.MethodDelete, Path: "/api/v1/cluster/" + c.ID.String(), Status: http.StatusOK},
+		{Name: "scoped get cluster", Token: "scoped", Method: http.MethodGet, Path: "/api/v1/cluster/" + c.ID.String(), Status: http.StatusOK},
+		{Name: "scoped get other cluster", Token: "scoped", Method: http.MethodGet, Path: "/api/v1/cluster/" + other.ID.String(), Status: http.StatusForbidden},
+		{Name: "scoped create cluster", Token: "scoped", Method: http.MethodPost, Path: "/api/v1/clusters", Status: http.StatusForbidden},
+		{Name: "scoped list tokens", Token: "scoped", Method: http.MethodGet, Path: "/api/v1/tokens", Status: http.StatusForbidden},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			a := restapi.NewMockAccessService(ctrl)
+			a.EXPECT().Authenticate(gomock.Any(), tc.Token).DoAndReturn(func(_ interface{}, token string) (*access.Token, error) {
+				if t, ok := tokens[token]; ok {
+					return t, nil
+				}
+				return nil, access.ErrInvalidToken
+			}).AnyTimes()
+
+			m := restapi.NewMockClusterService(ctrl)
+			m.EXPECT().GetCluster(gomock.Any(), c.ID.String()).Return(c, nil).AnyTimes()
+			m.EXPECT().GetCluster(gomock.Any(), other.ID.String()).Return(other, nil).AnyTimes()
+			m.EXPECT().ListClusters(gomock.Any(), gomock.Any()).Return([]*cluster.Cluster{c, other}, nil).AnyTimes()
+			m.EXPECT().CheckCQLCredentials(gomock.Any()).Return(false, nil).AnyTimes()
+			m.EXPECT().DeleteCluster(gomock.Any(), c.ID).Return(nil).AnyTimes()
+
+			h := restapi.New(restapi.Services{Cluster: m, Access: a}, log.Logger{})
+			r := httptest.NewRequest(tc.Method, tc.Path, nil)
+			if tc.Token != "" {
+				r.Header.Set("Authorization", "Bearer "+tc.Token)
+			}
+			w := httptest.NewRecorder()
+			h.ServeHTTP(w, r)
+
+			if w.Code != tc.Status {
+				t.Fatalf("Expected status %d, got %d: %s", tc.Status, w.Code, w.Body.String())
+			}
+		})
+	}
+}
+
+func TestAccessListClustersScoped(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	c := givenCluster()
+	other := givenCluster()
+	token := &access.Token{Name: "scoped", Role: access.RoleReadOnly, ClusterID: &c.ID}
+
+	a := restapi.NewMockAccessService(ctrl)
+	a.EXPECT().Authenticate(gomock.Any(), "scoped").Return(token, nil)
+
+	m := restapi.NewMockClusterService(ctrl)
+	m.EXPECT().ListClusters(gomock.Any(), &cluster.Filter{}).Return([]*cluster.Cluster{c, other}, nil)
+	m.EXPECT().CheckCQLCredentials(c.ID).Return(true, nil)
+
+	h := restapi.New(restapi.Services{Cluster: m, Access: a}, log.Logger{})
+	r := httptest.NewRequest(http.MethodGet, "/api/v1/clusters", nil)
+	r.Header.Set("Authorization", "Bearer scoped")
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, r)
+
+	expected := *c
+	expected.Username = "set"
+	expected.Password = "set"
+	assertJsonBody(t, w, []*cluster.Cluster{&expected})
+}
+
+func TestAccessDisabledCreateToken(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	a := restapi.NewMockAccessService(ctrl)
+	a.EXPECT().Authenticate(gomock.Any(), "").Return(nil, nil)
+
+	h := restapi.New(restapi.Services{Cluster: restapi.NewMockClusterService(ctrl), Access: a}, log.Logger{})
+	r := httptest.NewRequest(http.MethodPost, "/api/v1/tokens", strings.NewReader(`{"name":"admin","role":"admin"}`))
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, r)
+
+	if w.Code != http.StatusUnauthorized {
+		t.Fatalf("Expected status %d, got %d: %s", http.StatusUnauthorized, w.Code, w.Body.String())
+	}
+}
@@ -0,0 +1,164 @@
+// Copyright (C) 2024 ScyllaDB
+
+package restapi
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/go-chi/render"
+	"github.com/pkg/errors"
+	"github.com/scylladb/go-log"
+	"github.com/scylladb/scylla-manager/v3/pkg/service/access"
+	"github.com/scylladb/scylla-manager/v3/pkg/service/audit"
+	"github.com/scylladb/scylla-manager/v3/pkg/util/uuid"
+)
+
+// anonymous is identity recorded in audit log when authentication is disabled.
+const anonymous = "anonymous"
+
+// auditLog is a middleware that records mutating requests in audit log.
+// It runs before authentication so that rejected requests are recorded too,
+// authenticate sets identity of the entry with setAuditIdentity.
+// Handlers can describe the modified resource with setAuditResource.
+// Failure to write audit log entry is logged and does not fail the request.
+func auditLog(svc AuditService, logger log.Logger) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		if svc == nil {
+			return next
+		}
+
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method == http.MethodGet || r.Method == http.MethodHead || r.Method == http.MethodOptions {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			e := &audit.Entry{
+				ClientAddr: r.RemoteAddr,
+				Identity:   anonymous,
+				Method:     r.Method,
+				Path:       r.URL.Path,
+			}
+
+			ww := middleware.NewWrapResponseWriter(w, r.ProtoMajor)
+			next.ServeHTTP(ww, r.WithContext(context.WithValue(r.Context(), ctxAuditEntry, e)))
+
+			e.StatusCode = ww.Status()
+			if e.StatusCode == 0 {
+				e.StatusCode = http.StatusOK
+			}
+			// Record operation even if client disconnected
+			if err := svc.Log(context.WithoutCancel(r.Context()), e); err != nil {
+				logger.Error(r.Context(), "Failed to write audit log entry",
+					"entry", e,
+					"error", err,
+				)
+			}
+		})
+	}
+}
+
+func auditEntryFromCtx(r *http.Request) *audit.Entry {
+	e, _ := r.Context().Value(ctxAuditEntry).(*audit.Entry) // nolint: errcheck
+	return e
+}
+
+// setAuditIdentity records name of the token used.
+func setAuditIdentity(r *http.Request, identity string) {
+	if e := auditEntryFromCtx(r); e != nil {
+		e.Identity = identity
+	}
+}
+
+// setAuditCluster records cluster of the modified resource.
+func setAuditCluster(r *http.Request, clusterID uuid.UUID) {
+	if e := auditEntryFromCtx(r); e != nil {
+		e.ClusterID = clusterID
+	}
+}
+
+// setAuditResource records the modified resource and JSON diff between its
+// state before and after the request. Pass nil before for created resources
+// and nil after for deleted ones.
+func setAuditResource(r *http.Request, resource, id string, before, after interface{}) {
+	e := auditEntryFromCtx(r)
+	if e == nil {
+		return
+	}
+	e.Resource = resource
+	e.ResourceID = id
+	// Resources are rendered as JSON by handlers, in the unlikely case
+	// of serialization error diff is omitted.
+	e.Diff, _ = audit.Diff(before, after) // nolint: errcheck
+}
+
+type auditHandler struct {
+	svc AuditService
+}
+
+func newAuditHandler(services Services) *chi.Mux {
+	m := chi.NewMux()
+	h := auditHandler{
+		svc: services.Audit,
+	}
+
+	m.Use(requireRole(access.RoleAdmin))
+	m.Get("/", h.listEntries)
+
+	return m
+}
+
+func (h auditHandler) listEntries(w http.ResponseWriter, r *http.Request) {
+	var f audit.Filter
+
+	if v := r.FormValue("cluster_id"); v != "" {
+		id, err := uuid.Parse(v)
+		if err != nil {
+			respondBadRequest(w, r, errors.Wrap(err, "invalid cluster_id"))
+			return
+		}
+		f.ClusterID = id
+	}
+	if t := tokenFromCtx(r); t != nil && !t.AllClusters() {
+		if f.ClusterID != uuid.Nil && f.ClusterID != *t.ClusterID {
+			respondForbidden(w, r, errors.Errorf("token %q can't access cluster %s", t.Name, f.ClusterID))
+			return
+		}
+		f.ClusterID = *t.ClusterID
+	}
+	if v := r.FormValue("since"); v != "" {
+		if err := f.Since.UnmarshalText([]byte(v)); err != nil {
+			respondBadRequest(w, r, errors.Wrap(err, "invalid since"))
+			return
+		}
+	}
+	if v := r.FormValue("until"); v != "" {
+		if err := f.Until.UnmarshalText([]byte(v)); err != nil {
+			respondBadRequest(w, r, errors.Wrap(err, "invalid until"))
+			return
+		}
+	}
+	if v := r.FormValue("limit"); v != "" {
+		limit, err := strconv.Atoi(v)
+		if err != nil {
+			respondBadRequest(w, r, errors.Wrap(err, "invalid limit"))
+			return
+		}
+		f.Limit = limit
+	}
+
+	entries, err := h.svc.List(r.Context(), f)
+	if err != nil {
+		respondError(w, r, errors.Wrap(err, "list audit log"))
+		return
+	}
+	if len(entries) == 0 {
+		render.Respond(w, r, []struct{}{})
+		return
+	}
+	render.Respond(w, r, entries)
+}
@@ -0,0 +1,160 @@
+// Copyright (C) 2024 ScyllaDB
+
+//go:generate mockgen -destination mock_auditservice_test.go -mock_names AuditService=MockAuditService -package restapi github.com/scylladb/scylla-manager/v3/pkg/restapi AuditService
+
+package restapi_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/go-cmp/cmp"
+	"github.com/scylladb/go-log"
+	"github.com/scylladb/scylla-manager/v3/pkg/restapi"
+	"github.com/scylladb/scylla-manager/v3/pkg/service/access"
+	"github.com/scylladb/scylla-manager/v3/pkg/service/audit"
+	"github.com/scylladb/scylla-manager/v3/pkg/service/cluster"
+	"github.com/scylladb/scylla-manager/v3/pkg/testutils"
+)
+
+func TestAuditLogClusterUpdate(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	c := givenCluster()
+	c.Password = "old"
+	token := &access.Token{Name: "admin", Role: access.RoleAdmin}
+
+	a := restapi.NewMockAccessService(ctrl)
+	a.EXPECT().Authenticate(gomock.Any(), "admin").Return(token, nil)
+
+	m := restapi.NewMockClusterService(ctrl)
+	m.EXPECT().GetCluster(gomock.Any(), c.ID.String()).Return(c, nil)
+	m.EXPECT().PutCluster(gomock.Any(), gomock.Any()).Return(nil)
+
+	var entry *audit.Entry
+	l := restapi.NewMockAuditService(ctrl)
+	l.EXPECT().Log(gomock.Any(), gomock.Any()).DoAndReturn(func(_ interface{}, e *audit.Entry) error {
+		entry = e
+		return nil
+	})
+
+	h := restapi.New(restapi.Services{Cluster: m, Access: a, Audit: l}, log.Logger{})
+	r := httptest.NewRequest(http.MethodPut, "/api/v1/cluster/"+c.ID.String(), jsonBody(t, &cluster.Cluster{Name: "new-name", Password: "new"}))
+	r.Header.Set("Authorization", "Bearer admin")
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, r)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("Expected status %d, got %d: %s", http.StatusOK, w.Code, w.Body.String())
+	}
+	if entry == nil {
+		t.Fatal("Audit log entry not written")
+	}
+
+	expected := audit.Entry{
+		ClusterID:  c.ID,
+		ClientAddr: r.RemoteAddr,
+		Identity:   "admin",
+		Method:     http.MethodPut,
+		Path:       "/api/v1/cluster/" + c.ID.String(),
+		Resource:   "cluster",
+		ResourceID: c.ID.String(),
+		StatusCode: http.StatusOK,
+	}
+	var diff map[string]audit.Change
+	if err := json.Unmarshal(entry.Diff, &diff); err != nil {
+		t.Fatal(err)
+	}
+	entry.Diff = nil
+	if d := cmp.Diff(expected, *entry, testutils.UUIDComparer()); d != "" {
+		t.Fatal(d)
+	}
+
+	expectedDiff := map[string]audit.Change{
+		"name":     {Old: c.Name, New: "new-name"},
+		"password": {Old: "***", New: "***"},
+	}
+	if d := cmp.Diff(expectedDiff, diff); d != "" {
+		t.Fatal(d)
+	}
+}
+
+func TestAuditLogSkipsReads(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	m := restapi.NewMockClusterService(ctrl)
+	m.EXPECT().ListClusters(gomock.Any(), &cluster.Filter{}).Return(nil, nil)
+
+	l := restapi.NewMockAuditService(ctrl)
+
+	h := restapi.New(restapi.Services{Cluster: m, Audit: l}, log.Logger{})
+	r := httptest.NewRequest(http.MethodGet, "/api/v1/clusters", nil)
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, r)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("Expected status %d, got %d", http.StatusOK, w.Code)
+	}
+}
+
+func TestAuditLogUnauthorized(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	c := givenCluster()
+
+	a := restapi.NewMockAccessService(ctrl)
+	a.EXPECT().Authenticate(gomock.Any(), "invalid").Return(nil, access.ErrInvalidToken)
+
+	var entry *audit.Entry
+	l := restapi.NewMockAuditService(ctrl)
+	l.EXPECT().Log(gomock.Any(), gomock.Any()).DoAndReturn(func(_ interface{}, e *audit.Entry) error {
+		entry = e
+		return nil
+	})
+
+	h := restapi.New(restapi.Services{Cluster: restapi.NewMockClusterService(ctrl), Access: a, Audit: l}, log.Logger{})
+	r := httptest.NewRequest(http.MethodDelete, "/api/v1/cluster/"+c.ID.String(), nil)
+	r.Header.Set("Authorization", "Bearer invalid")
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, r)
+
+	if w.Code != http.StatusUnauthorized {
+		t.Fatalf("Expected status %d, got %d: %s", http.StatusUnauthorized, w.Code, w.Body.String())
+	}
+	if entry == nil {
+		t.Fatal("Audit log entry not written")
+	}
+	if entry.StatusCode != http.StatusUnauthorized || entry.Identity != "anonymous" {
+		t.Fatalf("Audit log entry %+v, expected anonymous entry with status %d", entry, http.StatusUnauthorized)
+	}
+}
+
+func TestAuditListInvalidClusterID(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	l := restapi.NewMockAuditService(ctrl)
+
+	h := restapi.New(restapi.Services{Cluster: restapi.NewMockClusterService(ctrl), Audit: l}, log.Logger{})
+	r := httptest.NewRequest(http.MethodGet, "/api/v1/audit?cluster_id=prod-cluster", nil)
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, r)
+
+	if w.Code != http.StatusBadRequest {
+		t.Fatalf("Expected status %d, got %d: %s", http.StatusBadRequest, w.Code, w.Body.String())
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"context"
 	"encoding/json"
 	"net/http"
+	"strings"
 
 	"github.com/go-chi/chi/v5"
 	"github.com/go-chi/render"
@@ -181,10 +182,16 @@ func (h backupHandler) deleteSnapshot(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	locations := h.mustLocationsFromCtx(r)
+	setAuditResource(r, "backup", strings.Join(snapshotTags, ","), map[string]interface{}{
+		"locations":     locations,
+		"snapshot_tags": snapshotTags,
+	}, nil)
+
 	err := h.svc.DeleteSnapshot(
 		r.Context(),
 		mustClusterIDFromCtx(r),
-		h.mustLocationsFromCtx(r),
+		locations,
 		snapshotTags,
 	)
 	if err != nil {
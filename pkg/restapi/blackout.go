@@ -0,0 +1,82 @@
+// Copyright (C) 2024 ScyllaDB
+
+package restapi
+
+import (
+	"net/http"
+	"net/url"
+	"path"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/render"
+	"github.com/pkg/errors"
+	"github.com/scylladb/scylla-manager/v3/pkg/service/scheduler"
+	"github.com/scylladb/scylla-manager/v3/pkg/util/uuid"
+)
+
+type blackoutHandler struct {
+	svc SchedService
+}
+
+func newBlackoutHandler(services Services) *chi.Mux {
+	m := chi.NewMux()
+	h := blackoutHandler{
+		svc: services.Scheduler,
+	}
+
+	m.Get("/", h.list)
+	m.Post("/", h.create)
+	m.Delete("/{blackout_id}", h.delete)
+
+	return m
+}
+
+func (h blackoutHandler) list(w http.ResponseWriter, r *http.Request) {
+	v, err := h.svc.ListBlackouts(r.Context(), mustClusterIDFromCtx(r))
+	if err != nil {
+		respondError(w, r, errors.Wrap(err, "list blackouts"))
+		return
+	}
+	if len(v) == 0 {
+		render.Respond(w, r, []struct{}{})
+		return
+	}
+	render.Respond(w, r, v)
+}
+
+func (h blackoutHandler) create(w http.ResponseWriter, r *http.Request) {
+	var b scheduler.Blackout
+	if err := render.DecodeJSON(r.Body, &b); err != nil {
+		respondBadRequest(w, r, err)
+		return
+	}
+	if b.ID != uuid.Nil {
+		respondBadRequest(w, r, errors.Errorf("unexpected ID %q", b.ID))
+		return
+	}
+	b.ClusterID = mustClusterIDFromCtx(r)
+
+	if err := h.svc.PutBlackout(r.Context(), &b); err != nil {
+		respondError(w, r, errors.Wrap(err, "create blackout"))
+		return
+	}
+	setAuditResource(r, "blackout", b.ID.String(), nil, &b)
+
+	u := r.URL.ResolveReference(&url.URL{Path: path.Join("blackouts", b.ID.String())})
+	w.Header().Set("Location", u.String())
+	w.WriteHeader(http.StatusCreated)
+}
+
+func (h blackoutHandler) delete(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(chi.URLParam(r, "blackout_id"))
+	if err != nil {
+		respondBadRequest(w, r, err)
+		return
+	}
+	setAuditResource(r, "blackout", id.String(), nil, nil)
+	if err := h.svc.DeleteBlackout(r.Context(), mustClusterIDFromCtx(r), id); err != nil {
+		respondError(w, r, errors.Wrapf(err, "delete blackout %s", id))
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
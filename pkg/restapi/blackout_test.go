@@ -0,0 +1,81 @@
+// Copyright (C) 2024 ScyllaDB
+
+package restapi_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/scylladb/go-log"
+	"github.com/scylladb/scylla-manager/v3/pkg/restapi"
+	"github.com/scylladb/scylla-manager/v3/pkg/service/scheduler"
+	"github.com/scylladb/scylla-manager/v3/pkg/util/uuid"
+)
+
+func TestBlackoutCreate(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	c := givenCluster()
+	id := uuid.NewTime()
+
+	cm := restapi.NewMockClusterService(ctrl)
+	cm.EXPECT().GetCluster(gomock.Any(), c.ID.String()).Return(c, nil)
+
+	sm := restapi.NewMockSchedService(ctrl)
+	sm.EXPECT().PutBlackout(gomock.Any(), gomock.Any()).DoAndReturn(func(_ interface{}, b *scheduler.Blackout) error {
+		if b.ClusterID != c.ID {
+			t.Errorf("ClusterID = %s, expected %s", b.ClusterID, c.ID)
+		}
+		b.ID = id
+		return nil
+	})
+
+	h := restapi.New(restapi.Services{Cluster: cm, Scheduler: sm}, log.Logger{})
+	b := &scheduler.Blackout{
+		StartTime: time.Now(),
+		EndTime:   time.Now().Add(time.Hour),
+		Reason:    "peak traffic",
+	}
+	r := httptest.NewRequest(http.MethodPost, "/api/v1/cluster/"+c.ID.String()+"/blackouts", jsonBody(t, b))
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, r)
+
+	if w.Code != http.StatusCreated {
+		t.Fatalf("Expected status %d, got %d: %s", http.StatusCreated, w.Code, w.Body.String())
+	}
+	if l := w.Header().Get("Location"); !strings.HasSuffix(l, "/blackouts/"+id.String()) {
+		t.Fatalf("Location = %s, expected blackout %s", l, id)
+	}
+}
+
+func TestBlackoutDelete(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	c := givenCluster()
+	id := uuid.NewTime()
+
+	cm := restapi.NewMockClusterService(ctrl)
+	cm.EXPECT().GetCluster(gomock.Any(), c.ID.String()).Return(c, nil)
+
+	sm := restapi.NewMockSchedService(ctrl)
+	sm.EXPECT().DeleteBlackout(gomock.Any(), c.ID, id).Return(nil)
+
+	h := restapi.New(restapi.Services{Cluster: cm, Scheduler: sm}, log.Logger{})
+	r := httptest.NewRequest(http.MethodDelete, "/api/v1/cluster/"+c.ID.String()+"/blackouts/"+id.String(), nil)
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, r)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("Expected status %d, got %d: %s", http.StatusOK, w.Code, w.Body.String())
+	}
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/go-chi/chi/v5"
 	"github.com/go-chi/render"
 	"github.com/pkg/errors"
+	"github.com/scylladb/scylla-manager/v3/pkg/service/access"
 	"github.com/scylladb/scylla-manager/v3/pkg/service/cluster"
 )
 
@@ -41,6 +42,12 @@ func (h clusterFilter) clusterCtx(next http.Handler) http.Handler {
 			respondError(w, r, errors.Wrapf(err, "load cluster %q", clusterID))
 			return
 		}
+		if err := checkClusterAccess(r, c.ID); err != nil {
+			respondForbidden(w, r, err)
+			return
+		}
+
+		setAuditCluster(r, c.ID)
 
 		ctx := r.Context()
 		ctx = context.WithValue(ctx, ctxClusterID, c.ID)
@@ -59,13 +66,13 @@ func newClusterHandler(svc ClusterService) *chi.Mux {
 
 	m.Route("/clusters", func(r chi.Router) {
 		r.Get("/", h.listClusters)
-		r.Post("/", h.createCluster)
+		r.With(requireRole(access.RoleAdmin), requireAllClusters).Post("/", h.createCluster)
 	})
 	m.Route("/cluster/{cluster_id}", func(r chi.Router) {
 		r.Use(clusterFilter(h).clusterCtx)
 		r.Get("/", h.loadCluster)
-		r.Put("/", h.updateCluster)
-		r.Delete("/", h.deleteCluster)
+		r.With(requireRole(access.RoleAdmin)).Put("/", h.updateCluster)
+		r.With(requireRole(access.RoleAdmin)).Delete("/", h.deleteCluster)
 	})
 	return m
 }
@@ -76,6 +83,16 @@ func (h clusterHandler) listClusters(w http.ResponseWriter, r *http.Request) {
 		respondError(w, r, errors.Wrap(err, "list clusters"))
 		return
 	}
+	// Return only clusters accessible with the token
+	if t := tokenFromCtx(r); t != nil && !t.AllClusters() {
+		var filtered []*cluster.Cluster
+		for _, c := range ids {
+			if t.CanAccessCluster(c.ID) {
+				filtered = append(filtered, c)
+			}
+		}
+		ids = filtered
+	}
 
 	// Check if cluster CQL credentials are set, but don't return them
 	for _, c := range ids {
@@ -119,6 +136,8 @@ func (h clusterHandler) createCluster(w http.ResponseWriter, r *http.Request) {
 		respondError(w, r, errors.Wrap(err, "create cluster"))
 		return
 	}
+	setAuditCluster(r, newCluster.ID)
+	setAuditResource(r, "cluster", newCluster.ID.String(), nil, newCluster)
 
 	location := r.URL.ResolveReference(&url.URL{
 		Path: path.Join("cluster", newCluster.ID.String()),
@@ -142,6 +161,7 @@ func (h clusterHandler) updateCluster(w http.ResponseWriter, r *http.Request) {
 	}
 	newCluster.ID = c.ID
 
+	setAuditResource(r, "cluster", c.ID.String(), c, newCluster)
 	if err := h.svc.PutCluster(r.Context(), newCluster); err != nil {
 		respondError(w, r, errors.Wrapf(err, "update cluster %q", c.ID))
 		return
@@ -173,6 +193,15 @@ func (h clusterHandler) deleteCluster(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
+	if !deleteCQLCredentials && !deleteSSLUserCert {
+		setAuditResource(r, "cluster", c.ID.String(), c, nil)
+	} else {
+		setAuditResource(r, "cluster", c.ID.String(), nil, map[string]bool{
+			"cql_creds":     deleteCQLCredentials,
+			"ssl_user_cert": deleteSSLUserCert,
+		})
+	}
+
 	if !deleteCQLCredentials && !deleteSSLUserCert {
 		if err := h.svc.DeleteCluster(r.Context(), c.ID); err != nil {
 			respondError(w, r, errors.Wrapf(err, "delete cluster %q", c.ID))
@@ -17,6 +17,8 @@ const (
 	ctxClusterID ctxt = iota
 	ctxCluster
 	ctxTask
+	ctxToken
+	ctxAuditEntry
 
 	ctxBackupLocations
 	ctxBackupListFilter
@@ -0,0 +1,123 @@
+// Copyright (C) 2024 ScyllaDB
+
+package restapi
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/render"
+	"github.com/pkg/errors"
+	"github.com/scylladb/scylla-manager/v3/pkg/service/access"
+	"github.com/scylladb/scylla-manager/v3/pkg/service/backup"
+	"github.com/scylladb/scylla-manager/v3/pkg/util"
+	"github.com/scylladb/scylla-manager/v3/pkg/util/uuid"
+)
+
+// encryptionKeyHandler manages backup encryption keys. Keys are kept after
+// cluster is removed, so cluster_id may refer to a cluster that is not
+// registered in Scylla Manager, such as the source cluster of a restore.
+type encryptionKeyHandler struct {
+	cluster ClusterService
+	svc     BackupService
+}
+
+func newEncryptionKeyHandler(services Services) *chi.Mux {
+	m := chi.NewMux()
+	h := encryptionKeyHandler{
+		cluster: services.Cluster,
+		svc:     services.Backup,
+	}
+
+	m.Route("/{cluster_id}", func(r chi.Router) {
+		r.Use(requireRole(access.RoleAdmin), h.clusterCtx)
+		r.Get("/", h.getKey)
+		r.Put("/", h.putKey)
+		r.Delete("/", h.deleteKey)
+	})
+
+	return m
+}
+
+// clusterCtx resolves cluster_id as cluster name or ID, if the cluster is
+// not registered cluster_id must be a valid UUID and token must not be
+// limited to a cluster.
+func (h encryptionKeyHandler) clusterCtx(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		param, err := url.QueryUnescape(chi.URLParam(r, "cluster_id"))
+		if err != nil {
+			respondBadRequest(w, r, errors.New("invalid encoding in cluster ID"))
+			return
+		}
+		if param == "" {
+			respondBadRequest(w, r, errors.New("missing cluster ID"))
+			return
+		}
+
+		var clusterID uuid.UUID
+		c, err := h.cluster.GetCluster(r.Context(), param)
+		switch {
+		case err == nil:
+			clusterID = c.ID
+			if err := checkClusterAccess(r, clusterID); err != nil {
+				respondForbidden(w, r, err)
+				return
+			}
+		case errors.Is(err, util.ErrNotFound):
+			clusterID, err = uuid.Parse(param)
+			if err != nil {
+				respondError(w, r, errors.Wrapf(util.ErrNotFound, "load cluster %q", param))
+				return
+			}
+			if t := tokenFromCtx(r); t != nil && !t.AllClusters() {
+				respondForbidden(w, r, errors.Errorf("token %q is limited to cluster %s", t.Name, t.ClusterID))
+				return
+			}
+		default:
+			respondError(w, r, errors.Wrapf(err, "load cluster %q", param))
+			return
+		}
+
+		setAuditCluster(r, clusterID)
+		next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), ctxClusterID, clusterID)))
+	})
+}
+
+func (h encryptionKeyHandler) getKey(w http.ResponseWriter, r *http.Request) {
+	k, err := h.svc.GetEncryptionKey(r.Context(), mustClusterIDFromCtx(r))
+	if err != nil {
+		respondError(w, r, errors.Wrap(err, "get backup encryption key"))
+		return
+	}
+	render.Respond(w, r, k)
+}
+
+func (h encryptionKeyHandler) putKey(w http.ResponseWriter, r *http.Request) {
+	var k backup.EncryptionKey
+	if err := render.DecodeJSON(r.Body, &k); err != nil {
+		respondBadRequest(w, r, err)
+		return
+	}
+	k.ClusterID = mustClusterIDFromCtx(r)
+
+	if err := h.svc.PutEncryptionKey(r.Context(), k); err != nil {
+		respondError(w, r, errors.Wrap(err, "put backup encryption key"))
+		return
+	}
+	// Key material is not recorded in audit log
+	setAuditResource(r, "backup_encryption_key", k.ClusterID.String(), nil, nil)
+
+	w.WriteHeader(http.StatusOK)
+}
+
+func (h encryptionKeyHandler) deleteKey(w http.ResponseWriter, r *http.Request) {
+	clusterID := mustClusterIDFromCtx(r)
+	setAuditResource(r, "backup_encryption_key", clusterID.String(), nil, nil)
+	if err := h.svc.DeleteEncryptionKey(r.Context(), clusterID); err != nil {
+		respondError(w, r, errors.Wrap(err, "delete backup encryption key"))
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
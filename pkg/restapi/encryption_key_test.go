@@ -0,0 +1,92 @@
+// Copyright (C) 2024 ScyllaDB
+
+package restapi_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/scylladb/go-log"
+	"github.com/scylladb/scylla-manager/v3/pkg/restapi"
+	"github.com/scylladb/scylla-manager/v3/pkg/service/backup"
+	"github.com/scylladb/scylla-manager/v3/pkg/util"
+	"github.com/scylladb/scylla-manager/v3/pkg/util/uuid"
+)
+
+func TestEncryptionKeyGet(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	c := givenCluster()
+
+	cm := restapi.NewMockClusterService(ctrl)
+	cm.EXPECT().GetCluster(gomock.Any(), c.Name).Return(c, nil)
+
+	k := backup.EncryptionKey{ClusterID: c.ID, ID: "id", Key: "key"}
+	bm := restapi.NewMockBackupService(ctrl)
+	bm.EXPECT().GetEncryptionKey(gomock.Any(), c.ID).Return(k, nil)
+
+	h := restapi.New(restapi.Services{Cluster: cm, Backup: bm}, log.Logger{})
+	r := httptest.NewRequest(http.MethodGet, "/api/v1/backup/encryption-key/"+c.Name, nil)
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, r)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("Expected status %d, got %d: %s", http.StatusOK, w.Code, w.Body.String())
+	}
+	assertJsonBody(t, w, k)
+}
+
+func TestEncryptionKeyPutUnregisteredCluster(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	clusterID := uuid.NewTime()
+
+	cm := restapi.NewMockClusterService(ctrl)
+	cm.EXPECT().GetCluster(gomock.Any(), clusterID.String()).Return(nil, util.ErrNotFound)
+
+	bm := restapi.NewMockBackupService(ctrl)
+	bm.EXPECT().PutEncryptionKey(gomock.Any(), gomock.Any()).DoAndReturn(func(_ interface{}, k backup.EncryptionKey) error {
+		if k.ClusterID != clusterID {
+			t.Errorf("ClusterID = %s, expected %s", k.ClusterID, clusterID)
+		}
+		return nil
+	})
+
+	h := restapi.New(restapi.Services{Cluster: cm, Backup: bm}, log.Logger{})
+	r := httptest.NewRequest(http.MethodPut, "/api/v1/backup/encryption-key/"+clusterID.String(), jsonBody(t, backup.EncryptionKey{Key: "key"}))
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, r)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("Expected status %d, got %d: %s", http.StatusOK, w.Code, w.Body.String())
+	}
+}
+
+func TestEncryptionKeyUnknownClusterName(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cm := restapi.NewMockClusterService(ctrl)
+	cm.EXPECT().GetCluster(gomock.Any(), "foo").Return(nil, util.ErrNotFound)
+
+	bm := restapi.NewMockBackupService(ctrl)
+
+	h := restapi.New(restapi.Services{Cluster: cm, Backup: bm}, log.Logger{})
+	r := httptest.NewRequest(http.MethodDelete, "/api/v1/backup/encryption-key/foo", nil)
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, r)
+
+	if w.Code != http.StatusNotFound {
+		t.Fatalf("Expected status %d, got %d: %s", http.StatusNotFound, w.Code, w.Body.String())
+	}
+}
@@ -0,0 +1,39 @@
+// Copyright (C) 2024 ScyllaDB
+
+package restapi
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/go-chi/render"
+	"github.com/scylladb/go-log"
+)
+
+// requireLeader is a middleware that rejects mutating requests sent to
+// an instance that is not the leader. Changes need to be applied by the leader
+// as only the leader schedules tasks.
+func requireLeader(svc LeaderService) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		if svc == nil {
+			return next
+		}
+
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method == http.MethodGet || r.Method == http.MethodHead || r.Method == http.MethodOptions || svc.IsLeader() {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			msg := "this instance is not the leader, no leader is elected at the moment, try again later"
+			if l, err := svc.Leader(r.Context()); err == nil {
+				msg = fmt.Sprintf("this instance is not the leader, send the request to %s", l)
+			}
+			render.Respond(w, r, &httpError{
+				StatusCode: http.StatusServiceUnavailable,
+				Message:    msg,
+				TraceID:    log.TraceID(r.Context()),
+			})
+		})
+	}
+}
@@ -0,0 +1,56 @@
+// Copyright (C) 2024 ScyllaDB
+
+//go:generate mockgen -destination mock_leaderservice_test.go -mock_names LeaderService=MockLeaderService -package restapi github.com/scylladb/scylla-manager/v3/pkg/restapi LeaderService
+
+package restapi_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/scylladb/go-log"
+	"github.com/scylladb/scylla-manager/v3/pkg/restapi"
+	"github.com/scylladb/scylla-manager/v3/pkg/service/cluster"
+)
+
+func TestRequireLeader(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	c := givenCluster()
+
+	l := restapi.NewMockLeaderService(ctrl)
+	l.EXPECT().IsLeader().Return(false).AnyTimes()
+	l.EXPECT().Leader(gomock.Any()).Return("manager-1", nil).AnyTimes()
+
+	m := restapi.NewMockClusterService(ctrl)
+	m.EXPECT().GetCluster(gomock.Any(), c.ID.String()).Return(c, nil)
+
+	h := restapi.New(restapi.Services{Cluster: m, Leader: l}, log.Logger{})
+
+	t.Run("read allowed", func(t *testing.T) {
+		r := httptest.NewRequest(http.MethodGet, "/api/v1/cluster/"+c.ID.String(), nil)
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, r)
+		if w.Code != http.StatusOK {
+			t.Fatalf("Expected status %d, got %d: %s", http.StatusOK, w.Code, w.Body.String())
+		}
+	})
+
+	t.Run("update rejected", func(t *testing.T) {
+		r := httptest.NewRequest(http.MethodPut, "/api/v1/cluster/"+c.ID.String(), jsonBody(t, &cluster.Cluster{Name: "new-name"}))
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, r)
+		if w.Code != http.StatusServiceUnavailable {
+			t.Fatalf("Expected status %d, got %d: %s", http.StatusServiceUnavailable, w.Code, w.Body.String())
+		}
+		if !strings.Contains(w.Body.String(), "manager-1") {
+			t.Fatalf("Expected leader in response, got %s", w.Body.String())
+		}
+	})
+}
@@ -38,6 +38,49 @@ func (m *MockBackupService) EXPECT() *MockBackupServiceMockRecorder {
 	return m.recorder
 }
 
+// CheckSLA mocks base method.
+func (m *MockBackupService) CheckSLA(arg0 context.Context, arg1 uuid.UUID) (backup.SLAReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckSLA", arg0, arg1)
+	ret0, _ := ret[0].(backup.SLAReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CheckSLA indicates an expected call of CheckSLA.
+func (mr *MockBackupServiceMockRecorder) CheckSLA(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckSLA", reflect.TypeOf((*MockBackupService)(nil).CheckSLA), arg0, arg1)
+}
+
+// DeleteEncryptionKey mocks base method.
+func (m *MockBackupService) DeleteEncryptionKey(arg0 context.Context, arg1 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteEncryptionKey", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteEncryptionKey indicates an expected call of DeleteEncryptionKey.
+func (mr *MockBackupServiceMockRecorder) DeleteEncryptionKey(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEncryptionKey", reflect.TypeOf((*MockBackupService)(nil).DeleteEncryptionKey), arg0, arg1)
+}
+
+// DeleteSLAPolicy mocks base method.
+func (m *MockBackupService) DeleteSLAPolicy(arg0 context.Context, arg1 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSLAPolicy", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSLAPolicy indicates an expected call of DeleteSLAPolicy.
+func (mr *MockBackupServiceMockRecorder) DeleteSLAPolicy(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSLAPolicy", reflect.TypeOf((*MockBackupService)(nil).DeleteSLAPolicy), arg0, arg1)
+}
+
 // DeleteSnapshot mocks base method.
 func (m *MockBackupService) DeleteSnapshot(arg0 context.Context, arg1 uuid.UUID, arg2 []backupspec.Location, arg3 []string) error {
 	m.ctrl.T.Helper()
@@ -66,6 +109,21 @@ func (mr *MockBackupServiceMockRecorder) ExtractLocations(arg0, arg1 interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExtractLocations", reflect.TypeOf((*MockBackupService)(nil).ExtractLocations), arg0, arg1)
 }
 
+// GetEncryptionKey mocks base method.
+func (m *MockBackupService) GetEncryptionKey(arg0 context.Context, arg1 uuid.UUID) (backup.EncryptionKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEncryptionKey", arg0, arg1)
+	ret0, _ := ret[0].(backup.EncryptionKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEncryptionKey indicates an expected call of GetEncryptionKey.
+func (mr *MockBackupServiceMockRecorder) GetEncryptionKey(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEncryptionKey", reflect.TypeOf((*MockBackupService)(nil).GetEncryptionKey), arg0, arg1)
+}
+
 // GetProgress mocks base method.
 func (m *MockBackupService) GetProgress(arg0 context.Context, arg1, arg2, arg3 uuid.UUID) (backup.Progress, error) {
 	m.ctrl.T.Helper()
@@ -81,6 +139,21 @@ func (mr *MockBackupServiceMockRecorder) GetProgress(arg0, arg1, arg2, arg3 inte
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProgress", reflect.TypeOf((*MockBackupService)(nil).GetProgress), arg0, arg1, arg2, arg3)
 }
 
+// GetSLAPolicy mocks base method.
+func (m *MockBackupService) GetSLAPolicy(arg0 context.Context, arg1 uuid.UUID) (*backup.SLAPolicy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSLAPolicy", arg0, arg1)
+	ret0, _ := ret[0].(*backup.SLAPolicy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSLAPolicy indicates an expected call of GetSLAPolicy.
+func (mr *MockBackupServiceMockRecorder) GetSLAPolicy(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSLAPolicy", reflect.TypeOf((*MockBackupService)(nil).GetSLAPolicy), arg0, arg1)
+}
+
 // GetTarget mocks base method.
 func (m *MockBackupService) GetTarget(arg0 context.Context, arg1 uuid.UUID, arg2 json.RawMessage) (backup.Target, error) {
 	m.ctrl.T.Helper()
@@ -170,3 +243,31 @@ func (mr *MockBackupServiceMockRecorder) ListFiles(arg0, arg1, arg2, arg3 interf
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFiles", reflect.TypeOf((*MockBackupService)(nil).ListFiles), arg0, arg1, arg2, arg3)
 }
+
+// PutEncryptionKey mocks base method.
+func (m *MockBackupService) PutEncryptionKey(arg0 context.Context, arg1 backup.EncryptionKey) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutEncryptionKey", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PutEncryptionKey indicates an expected call of PutEncryptionKey.
+func (mr *MockBackupServiceMockRecorder) PutEncryptionKey(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutEncryptionKey", reflect.TypeOf((*MockBackupService)(nil).PutEncryptionKey), arg0, arg1)
+}
+
+// PutSLAPolicy mocks base method.
+func (m *MockBackupService) PutSLAPolicy(arg0 context.Context, arg1 *backup.SLAPolicy) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutSLAPolicy", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PutSLAPolicy indicates an expected call of PutSLAPolicy.
+func (mr *MockBackupServiceMockRecorder) PutSLAPolicy(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutSLAPolicy", reflect.TypeOf((*MockBackupService)(nil).PutSLAPolicy), arg0, arg1)
+}
@@ -1,5 +1,5 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/scylladb/scylla-manager/pkg/restapi (interfaces: SchedService)
+// Source: github.com/scylladb/scylla-manager/v3/pkg/restapi (interfaces: SchedService)
 
 // Package restapi is a generated GoMock package.
 package restapi
@@ -13,30 +13,44 @@ import (
 	uuid "github.com/scylladb/scylla-manager/v3/pkg/util/uuid"
 )
 
-// MockSchedService is a mock of SchedService interface
+// MockSchedService is a mock of SchedService interface.
 type MockSchedService struct {
 	ctrl     *gomock.Controller
 	recorder *MockSchedServiceMockRecorder
 }
 
-// MockSchedServiceMockRecorder is the mock recorder for MockSchedService
+// MockSchedServiceMockRecorder is the mock recorder for MockSchedService.
 type MockSchedServiceMockRecorder struct {
 	mock *MockSchedService
 }
 
-// NewMockSchedService creates a new mock instance
+// NewMockSchedService creates a new mock instance.
 func NewMockSchedService(ctrl *gomock.Controller) *MockSchedService {
 	mock := &MockSchedService{ctrl: ctrl}
 	mock.recorder = &MockSchedServiceMockRecorder{mock}
 	return mock
 }
 
-// EXPECT returns an object that allows the caller to indicate expected use
+// EXPECT returns an object that allows the caller to indicate expected use.
 func (m *MockSchedService) EXPECT() *MockSchedServiceMockRecorder {
 	return m.recorder
 }
 
-// DeleteTask mocks base method
+// DeleteBlackout mocks base method.
+func (m *MockSchedService) DeleteBlackout(arg0 context.Context, arg1, arg2 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteBlackout", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteBlackout indicates an expected call of DeleteBlackout.
+func (mr *MockSchedServiceMockRecorder) DeleteBlackout(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBlackout", reflect.TypeOf((*MockSchedService)(nil).DeleteBlackout), arg0, arg1, arg2)
+}
+
+// DeleteTask mocks base method.
 func (m *MockSchedService) DeleteTask(arg0 context.Context, arg1 *scheduler.Task) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "DeleteTask", arg0, arg1)
@@ -44,13 +58,13 @@ func (m *MockSchedService) DeleteTask(arg0 context.Context, arg1 *scheduler.Task
 	return ret0
 }
 
-// DeleteTask indicates an expected call of DeleteTask
+// DeleteTask indicates an expected call of DeleteTask.
 func (mr *MockSchedServiceMockRecorder) DeleteTask(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTask", reflect.TypeOf((*MockSchedService)(nil).DeleteTask), arg0, arg1)
 }
 
-// GetLastRuns mocks base method
+// GetLastRuns mocks base method.
 func (m *MockSchedService) GetLastRuns(arg0 context.Context, arg1 *scheduler.Task, arg2 int) ([]*scheduler.Run, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetLastRuns", arg0, arg1, arg2)
@@ -59,13 +73,28 @@ func (m *MockSchedService) GetLastRuns(arg0 context.Context, arg1 *scheduler.Tas
 	return ret0, ret1
 }
 
-// GetLastRuns indicates an expected call of GetLastRuns
+// GetLastRuns indicates an expected call of GetLastRuns.
 func (mr *MockSchedServiceMockRecorder) GetLastRuns(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLastRuns", reflect.TypeOf((*MockSchedService)(nil).GetLastRuns), arg0, arg1, arg2)
 }
 
-// GetRun mocks base method
+// GetNthLastRun mocks base method.
+func (m *MockSchedService) GetNthLastRun(arg0 context.Context, arg1 *scheduler.Task, arg2 int) (*scheduler.Run, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNthLastRun", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*scheduler.Run)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNthLastRun indicates an expected call of GetNthLastRun.
+func (mr *MockSchedServiceMockRecorder) GetNthLastRun(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNthLastRun", reflect.TypeOf((*MockSchedService)(nil).GetNthLastRun), arg0, arg1, arg2)
+}
+
+// GetRun mocks base method.
 func (m *MockSchedService) GetRun(arg0 context.Context, arg1 *scheduler.Task, arg2 uuid.UUID) (*scheduler.Run, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetRun", arg0, arg1, arg2)
@@ -74,13 +103,13 @@ func (m *MockSchedService) GetRun(arg0 context.Context, arg1 *scheduler.Task, ar
 	return ret0, ret1
 }
 
-// GetRun indicates an expected call of GetRun
+// GetRun indicates an expected call of GetRun.
 func (mr *MockSchedServiceMockRecorder) GetRun(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRun", reflect.TypeOf((*MockSchedService)(nil).GetRun), arg0, arg1, arg2)
 }
 
-// GetTaskByID mocks base method
+// GetTaskByID mocks base method.
 func (m *MockSchedService) GetTaskByID(arg0 context.Context, arg1 uuid.UUID, arg2 scheduler.TaskType, arg3 uuid.UUID) (*scheduler.Task, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetTaskByID", arg0, arg1, arg2, arg3)
@@ -89,13 +118,13 @@ func (m *MockSchedService) GetTaskByID(arg0 context.Context, arg1 uuid.UUID, arg
 	return ret0, ret1
 }
 
-// GetTaskByID indicates an expected call of GetTaskByID
+// GetTaskByID indicates an expected call of GetTaskByID.
 func (mr *MockSchedServiceMockRecorder) GetTaskByID(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTaskByID", reflect.TypeOf((*MockSchedService)(nil).GetTaskByID), arg0, arg1, arg2, arg3)
 }
 
-// IsSuspended mocks base method
+// IsSuspended mocks base method.
 func (m *MockSchedService) IsSuspended(arg0 context.Context, arg1 uuid.UUID) bool {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "IsSuspended", arg0, arg1)
@@ -103,13 +132,28 @@ func (m *MockSchedService) IsSuspended(arg0 context.Context, arg1 uuid.UUID) boo
 	return ret0
 }
 
-// IsSuspended indicates an expected call of IsSuspended
+// IsSuspended indicates an expected call of IsSuspended.
 func (mr *MockSchedServiceMockRecorder) IsSuspended(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsSuspended", reflect.TypeOf((*MockSchedService)(nil).IsSuspended), arg0, arg1)
 }
 
-// ListTasks mocks base method
+// ListBlackouts mocks base method.
+func (m *MockSchedService) ListBlackouts(arg0 context.Context, arg1 uuid.UUID) ([]*scheduler.Blackout, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListBlackouts", arg0, arg1)
+	ret0, _ := ret[0].([]*scheduler.Blackout)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListBlackouts indicates an expected call of ListBlackouts.
+func (mr *MockSchedServiceMockRecorder) ListBlackouts(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBlackouts", reflect.TypeOf((*MockSchedService)(nil).ListBlackouts), arg0, arg1)
+}
+
+// ListTasks mocks base method.
 func (m *MockSchedService) ListTasks(arg0 context.Context, arg1 uuid.UUID, arg2 scheduler.ListFilter) ([]*scheduler.TaskListItem, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "ListTasks", arg0, arg1, arg2)
@@ -118,13 +162,13 @@ func (m *MockSchedService) ListTasks(arg0 context.Context, arg1 uuid.UUID, arg2
 	return ret0, ret1
 }
 
-// ListTasks indicates an expected call of ListTasks
+// ListTasks indicates an expected call of ListTasks.
 func (mr *MockSchedServiceMockRecorder) ListTasks(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTasks", reflect.TypeOf((*MockSchedService)(nil).ListTasks), arg0, arg1, arg2)
 }
 
-// PropertiesDecorator mocks base method
+// PropertiesDecorator mocks base method.
 func (m *MockSchedService) PropertiesDecorator(arg0 scheduler.TaskType) scheduler.PropertiesDecorator {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "PropertiesDecorator", arg0)
@@ -132,41 +176,41 @@ func (m *MockSchedService) PropertiesDecorator(arg0 scheduler.TaskType) schedule
 	return ret0
 }
 
-// PropertiesDecorator indicates an expected call of PropertiesDecorator
+// PropertiesDecorator indicates an expected call of PropertiesDecorator.
 func (mr *MockSchedServiceMockRecorder) PropertiesDecorator(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PropertiesDecorator", reflect.TypeOf((*MockSchedService)(nil).PropertiesDecorator), arg0)
 }
 
-// PutTask mocks base method
-func (m *MockSchedService) PutTask(arg0 context.Context, arg1 *scheduler.Task) error {
+// PutBlackout mocks base method.
+func (m *MockSchedService) PutBlackout(arg0 context.Context, arg1 *scheduler.Blackout) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "PutTask", arg0, arg1)
+	ret := m.ctrl.Call(m, "PutBlackout", arg0, arg1)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// PutTask indicates an expected call of PutTask
-func (mr *MockSchedServiceMockRecorder) PutTask(arg0, arg1 interface{}) *gomock.Call {
+// PutBlackout indicates an expected call of PutBlackout.
+func (mr *MockSchedServiceMockRecorder) PutBlackout(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutTask", reflect.TypeOf((*MockSchedService)(nil).PutTask), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutBlackout", reflect.TypeOf((*MockSchedService)(nil).PutBlackout), arg0, arg1)
 }
 
-// PutTaskOnce mocks base method
-func (m *MockSchedService) PutTaskOnce(arg0 context.Context, arg1 *scheduler.Task) error {
+// PutTask mocks base method.
+func (m *MockSchedService) PutTask(arg0 context.Context, arg1 *scheduler.Task) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "PutTaskOnce", arg0, arg1)
+	ret := m.ctrl.Call(m, "PutTask", arg0, arg1)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// PutTaskOnce indicates an expected call of PutTaskOnce
-func (mr *MockSchedServiceMockRecorder) PutTaskOnce(arg0, arg1 interface{}) *gomock.Call {
+// PutTask indicates an expected call of PutTask.
+func (mr *MockSchedServiceMockRecorder) PutTask(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutTaskOnce", reflect.TypeOf((*MockSchedService)(nil).PutTaskOnce), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutTask", reflect.TypeOf((*MockSchedService)(nil).PutTask), arg0, arg1)
 }
 
-// Resume mocks base method
+// Resume mocks base method.
 func (m *MockSchedService) Resume(arg0 context.Context, arg1 uuid.UUID, arg2 bool) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Resume", arg0, arg1, arg2)
@@ -174,13 +218,13 @@ func (m *MockSchedService) Resume(arg0 context.Context, arg1 uuid.UUID, arg2 boo
 	return ret0
 }
 
-// Resume indicates an expected call of Resume
+// Resume indicates an expected call of Resume.
 func (mr *MockSchedServiceMockRecorder) Resume(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Resume", reflect.TypeOf((*MockSchedService)(nil).Resume), arg0, arg1, arg2)
 }
 
-// StartTask mocks base method
+// StartTask mocks base method.
 func (m *MockSchedService) StartTask(arg0 context.Context, arg1 *scheduler.Task) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "StartTask", arg0, arg1)
@@ -188,13 +232,13 @@ func (m *MockSchedService) StartTask(arg0 context.Context, arg1 *scheduler.Task)
 	return ret0
 }
 
-// StartTask indicates an expected call of StartTask
+// StartTask indicates an expected call of StartTask.
 func (mr *MockSchedServiceMockRecorder) StartTask(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartTask", reflect.TypeOf((*MockSchedService)(nil).StartTask), arg0, arg1)
 }
 
-// StartTaskNoContinue mocks base method
+// StartTaskNoContinue mocks base method.
 func (m *MockSchedService) StartTaskNoContinue(arg0 context.Context, arg1 *scheduler.Task) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "StartTaskNoContinue", arg0, arg1)
@@ -202,13 +246,13 @@ func (m *MockSchedService) StartTaskNoContinue(arg0 context.Context, arg1 *sched
 	return ret0
 }
 
-// StartTaskNoContinue indicates an expected call of StartTaskNoContinue
+// StartTaskNoContinue indicates an expected call of StartTaskNoContinue.
 func (mr *MockSchedServiceMockRecorder) StartTaskNoContinue(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartTaskNoContinue", reflect.TypeOf((*MockSchedService)(nil).StartTaskNoContinue), arg0, arg1)
 }
 
-// StopTask mocks base method
+// StopTask mocks base method.
 func (m *MockSchedService) StopTask(arg0 context.Context, arg1 *scheduler.Task) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "StopTask", arg0, arg1)
@@ -216,13 +260,13 @@ func (m *MockSchedService) StopTask(arg0 context.Context, arg1 *scheduler.Task)
 	return ret0
 }
 
-// StopTask indicates an expected call of StopTask
+// StopTask indicates an expected call of StopTask.
 func (mr *MockSchedServiceMockRecorder) StopTask(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StopTask", reflect.TypeOf((*MockSchedService)(nil).StopTask), arg0, arg1)
 }
 
-// Suspend mocks base method
+// Suspend mocks base method.
 func (m *MockSchedService) Suspend(arg0 context.Context, arg1 uuid.UUID) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Suspend", arg0, arg1)
@@ -230,7 +274,7 @@ func (m *MockSchedService) Suspend(arg0 context.Context, arg1 uuid.UUID) error {
 	return ret0
 }
 
-// Suspend indicates an expected call of Suspend
+// Suspend indicates an expected call of Suspend.
 func (mr *MockSchedServiceMockRecorder) Suspend(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Suspend", reflect.TypeOf((*MockSchedService)(nil).Suspend), arg0, arg1)
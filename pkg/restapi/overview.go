@@ -0,0 +1,209 @@
+// Copyright (C) 2024 ScyllaDB
+
+package restapi
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/render"
+	"github.com/pkg/errors"
+	"github.com/scylladb/scylla-manager/v3/pkg/service/cluster"
+	"github.com/scylladb/scylla-manager/v3/pkg/service/scheduler"
+	"github.com/scylladb/scylla-manager/v3/pkg/util/parallel"
+	"github.com/scylladb/scylla-manager/v3/pkg/util/uuid"
+)
+
+// clusterOverview summarizes state of a cluster, it allows for getting
+// a view of all managed clusters in a single call.
+type clusterOverview struct {
+	ClusterID      uuid.UUID         `json:"cluster_id"`
+	Name           string            `json:"name"`
+	Labels         map[string]string `json:"labels,omitempty"`
+	Suspended      bool              `json:"suspended"`
+	Health         healthSummary     `json:"health"`
+	LastBackup     *taskSummary      `json:"last_backup,omitempty"`
+	LastRepair     *taskSummary      `json:"last_repair,omitempty"`
+	NextActivation *time.Time        `json:"next_activation,omitempty"`
+	NextTask       *taskSummary      `json:"next_task,omitempty"`
+	RunningTasks   []taskSummary     `json:"running_tasks,omitempty"`
+	// Error is set if tasks of the cluster could not be listed.
+	Error string `json:"error,omitempty"`
+}
+
+// healthSummary is an aggregate of healthcheck node statuses.
+type healthSummary struct {
+	Nodes  int `json:"nodes"`
+	Up     int `json:"up"`
+	CQLUp  int `json:"cql_up"`
+	RESTUp int `json:"rest_up"`
+	// Error is set if cluster status could not be checked.
+	Error string `json:"error,omitempty"`
+}
+
+type taskSummary struct {
+	Type        scheduler.TaskType `json:"type"`
+	ID          uuid.UUID          `json:"id"`
+	Name        string             `json:"name,omitempty"`
+	Status      scheduler.Status   `json:"status"`
+	LastSuccess *time.Time         `json:"last_success,omitempty"`
+	LastError   *time.Time         `json:"last_error,omitempty"`
+}
+
+func makeTaskSummary(t *scheduler.TaskListItem) *taskSummary {
+	return &taskSummary{
+		Type:        t.Type,
+		ID:          t.ID,
+		Name:        t.Name,
+		Status:      t.Status,
+		LastSuccess: t.LastSuccess,
+		LastError:   t.LastError,
+	}
+}
+
+// lastRun returns the end time of the last successful or failed run.
+func (t *taskSummary) lastRun() time.Time {
+	var out time.Time
+	if t.LastSuccess != nil {
+		out = *t.LastSuccess
+	}
+	if t.LastError != nil && t.LastError.After(out) {
+		out = *t.LastError
+	}
+	return out
+}
+
+type overviewHandler struct {
+	Services
+}
+
+func newOverviewHandler(services Services) *chi.Mux {
+	m := chi.NewMux()
+	h := overviewHandler{services}
+
+	m.Get("/", h.get)
+
+	return m
+}
+
+func (h overviewHandler) get(w http.ResponseWriter, r *http.Request) {
+	labels, err := parseLabelSelector(r.URL.Query()["label"])
+	if err != nil {
+		respondBadRequest(w, r, err)
+		return
+	}
+
+	clusters, err := h.Cluster.ListClusters(r.Context(), &cluster.Filter{})
+	if err != nil {
+		respondError(w, r, errors.Wrap(err, "list clusters"))
+		return
+	}
+	t := tokenFromCtx(r)
+	var filtered []*cluster.Cluster
+	for _, c := range clusters {
+		if t != nil && !t.AllClusters() && !t.CanAccessCluster(c.ID) {
+			continue
+		}
+		if !matchLabels(c.Labels, labels) {
+			continue
+		}
+		filtered = append(filtered, c)
+	}
+
+	out := make([]clusterOverview, len(filtered))
+	f := func(i int) error {
+		out[i] = h.clusterOverview(r.Context(), filtered[i])
+		return nil
+	}
+	_ = parallel.Run(len(filtered), parallel.NoLimit, f, nil)
+
+	render.Respond(w, r, out)
+}
+
+func (h overviewHandler) clusterOverview(ctx context.Context, c *cluster.Cluster) clusterOverview {
+	o := clusterOverview{
+		ClusterID: c.ID,
+		Name:      c.Name,
+		Labels:    c.Labels,
+		Suspended: h.Scheduler.IsSuspended(ctx, c.ID),
+	}
+
+	status, err := h.HealthCheck.Status(ctx, c.ID)
+	if err != nil {
+		o.Health.Error = err.Error()
+	}
+	for _, s := range status {
+		o.Health.Nodes++
+		if strings.HasPrefix(s.Status, "U") {
+			o.Health.Up++
+		}
+		if s.CQLStatus == "UP" {
+			o.Health.CQLUp++
+		}
+		if s.RESTStatus == "UP" {
+			o.Health.RESTUp++
+		}
+	}
+
+	tasks, err := h.Scheduler.ListTasks(ctx, c.ID, scheduler.ListFilter{})
+	if err != nil {
+		o.Error = errors.Wrap(err, "list tasks").Error()
+		return o
+	}
+	for _, t := range tasks {
+		s := makeTaskSummary(t)
+		switch t.Type {
+		case scheduler.BackupTask:
+			if o.LastBackup == nil || s.lastRun().After(o.LastBackup.lastRun()) {
+				o.LastBackup = s
+			}
+		case scheduler.RepairTask:
+			if o.LastRepair == nil || s.lastRun().After(o.LastRepair.lastRun()) {
+				o.LastRepair = s
+			}
+		}
+		if t.Status == scheduler.StatusRunning {
+			o.RunningTasks = append(o.RunningTasks, *s)
+		}
+		// Health checks are activated every few seconds, they would always
+		// be reported as the next activation.
+		if t.Type != scheduler.HealthCheckTask && t.NextActivation != nil &&
+			(o.NextActivation == nil || t.NextActivation.Before(*o.NextActivation)) {
+			o.NextActivation = t.NextActivation
+			o.NextTask = s
+		}
+	}
+
+	return o
+}
+
+// parseLabelSelector parses label query values in k1=v1,k2=v2 format.
+func parseLabelSelector(values []string) (map[string]string, error) {
+	out := make(map[string]string)
+	for _, v := range values {
+		for _, l := range strings.Split(v, ",") {
+			if l == "" {
+				continue
+			}
+			k, val, ok := strings.Cut(l, "=")
+			if !ok || k == "" {
+				return nil, errors.Errorf("invalid label %q, expected key=value", l)
+			}
+			out[k] = val
+		}
+	}
+	return out, nil
+}
+
+// matchLabels returns true if labels contain all the selector labels.
+func matchLabels(labels, selector map[string]string) bool {
+	for k, v := range selector {
+		if lv, ok := labels[k]; !ok || lv != v {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,139 @@
+// Copyright (C) 2024 ScyllaDB
+
+//go:generate mockgen -destination mock_healthcheckservice_test.go -mock_names HealthCheckService=MockHealthCheckService -package restapi github.com/scylladb/scylla-manager/v3/pkg/restapi HealthCheckService
+
+package restapi_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/scylladb/go-log"
+	"github.com/scylladb/scylla-manager/v3/pkg/restapi"
+	"github.com/scylladb/scylla-manager/v3/pkg/service/cluster"
+	"github.com/scylladb/scylla-manager/v3/pkg/service/healthcheck"
+	"github.com/scylladb/scylla-manager/v3/pkg/service/scheduler"
+	"github.com/scylladb/scylla-manager/v3/pkg/util/uuid"
+)
+
+func TestOverview(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	prod := givenCluster()
+	prod.Labels = map[string]string{"env": "prod", "dc": "eu"}
+	dev := givenCluster()
+	dev.Labels = map[string]string{"env": "dev"}
+
+	cm := restapi.NewMockClusterService(ctrl)
+	cm.EXPECT().ListClusters(gomock.Any(), gomock.Any()).Return([]*cluster.Cluster{prod, dev}, nil)
+
+	hm := restapi.NewMockHealthCheckService(ctrl)
+	hm.EXPECT().Status(gomock.Any(), prod.ID).Return([]healthcheck.NodeStatus{
+		{Status: "UN", CQLStatus: "UP", RESTStatus: "UP"},
+		{Status: "UN", CQLStatus: "UP", RESTStatus: "HTTP 500"},
+		{Status: "DN", CQLStatus: "DOWN", RESTStatus: "DOWN"},
+	}, nil)
+
+	var (
+		now       = time.Now().UTC().Truncate(time.Second)
+		hourAgo   = now.Add(-time.Hour)
+		dayAgo    = now.Add(-24 * time.Hour)
+		inHour    = now.Add(time.Hour)
+		inMinute  = now.Add(time.Minute)
+		inSeconds = now.Add(15 * time.Second)
+	)
+	oldBackup := &scheduler.TaskListItem{
+		Task:           scheduler.Task{Type: scheduler.BackupTask, ID: uuid.NewTime(), Status: scheduler.StatusDone, LastSuccess: &dayAgo},
+		NextActivation: &inHour,
+	}
+	newBackup := &scheduler.TaskListItem{
+		Task: scheduler.Task{Type: scheduler.BackupTask, ID: uuid.NewTime(), Status: scheduler.StatusError, LastSuccess: &dayAgo, LastError: &hourAgo},
+	}
+	repair := &scheduler.TaskListItem{
+		Task:           scheduler.Task{Type: scheduler.RepairTask, ID: uuid.NewTime(), Status: scheduler.StatusRunning},
+		NextActivation: &inMinute,
+	}
+	health := &scheduler.TaskListItem{
+		Task:           scheduler.Task{Type: scheduler.HealthCheckTask, ID: uuid.NewTime(), Status: scheduler.StatusDone},
+		NextActivation: &inSeconds,
+	}
+
+	sm := restapi.NewMockSchedService(ctrl)
+	sm.EXPECT().IsSuspended(gomock.Any(), prod.ID).Return(true)
+	sm.EXPECT().ListTasks(gomock.Any(), prod.ID, scheduler.ListFilter{}).Return([]*scheduler.TaskListItem{oldBackup, newBackup, repair, health}, nil)
+
+	h := restapi.New(restapi.Services{Cluster: cm, HealthCheck: hm, Scheduler: sm}, log.Logger{})
+	r := httptest.NewRequest(http.MethodGet, "/api/v1/overview?label=env=prod", nil)
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, r)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("Expected status %d, got %d: %s", http.StatusOK, w.Code, w.Body.String())
+	}
+
+	type task struct {
+		ID     uuid.UUID `json:"id"`
+		Status string    `json:"status"`
+	}
+	var out []struct {
+		ClusterID uuid.UUID `json:"cluster_id"`
+		Suspended bool      `json:"suspended"`
+		Health    struct {
+			Nodes  int `json:"nodes"`
+			Up     int `json:"up"`
+			CQLUp  int `json:"cql_up"`
+			RESTUp int `json:"rest_up"`
+		} `json:"health"`
+		LastBackup     *task      `json:"last_backup"`
+		LastRepair     *task      `json:"last_repair"`
+		NextActivation *time.Time `json:"next_activation"`
+		NextTask       *task      `json:"next_task"`
+		RunningTasks   []task     `json:"running_tasks"`
+	}
+	if err := json.NewDecoder(w.Body).Decode(&out); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(out) != 1 || out[0].ClusterID != prod.ID {
+		t.Fatalf("Overview = %+v, expected only cluster %s", out, prod.ID)
+	}
+	o := out[0]
+	if !o.Suspended {
+		t.Error("Expected cluster to be suspended")
+	}
+	if o.Health.Nodes != 3 || o.Health.Up != 2 || o.Health.CQLUp != 2 || o.Health.RESTUp != 1 {
+		t.Errorf("Health = %+v, expected 3 nodes, 2 up, 2 CQL up, 1 REST up", o.Health)
+	}
+	if o.LastBackup == nil || o.LastBackup.ID != newBackup.ID || o.LastBackup.Status != "ERROR" {
+		t.Errorf("LastBackup = %+v, expected task %s", o.LastBackup, newBackup.ID)
+	}
+	if o.LastRepair == nil || o.LastRepair.ID != repair.ID {
+		t.Errorf("LastRepair = %+v, expected task %s", o.LastRepair, repair.ID)
+	}
+	if o.NextActivation == nil || !o.NextActivation.Equal(inMinute) || o.NextTask == nil || o.NextTask.ID != repair.ID {
+		t.Errorf("NextActivation = %v, NextTask = %+v, expected repair at %s", o.NextActivation, o.NextTask, inMinute)
+	}
+	if len(o.RunningTasks) != 1 || o.RunningTasks[0].ID != repair.ID {
+		t.Errorf("RunningTasks = %+v, expected repair %s", o.RunningTasks, repair.ID)
+	}
+}
+
+func TestOverviewInvalidLabel(t *testing.T) {
+	t.Parallel()
+
+	h := restapi.New(restapi.Services{}, log.Logger{})
+	r := httptest.NewRequest(http.MethodGet, "/api/v1/overview?label=env", nil)
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, r)
+
+	if w.Code != http.StatusBadRequest {
+		t.Fatalf("Expected status %d, got %d: %s", http.StatusBadRequest, w.Code, w.Body.String())
+	}
+}
@@ -0,0 +1,45 @@
+// Copyright (C) 2024 ScyllaDB
+
+package restapi
+
+import (
+	"testing"
+
+	"github.com/scylladb/scylla-manager/v3/pkg/util/uuid"
+)
+
+func TestProgressStreamsSubscribe(t *testing.T) {
+	ps := newProgressStreams()
+	key := progressStreamKey{taskID: uuid.NewTime(), ref: runRef{offset: -1, id: uuid.NewTime()}}
+
+	var first *progressStream
+	for i := 0; i < maxStreamsPerRun; i++ {
+		s, ok := ps.subscribe(key)
+		if !ok {
+			t.Fatalf("subscribe() %d rejected", i)
+		}
+		if first == nil {
+			first = s
+		}
+		if s != first {
+			t.Fatal("subscribe() returned different streams of the same run")
+		}
+	}
+	if _, ok := ps.subscribe(key); ok {
+		t.Fatalf("subscribe() accepted more than %d streams", maxStreamsPerRun)
+	}
+	if _, ok := ps.subscribe(progressStreamKey{taskID: key.taskID}); !ok {
+		t.Fatal("subscribe() rejected stream of other run")
+	}
+
+	ps.unsubscribe(key)
+	if _, ok := ps.subscribe(key); !ok {
+		t.Fatal("subscribe() rejected stream after unsubscribe")
+	}
+	for i := 0; i < maxStreamsPerRun; i++ {
+		ps.unsubscribe(key)
+	}
+	if _, ok := ps.streams[key]; ok {
+		t.Fatal("Stream not removed after all subscribers left")
+	}
+}
@@ -35,14 +35,33 @@ func New(services Services, logger log.Logger) http.Handler {
 	r.Get("/version", Version())
 	r.Get("/api/v1/version", Version()) // For backwards compatibility
 
-	r.Mount("/api/v1/", newClusterHandler(services.Cluster))
-	f := clusterFilter{svc: services.Cluster}.clusterCtx
-	r.With(f).Mount("/api/v1/cluster/{cluster_id}/status", newStatusHandler(services.Cluster, services.HealthCheck))
-	r.With(f).Mount("/api/v1/cluster/{cluster_id}/suspended", newSuspendHandler(services))
-	r.With(f).Mount("/api/v1/cluster/{cluster_id}/tasks", newTasksHandler(services))
-	r.With(f).Mount("/api/v1/cluster/{cluster_id}/task", newTaskHandler(services))
-	r.With(f).Mount("/api/v1/cluster/{cluster_id}/backups", newBackupHandler(services))
-	r.With(f).Mount("/api/v1/cluster/{cluster_id}/repairs", newRepairHandler(services))
+	r.Group(func(r chi.Router) {
+		r.Use(
+			auditLog(services.Audit, logger),
+			authenticate(services.Access),
+			requireLeader(services.Leader),
+		)
+
+		r.Mount("/api/v1/", newClusterHandler(services.Cluster))
+		r.Mount("/api/v1/overview", newOverviewHandler(services))
+		f := clusterFilter{svc: services.Cluster}.clusterCtx
+		r.With(f).Mount("/api/v1/cluster/{cluster_id}/status", newStatusHandler(services.Cluster, services.HealthCheck))
+		r.With(f).Mount("/api/v1/cluster/{cluster_id}/suspended", newSuspendHandler(services))
+		r.With(f).Mount("/api/v1/cluster/{cluster_id}/blackouts", newBlackoutHandler(services))
+		r.With(f).Mount("/api/v1/cluster/{cluster_id}/tasks", newTasksHandler(services))
+		r.With(f).Mount("/api/v1/cluster/{cluster_id}/task", newTaskHandler(services))
+		r.With(f).Mount("/api/v1/cluster/{cluster_id}/backups", newBackupHandler(services))
+		r.With(f).Mount("/api/v1/cluster/{cluster_id}/sla", newSLAHandler(services))
+		r.With(f).Mount("/api/v1/cluster/{cluster_id}/repairs", newRepairHandler(services))
+		r.Mount("/api/v1/backup/encryption-key", newEncryptionKeyHandler(services))
+		if services.Access != nil {
+			r.Mount("/api/v1/tokens", newTokensHandler(services.Access))
+			r.Mount("/api/v1/token", newTokenHandler(services.Access))
+		}
+		if services.Audit != nil {
+			r.Mount("/api/v1/audit", newAuditHandler(services))
+		}
+	})
 
 	// NotFound registered last due to https://github.com/go-chi/chi/issues/297
 	r.NotFound(func(w http.ResponseWriter, r *http.Request) {
@@ -6,6 +6,8 @@ import (
 	"context"
 	"encoding/json"
 
+	"github.com/scylladb/scylla-manager/v3/pkg/service/access"
+	"github.com/scylladb/scylla-manager/v3/pkg/service/audit"
 	"github.com/scylladb/scylla-manager/v3/pkg/service/backup"
 	"github.com/scylladb/scylla-manager/v3/pkg/service/backup/backupspec"
 	"github.com/scylladb/scylla-manager/v3/pkg/service/cluster"
@@ -24,6 +26,9 @@ type Services struct {
 	Backup      BackupService
 	Restore     RestoreService
 	Scheduler   SchedService
+	Access      AccessService
+	Audit       AuditService
+	Leader      LeaderService
 }
 
 // ClusterService service interface for the REST API handlers.
@@ -63,11 +68,19 @@ type BackupService interface {
 	DeleteSnapshot(ctx context.Context, clusterID uuid.UUID, locations []backupspec.Location, snapshotTags []string) error
 	GetValidationTarget(_ context.Context, clusterID uuid.UUID, properties json.RawMessage) (backup.ValidationTarget, error)
 	GetValidationProgress(ctx context.Context, clusterID, taskID, runID uuid.UUID) ([]backup.ValidationHostProgress, error)
+	GetSLAPolicy(ctx context.Context, clusterID uuid.UUID) (*backup.SLAPolicy, error)
+	PutSLAPolicy(ctx context.Context, p *backup.SLAPolicy) error
+	DeleteSLAPolicy(ctx context.Context, clusterID uuid.UUID) error
+	CheckSLA(ctx context.Context, clusterID uuid.UUID) (backup.SLAReport, error)
+	GetEncryptionKey(ctx context.Context, clusterID uuid.UUID) (backup.EncryptionKey, error)
+	PutEncryptionKey(ctx context.Context, k backup.EncryptionKey) error
+	DeleteEncryptionKey(ctx context.Context, clusterID uuid.UUID) error
 }
 
 // RestoreService service interface for the REST API handlers.
 type RestoreService interface {
 	GetTargetUnitsViews(ctx context.Context, clusterID uuid.UUID, properties json.RawMessage) (restore.Target, []restore.Unit, []restore.View, error)
+	GetPlan(ctx context.Context, clusterID uuid.UUID, properties json.RawMessage) (restore.Plan, error)
 	GetProgress(ctx context.Context, clusterID, taskID, runID uuid.UUID) (restore.Progress, error)
 }
 
@@ -87,4 +100,27 @@ type SchedService interface {
 	IsSuspended(ctx context.Context, clusterID uuid.UUID) bool
 	Suspend(ctx context.Context, clusterID uuid.UUID) error
 	Resume(ctx context.Context, clusterID uuid.UUID, startTasks bool) error
+	ListBlackouts(ctx context.Context, clusterID uuid.UUID) ([]*scheduler.Blackout, error)
+	PutBlackout(ctx context.Context, b *scheduler.Blackout) error
+	DeleteBlackout(ctx context.Context, clusterID, id uuid.UUID) error
+}
+
+// AccessService service interface for the REST API handlers.
+type AccessService interface {
+	Authenticate(ctx context.Context, token string) (*access.Token, error)
+	ListTokens(ctx context.Context) ([]*access.Token, error)
+	CreateToken(ctx context.Context, t *access.Token) (string, error)
+	DeleteToken(ctx context.Context, id uuid.UUID) error
+}
+
+// AuditService service interface for the REST API handlers.
+type AuditService interface {
+	Log(ctx context.Context, e *audit.Entry) error
+	List(ctx context.Context, f audit.Filter) ([]*audit.Entry, error)
+}
+
+// LeaderService service interface for the REST API handlers.
+type LeaderService interface {
+	IsLeader() bool
+	Leader(ctx context.Context) (string, error)
 }
@@ -0,0 +1,75 @@
+// Copyright (C) 2024 ScyllaDB
+
+package restapi
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/render"
+	"github.com/pkg/errors"
+	"github.com/scylladb/scylla-manager/v3/pkg/service/backup"
+)
+
+type slaHandler struct {
+	svc BackupService
+}
+
+func newSLAHandler(services Services) *chi.Mux {
+	m := chi.NewMux()
+	h := slaHandler{
+		svc: services.Backup,
+	}
+
+	m.Get("/", h.check)
+	m.Get("/policy", h.getPolicy)
+	m.Put("/policy", h.putPolicy)
+	m.Delete("/policy", h.deletePolicy)
+
+	return m
+}
+
+func (h slaHandler) check(w http.ResponseWriter, r *http.Request) {
+	report, err := h.svc.CheckSLA(r.Context(), mustClusterIDFromCtx(r))
+	if err != nil {
+		respondError(w, r, errors.Wrap(err, "check backup SLA"))
+		return
+	}
+	render.Respond(w, r, report)
+}
+
+func (h slaHandler) getPolicy(w http.ResponseWriter, r *http.Request) {
+	p, err := h.svc.GetSLAPolicy(r.Context(), mustClusterIDFromCtx(r))
+	if err != nil {
+		respondError(w, r, errors.Wrap(err, "get backup SLA policy"))
+		return
+	}
+	render.Respond(w, r, p)
+}
+
+func (h slaHandler) putPolicy(w http.ResponseWriter, r *http.Request) {
+	var p backup.SLAPolicy
+	if err := render.DecodeJSON(r.Body, &p); err != nil {
+		respondBadRequest(w, r, err)
+		return
+	}
+	p.ClusterID = mustClusterIDFromCtx(r)
+
+	if err := h.svc.PutSLAPolicy(r.Context(), &p); err != nil {
+		respondError(w, r, errors.Wrap(err, "put backup SLA policy"))
+		return
+	}
+	setAuditResource(r, "sla_policy", p.ClusterID.String(), nil, &p)
+
+	render.Respond(w, r, p)
+}
+
+func (h slaHandler) deletePolicy(w http.ResponseWriter, r *http.Request) {
+	clusterID := mustClusterIDFromCtx(r)
+	setAuditResource(r, "sla_policy", clusterID.String(), nil, nil)
+	if err := h.svc.DeleteSLAPolicy(r.Context(), clusterID); err != nil {
+		respondError(w, r, errors.Wrap(err, "delete backup SLA policy"))
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
@@ -0,0 +1,89 @@
+// Copyright (C) 2024 ScyllaDB
+
+package restapi_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/scylladb/go-log"
+	"github.com/scylladb/scylla-manager/v3/pkg/restapi"
+	"github.com/scylladb/scylla-manager/v3/pkg/service/backup"
+	"github.com/scylladb/scylla-manager/v3/pkg/service/backup/backupspec"
+	"github.com/scylladb/scylla-manager/v3/pkg/util/duration"
+)
+
+func TestSLAPutPolicy(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	c := givenCluster()
+
+	cm := restapi.NewMockClusterService(ctrl)
+	cm.EXPECT().GetCluster(gomock.Any(), c.ID.String()).Return(c, nil)
+
+	bm := restapi.NewMockBackupService(ctrl)
+	bm.EXPECT().PutSLAPolicy(gomock.Any(), gomock.Any()).DoAndReturn(func(_ interface{}, p *backup.SLAPolicy) error {
+		if p.ClusterID != c.ID {
+			t.Errorf("ClusterID = %s, expected %s", p.ClusterID, c.ID)
+		}
+		return nil
+	})
+
+	h := restapi.New(restapi.Services{Cluster: cm, Backup: bm}, log.Logger{})
+	p := &backup.SLAPolicy{
+		Keyspace: []string{"ks*"},
+		Location: []backupspec.Location{{Provider: backupspec.S3, Path: "bucket"}},
+		MaxAge:   duration.Duration(24 * time.Hour),
+	}
+	r := httptest.NewRequest(http.MethodPut, "/api/v1/cluster/"+c.ID.String()+"/sla/policy", jsonBody(t, p))
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, r)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("Expected status %d, got %d: %s", http.StatusOK, w.Code, w.Body.String())
+	}
+}
+
+func TestSLACheck(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	c := givenCluster()
+
+	cm := restapi.NewMockClusterService(ctrl)
+	cm.EXPECT().GetCluster(gomock.Any(), c.ID.String()).Return(c, nil)
+
+	report := backup.SLAReport{
+		ClusterID: c.ID,
+		Status: []backup.SLAStatus{
+			{Keyspace: "ks", Location: backupspec.Location{Provider: backupspec.S3, Path: "bucket"}},
+		},
+	}
+	bm := restapi.NewMockBackupService(ctrl)
+	bm.EXPECT().CheckSLA(gomock.Any(), c.ID).Return(report, nil)
+
+	h := restapi.New(restapi.Services{Cluster: cm, Backup: bm}, log.Logger{})
+	r := httptest.NewRequest(http.MethodGet, "/api/v1/cluster/"+c.ID.String()+"/sla", nil)
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, r)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("Expected status %d, got %d: %s", http.StatusOK, w.Code, w.Body.String())
+	}
+	var got backup.SLAReport
+	if err := json.NewDecoder(w.Body).Decode(&got); err != nil {
+		t.Fatal(err)
+	}
+	if got.Compliant || len(got.Violations()) != 1 {
+		t.Fatalf("Expected one violation, got %+v", got)
+	}
+}
@@ -24,22 +24,27 @@ import (
 
 type taskHandler struct {
 	Services
+	streams *progressStreams
 }
 
 func newTasksHandler(services Services) *chi.Mux {
 	m := chi.NewMux()
-	h := &taskHandler{services}
+	h := &taskHandler{Services: services}
 
 	m.Get("/", h.listTasks)
 	m.Post("/", h.createTask)
 	m.Get("/{task_type}/target", h.getTarget)
+	m.Get("/restore/plan", h.getRestorePlan)
 
 	return m
 }
 
 func newTaskHandler(services Services) *chi.Mux {
 	m := chi.NewMux()
-	h := &taskHandler{services}
+	h := &taskHandler{
+		Services: services,
+		streams:  newProgressStreams(),
+	}
 
 	m.Route("/{task_type}/{task_id}", func(r chi.Router) {
 		r.Use(h.taskCtx)
@@ -50,6 +55,7 @@ func newTaskHandler(services Services) *chi.Mux {
 		r.Put("/stop", h.stopTask)
 		r.Get("/history", h.taskHistory)
 		r.Get("/{run_id}", h.taskRunProgress)
+		r.Get("/{run_id}/stream", h.streamTaskRunProgress)
 	})
 
 	return m
@@ -243,6 +249,54 @@ func (h *taskHandler) getTarget(w http.ResponseWriter, r *http.Request) {
 	render.Respond(w, r, t)
 }
 
+type restorePlan struct {
+	restore.Plan
+	Target restoreTarget `json:"target"`
+}
+
+func (h *taskHandler) getRestorePlan(w http.ResponseWriter, r *http.Request) {
+	newTask, err := h.parseTask(r)
+	if err != nil {
+		respondBadRequest(w, r, err)
+		return
+	}
+	if newTask.ID != uuid.Nil {
+		respondBadRequest(w, r, errors.Errorf("unexpected ID %q", newTask.ID))
+		return
+	}
+	newTask.Type = scheduler.RestoreTask
+
+	d := h.Services.Scheduler.PropertiesDecorator(newTask.Type)
+	p := newTask.Properties
+	if d != nil {
+		p, err = d(r.Context(), newTask.ClusterID, newTask.ID, newTask.Properties)
+		if err != nil {
+			respondBadRequest(w, r, errors.Wrap(err, "evaluate properties"))
+			return
+		}
+	}
+
+	plan, err := h.Restore.GetPlan(r.Context(), newTask.ClusterID, p)
+	if err != nil {
+		respondError(w, r, errors.Wrap(err, "get restore plan"))
+		return
+	}
+
+	var size int64
+	for _, u := range plan.Units {
+		size += u.Size
+	}
+	render.Respond(w, r, restorePlan{
+		Plan: plan,
+		Target: restoreTarget{
+			Target: plan.Target,
+			Size:   size,
+			Units:  plan.Units,
+			Views:  plan.Views,
+		},
+	})
+}
+
 func (h *taskHandler) validateTask(ctx context.Context, newTask *scheduler.Task, p []byte) error {
 	switch newTask.Type {
 	case scheduler.BackupTask:
@@ -304,6 +358,7 @@ func (h *taskHandler) createTask(w http.ResponseWriter, r *http.Request) {
 		respondError(w, r, errors.Wrap(err, "create task"))
 		return
 	}
+	setAuditResource(r, "task", auditTaskID(newTask), nil, newTask)
 
 	taskURL := r.URL.ResolveReference(&url.URL{Path: path.Join("task", newTask.Type.String(), newTask.ID.String())})
 	w.Header().Set("Location", taskURL.String())
@@ -339,6 +394,7 @@ func (h *taskHandler) updateTask(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	setAuditResource(r, "task", auditTaskID(t), t, newTask)
 	if err := h.Scheduler.PutTask(r.Context(), newTask); err != nil {
 		respondError(w, r, errors.Wrapf(err, "update task %q", t.ID))
 		return
@@ -348,6 +404,7 @@ func (h *taskHandler) updateTask(w http.ResponseWriter, r *http.Request) {
 
 func (h *taskHandler) deleteTask(w http.ResponseWriter, r *http.Request) {
 	t := mustTaskFromCtx(r)
+	setAuditResource(r, "task", auditTaskID(t), t, nil)
 	if err := h.Scheduler.DeleteTask(r.Context(), t); err != nil {
 		respondError(w, r, errors.Wrapf(err, "delete task %q", t.ID))
 		return
@@ -361,6 +418,7 @@ func (h *taskHandler) startTask(w http.ResponseWriter, r *http.Request) {
 	if err != nil {
 		respondBadRequest(w, r, err)
 	}
+	setAuditResource(r, "task", auditTaskID(t), nil, nil)
 
 	if noContinue {
 		err = h.Scheduler.StartTaskNoContinue(r.Context(), t)
@@ -400,13 +458,19 @@ func (h *taskHandler) stopTask(w http.ResponseWriter, r *http.Request) {
 	}
 
 	if t.Enabled && disable {
+		before := *t
 		t.Enabled = false
+		setAuditResource(r, "task", auditTaskID(t), &before, t)
 		// current task is canceled on save no need to stop it again
 		if err := h.Scheduler.PutTask(r.Context(), t); err != nil {
 			respondError(w, r, errors.Wrapf(err, "update task %q", t.ID))
 			return
 		}
-	} else if err := h.Scheduler.StopTask(r.Context(), t); err != nil {
+		return
+	}
+
+	setAuditResource(r, "task", auditTaskID(t), nil, nil)
+	if err := h.Scheduler.StopTask(r.Context(), t); err != nil {
 		respondError(w, r, errors.Wrapf(err, "stop task %q", t.ID))
 		return
 	}
@@ -444,16 +508,49 @@ type taskRunProgress struct {
 
 func (h *taskHandler) taskRunProgress(w http.ResponseWriter, r *http.Request) {
 	t := mustTaskFromCtx(r)
-	p := chi.URLParam(r, "run_id")
-
-	var prog taskRunProgress
+	ref, err := parseRunRef(chi.URLParam(r, "run_id"))
+	if err != nil {
+		respondBadRequest(w, r, err)
+		return
+	}
 
-	if n, err := tryReadOffset(p); err != nil { // nolint
-		respondBadRequest(w, r, errors.Wrap(err, "parse run offset"))
+	prog, err := h.loadTaskRunProgress(r.Context(), t, ref)
+	if err != nil {
+		respondError(w, r, err)
 		return
-	} else if n >= 0 {
-		prog.Run, err = h.Scheduler.GetNthLastRun(r.Context(), t, n)
-		if n == 0 && errors.Is(err, util.ErrNotFound) {
+	}
+	render.Respond(w, r, prog)
+}
+
+// runRef references task run by ID or by offset from the latest run.
+type runRef struct {
+	offset int
+	id     uuid.UUID
+}
+
+func parseRunRef(p string) (runRef, error) {
+	n, err := tryReadOffset(p)
+	if err != nil {
+		return runRef{}, errors.Wrap(err, "parse run offset")
+	}
+	if n >= 0 {
+		return runRef{offset: n}, nil
+	}
+	runID, err := uuid.Parse(p)
+	if err != nil {
+		return runRef{}, errors.Wrapf(err, "parse uuid %s", p)
+	}
+	return runRef{offset: -1, id: runID}, nil
+}
+
+func (h *taskHandler) loadTaskRunProgress(ctx context.Context, t *scheduler.Task, ref runRef) (*taskRunProgress, error) {
+	var (
+		prog taskRunProgress
+		err  error
+	)
+	if ref.offset >= 0 {
+		prog.Run, err = h.Scheduler.GetNthLastRun(ctx, t, ref.offset)
+		if ref.offset == 0 && errors.Is(err, util.ErrNotFound) {
 			prog.Run = &scheduler.Run{
 				ClusterID: t.ClusterID,
 				Type:      t.Type,
@@ -470,42 +567,30 @@ func (h *taskHandler) taskRunProgress(w http.ResponseWriter, r *http.Request) {
 			case scheduler.ValidateBackupTask:
 				prog.Progress = backup.ValidationHostProgress{}
 			}
-			render.Respond(w, r, prog)
-			return
+			return &prog, nil
 		}
 		if err != nil {
-			respondError(w, r, errors.Wrapf(err, "run ~%d", n))
-			return
+			return nil, errors.Wrapf(err, "run ~%d", ref.offset)
 		}
 	} else {
-		runID, err := uuid.Parse(p)
+		prog.Run, err = h.Scheduler.GetRun(ctx, t, ref.id)
 		if err != nil {
-			respondBadRequest(w, r, errors.Wrapf(err, "parse uuid %s", p))
-			return
-		}
-		prog.Run, err = h.Scheduler.GetRun(r.Context(), t, runID)
-		if err != nil {
-			respondError(w, r, errors.Wrapf(err, "run %s", runID))
-			return
+			return nil, errors.Wrapf(err, "run %s", ref.id)
 		}
 	}
 
-	var (
-		pr  interface{}
-		err error
-	)
+	var pr interface{}
 	switch t.Type {
 	case scheduler.RepairTask:
-		pr, err = h.Repair.GetProgress(r.Context(), t.ClusterID, t.ID, prog.Run.ID)
+		pr, err = h.Repair.GetProgress(ctx, t.ClusterID, t.ID, prog.Run.ID)
 	case scheduler.BackupTask:
-		pr, err = h.Backup.GetProgress(r.Context(), t.ClusterID, t.ID, prog.Run.ID)
+		pr, err = h.Backup.GetProgress(ctx, t.ClusterID, t.ID, prog.Run.ID)
 	case scheduler.RestoreTask:
-		pr, err = h.Restore.GetProgress(r.Context(), t.ClusterID, t.ID, prog.Run.ID)
+		pr, err = h.Restore.GetProgress(ctx, t.ClusterID, t.ID, prog.Run.ID)
 	case scheduler.ValidateBackupTask:
-		pr, err = h.Backup.GetValidationProgress(r.Context(), t.ClusterID, t.ID, prog.Run.ID)
+		pr, err = h.Backup.GetValidationProgress(ctx, t.ClusterID, t.ID, prog.Run.ID)
 	default:
-		respondBadRequest(w, r, errors.Errorf("unsupported task type %s", t.Type))
-		return
+		return nil, util.ErrValidate(errors.Errorf("unsupported task type %s", t.Type))
 	}
 	if err != nil {
 		// Ignoring ErrNotFound because progress can have task runs without repair progress recorded.
@@ -513,14 +598,13 @@ func (h *taskHandler) taskRunProgress(w http.ResponseWriter, r *http.Request) {
 		// prog.Progress is assigned separately to force nil on the returned value instead of an empty object.
 		// This is required for correct JSON representation and detection if Progress is empty.
 		if !errors.Is(err, util.ErrNotFound) {
-			respondError(w, r, errors.Wrapf(err, "load progress for task %q", t.ID))
-			return
+			return nil, errors.Wrapf(err, "load progress for task %q", t.ID)
 		}
 	} else {
 		prog.Progress = pr
 	}
 
-	render.Respond(w, r, prog)
+	return &prog, nil
 }
 
 func tryReadOffset(s string) (int, error) {
@@ -538,3 +622,8 @@ func tryReadOffset(s string) (int, error) {
 	}
 	return -1, nil
 }
+
+// auditTaskID returns task identifier recorded in audit log.
+func auditTaskID(t *scheduler.Task) string {
+	return t.Type.String() + "/" + t.ID.String()
+}
@@ -0,0 +1,256 @@
+// Copyright (C) 2024 ScyllaDB
+
+package restapi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/render"
+	"github.com/pkg/errors"
+	"github.com/scylladb/go-log"
+	"github.com/scylladb/scylla-manager/v3/pkg/service/scheduler"
+	"github.com/scylladb/scylla-manager/v3/pkg/util/httplog"
+	"github.com/scylladb/scylla-manager/v3/pkg/util/jsonutil"
+	"github.com/scylladb/scylla-manager/v3/pkg/util/uuid"
+)
+
+const (
+	defaultStreamInterval = 2 * time.Second
+	minStreamInterval     = time.Second
+	streamKeepAlive       = 30 * time.Second
+	// streamLoadTTL specifies for how long progress loaded for one stream
+	// is reused by other streams of the same run. It's shorter than
+	// minStreamInterval so that streams polling at that interval always
+	// get progress loaded after their previous poll.
+	streamLoadTTL = minStreamInterval / 2
+	// maxStreamsPerRun limits the number of clients following a single run.
+	maxStreamsPerRun = 10
+)
+
+// Task progress stream events.
+const (
+	streamEventProgress = "progress"
+	streamEventPatch    = "patch"
+	streamEventEnd      = "end"
+)
+
+// streamTaskRunProgress streams task run progress as server-sent events.
+// The first "progress" event holds the full progress document, subsequent
+// "patch" events hold JSON Patch operations that need to be applied to it.
+// The "end" event is sent when the run is done.
+// Streams of the same run share progress loading, see progressStreams.
+func (h *taskHandler) streamTaskRunProgress(w http.ResponseWriter, r *http.Request) {
+	t := mustTaskFromCtx(r)
+	ref, err := parseRunRef(chi.URLParam(r, "run_id"))
+	if err != nil {
+		respondBadRequest(w, r, err)
+		return
+	}
+	interval := defaultStreamInterval
+	if v := r.FormValue("interval"); v != "" {
+		interval, err = time.ParseDuration(v)
+		if err != nil {
+			respondBadRequest(w, r, errors.Wrap(err, "parse interval"))
+			return
+		}
+		if interval < minStreamInterval {
+			respondBadRequest(w, r, errors.Errorf("interval must be at least %s", minStreamInterval))
+			return
+		}
+	}
+
+	ctx := r.Context()
+	prog, err := h.loadTaskRunProgress(ctx, t, ref)
+	if err != nil {
+		respondError(w, r, err)
+		return
+	}
+	// Pin the run once it exists so that the stream does not jump to
+	// the next run when the current one is done.
+	if prog.Run.ID != uuid.Nil {
+		ref = runRef{offset: -1, id: prog.Run.ID}
+	}
+	doc, err := json.Marshal(prog)
+	if err != nil {
+		respondError(w, r, errors.Wrap(err, "encode progress"))
+		return
+	}
+
+	key := progressStreamKey{taskID: t.ID, ref: ref}
+	s, ok := h.streams.subscribe(key)
+	if !ok {
+		render.Respond(w, r, &httpError{
+			StatusCode: http.StatusTooManyRequests,
+			Message:    fmt.Sprintf("too many progress streams of the run, at most %d are allowed", maxStreamsPerRun),
+			TraceID:    log.TraceID(ctx),
+		})
+		return
+	}
+	defer h.streams.unsubscribe(key)
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+	rc := http.NewResponseController(w)
+
+	send := func(event string, data []byte) error {
+		if _, err := fmt.Fprintf(w, "event: %s\ndata: %s\n\n", event, data); err != nil {
+			return err
+		}
+		return rc.Flush()
+	}
+	if err := send(streamEventProgress, doc); err != nil {
+		return
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	lastSent := time.Now()
+	for !runDone(prog) {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		next, nextDoc, err := s.load(ctx, h, t)
+		if err != nil {
+			if ctx.Err() == nil {
+				httplog.RequestLoggerSetRequestError(r, err)
+			}
+			return
+		}
+		ops, err := jsonutil.Diff(doc, nextDoc)
+		if err != nil {
+			httplog.RequestLoggerSetRequestError(r, errors.Wrap(err, "diff progress"))
+			return
+		}
+		prog, doc = next, nextDoc
+
+		if len(ops) > 0 {
+			b, err := json.Marshal(ops)
+			if err != nil {
+				httplog.RequestLoggerSetRequestError(r, errors.Wrap(err, "encode patch"))
+				return
+			}
+			if err := send(streamEventPatch, b); err != nil {
+				return
+			}
+			lastSent = time.Now()
+		} else if time.Since(lastSent) >= streamKeepAlive {
+			if _, err := fmt.Fprint(w, ": keep-alive\n\n"); err != nil {
+				return
+			}
+			if err := rc.Flush(); err != nil {
+				return
+			}
+			lastSent = time.Now()
+		}
+	}
+
+	send(streamEventEnd, []byte(`{"status":"`+prog.Run.Status.String()+`"}`)) // nolint: errcheck
+}
+
+// progressStreams shares progress loading between streams of the same run,
+// progress is loaded at most once per streamLoadTTL regardless of
+// the number of streams.
+type progressStreams struct {
+	mu      sync.Mutex
+	streams map[progressStreamKey]*progressStream
+}
+
+type progressStreamKey struct {
+	taskID uuid.UUID
+	ref    runRef
+}
+
+func newProgressStreams() *progressStreams {
+	return &progressStreams{
+		streams: make(map[progressStreamKey]*progressStream),
+	}
+}
+
+// subscribe returns shared progress loader of the run, it returns false
+// if the run already has maxStreamsPerRun subscribers.
+func (ps *progressStreams) subscribe(key progressStreamKey) (*progressStream, bool) {
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+
+	s, ok := ps.streams[key]
+	if !ok {
+		s = &progressStream{ref: key.ref}
+		ps.streams[key] = s
+	}
+	if s.subscribers >= maxStreamsPerRun {
+		return nil, false
+	}
+	s.subscribers++
+	return s, true
+}
+
+func (ps *progressStreams) unsubscribe(key progressStreamKey) {
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+
+	s := ps.streams[key]
+	s.subscribers--
+	if s.subscribers == 0 {
+		delete(ps.streams, key)
+	}
+}
+
+// progressStream loads progress of a run on behalf of all its subscribers.
+type progressStream struct {
+	// subscribers is guarded by progressStreams mu.
+	subscribers int
+
+	mu       sync.Mutex
+	ref      runRef
+	prog     *taskRunProgress
+	doc      json.RawMessage
+	loadedAt time.Time
+}
+
+// load returns progress loaded by any of the subscribers within
+// streamLoadTTL or loads it.
+func (s *progressStream) load(ctx context.Context, h *taskHandler, t *scheduler.Task) (*taskRunProgress, json.RawMessage, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.prog != nil && time.Since(s.loadedAt) < streamLoadTTL {
+		return s.prog, s.doc, nil
+	}
+
+	prog, err := h.loadTaskRunProgress(ctx, t, s.ref)
+	if err != nil {
+		return nil, nil, err
+	}
+	doc, err := json.Marshal(prog)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "encode progress")
+	}
+	// Pin the run once it exists, see streamTaskRunProgress.
+	if prog.Run.ID != uuid.Nil {
+		s.ref = runRef{offset: -1, id: prog.Run.ID}
+	}
+	s.prog, s.doc, s.loadedAt = prog, doc, time.Now()
+
+	return prog, doc, nil
+}
+
+// runDone returns true if run reached a final status.
+func runDone(prog *taskRunProgress) bool {
+	switch prog.Run.Status {
+	case scheduler.StatusNew, scheduler.StatusRunning, scheduler.StatusStopping:
+		return false
+	default:
+		return true
+	}
+}
@@ -0,0 +1,148 @@
+// Copyright (C) 2024 ScyllaDB
+
+package restapi_test
+
+import (
+	"bufio"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/scylladb/go-log"
+	"github.com/scylladb/scylla-manager/v3/pkg/restapi"
+	"github.com/scylladb/scylla-manager/v3/pkg/service/repair"
+	"github.com/scylladb/scylla-manager/v3/pkg/service/scheduler"
+	"github.com/scylladb/scylla-manager/v3/pkg/util/jsonutil"
+	"github.com/scylladb/scylla-manager/v3/pkg/util/uuid"
+)
+
+//go:generate mockgen -destination mock_schedservice_test.go -mock_names SchedService=MockSchedService -package restapi github.com/scylladb/scylla-manager/v3/pkg/restapi SchedService
+
+type streamEvent struct {
+	Name string
+	Data string
+}
+
+func readStreamEvents(t *testing.T, body string) []streamEvent {
+	t.Helper()
+
+	var (
+		out []streamEvent
+		cur streamEvent
+	)
+	s := bufio.NewScanner(strings.NewReader(body))
+	for s.Scan() {
+		line := s.Text()
+		switch {
+		case line == "":
+			if cur.Name != "" {
+				out = append(out, cur)
+			}
+			cur = streamEvent{}
+		case strings.HasPrefix(line, "event: "):
+			cur.Name = strings.TrimPrefix(line, "event: ")
+		case strings.HasPrefix(line, "data: "):
+			cur.Data = strings.TrimPrefix(line, "data: ")
+		}
+	}
+	return out
+}
+
+func TestStreamTaskRunProgress(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	c := givenCluster()
+	task := &scheduler.Task{ClusterID: c.ID, Type: scheduler.RepairTask, ID: uuid.NewTime()}
+	running := &scheduler.Run{ClusterID: c.ID, Type: task.Type, TaskID: task.ID, ID: uuid.NewTime(), Status: scheduler.StatusRunning}
+	done := *running
+	done.Status = scheduler.StatusDone
+
+	cm := restapi.NewMockClusterService(ctrl)
+	cm.EXPECT().GetCluster(gomock.Any(), c.ID.String()).Return(c, nil)
+
+	sm := restapi.NewMockSchedService(ctrl)
+	sm.EXPECT().GetTaskByID(gomock.Any(), c.ID, task.Type, task.ID).Return(task, nil)
+	gomock.InOrder(
+		sm.EXPECT().GetNthLastRun(gomock.Any(), task, 0).Return(running, nil),
+		sm.EXPECT().GetRun(gomock.Any(), task, running.ID).Return(running, nil),
+		sm.EXPECT().GetRun(gomock.Any(), task, running.ID).Return(&done, nil),
+	)
+
+	rm := restapi.NewMockRepairService(ctrl)
+	gomock.InOrder(
+		rm.EXPECT().GetProgress(gomock.Any(), c.ID, task.ID, running.ID).Return(repair.Progress{SuccessPercentage: 10}, nil),
+		rm.EXPECT().GetProgress(gomock.Any(), c.ID, task.ID, running.ID).Return(repair.Progress{SuccessPercentage: 10}, nil),
+		rm.EXPECT().GetProgress(gomock.Any(), c.ID, task.ID, running.ID).Return(repair.Progress{SuccessPercentage: 100}, nil),
+	)
+
+	h := restapi.New(restapi.Services{Cluster: cm, Scheduler: sm, Repair: rm}, log.Logger{})
+	r := httptest.NewRequest(http.MethodGet, "/api/v1/cluster/"+c.ID.String()+"/task/repair/"+task.ID.String()+"/latest/stream?interval=1s", nil)
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, r)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("Expected status %d, got %d: %s", http.StatusOK, w.Code, w.Body.String())
+	}
+	if ct := w.Header().Get("Content-Type"); ct != "text/event-stream" {
+		t.Fatalf("Content-Type = %s, expected text/event-stream", ct)
+	}
+
+	events := readStreamEvents(t, w.Body.String())
+	if len(events) != 3 {
+		t.Fatalf("Expected 3 events, got %+v", events)
+	}
+	for i, name := range []string{"progress", "patch", "end"} {
+		if events[i].Name != name {
+			t.Fatalf("Event %d = %s, expected %s", i, events[i].Name, name)
+		}
+	}
+
+	var ops []jsonutil.PatchOp
+	if err := json.Unmarshal([]byte(events[1].Data), &ops); err != nil {
+		t.Fatal(err)
+	}
+	doc, err := jsonutil.ApplyPatch(json.RawMessage(events[0].Data), ops)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var prog struct {
+		Run      scheduler.Run   `json:"run"`
+		Progress repair.Progress `json:"progress"`
+	}
+	if err := json.Unmarshal(doc, &prog); err != nil {
+		t.Fatal(err)
+	}
+	if prog.Run.Status != scheduler.StatusDone || prog.Progress.SuccessPercentage != 100 {
+		t.Fatalf("Patched progress = %+v, expected done run with 100%% success", prog)
+	}
+}
+
+func TestStreamTaskRunProgressInvalidInterval(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	c := givenCluster()
+	task := &scheduler.Task{ClusterID: c.ID, Type: scheduler.RepairTask, ID: uuid.NewTime()}
+
+	cm := restapi.NewMockClusterService(ctrl)
+	cm.EXPECT().GetCluster(gomock.Any(), c.ID.String()).Return(c, nil)
+	sm := restapi.NewMockSchedService(ctrl)
+	sm.EXPECT().GetTaskByID(gomock.Any(), c.ID, task.Type, task.ID).Return(task, nil)
+
+	h := restapi.New(restapi.Services{Cluster: cm, Scheduler: sm}, log.Logger{})
+	r := httptest.NewRequest(http.MethodGet, "/api/v1/cluster/"+c.ID.String()+"/task/repair/"+task.ID.String()+"/latest/stream?interval=1ms", nil)
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, r)
+
+	if w.Code != http.StatusBadRequest {
+		t.Fatalf("Expected status %d, got %d: %s", http.StatusBadRequest, w.Code, w.Body.String())
+	}
+}
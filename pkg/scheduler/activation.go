@@ -20,6 +20,9 @@ type Activation[K comparable] struct {
 	Retry      int8
 	Properties Properties
 	Stop       time.Time
+
+	// next is activation time before applying window and blackouts.
+	next time.Time
 }
 
 // activationHeap implements heap.Interface.
@@ -0,0 +1,82 @@
+// Copyright (C) 2024 ScyllaDB
+
+package scheduler
+
+import (
+	"sort"
+	"time"
+)
+
+// Blackout is a period of time when scheduler does not run keys.
+// Keys running when blackout begins are stopped and continued after it ends,
+// activations falling into blackout are deferred until it ends.
+type Blackout struct {
+	Begin time.Time
+	End   time.Time
+}
+
+// Blackouts is a list of blackout periods sorted by begin time.
+type Blackouts []Blackout
+
+// NewBlackouts returns sorted blackouts, periods that ended before now
+// are discarded.
+func NewBlackouts(now time.Time, b ...Blackout) Blackouts {
+	out := make(Blackouts, 0, len(b))
+	for _, v := range b {
+		if v.End.After(now) && v.End.After(v.Begin) {
+			out = append(out, v)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool {
+		return out[i].Begin.Before(out[j].Begin)
+	})
+	return out
+}
+
+// Find returns blackout period containing t.
+func (b Blackouts) Find(t time.Time) (Blackout, bool) {
+	for _, v := range b {
+		if v.Begin.After(t) {
+			break
+		}
+		if t.Before(v.End) {
+			return v, true
+		}
+	}
+	return Blackout{}, false
+}
+
+// NextBegin returns the closest blackout begin time after t, or zero time
+// if there is none.
+func (b Blackouts) NextBegin(t time.Time) time.Time {
+	for _, v := range b {
+		if v.Begin.After(t) {
+			return v.Begin
+		}
+	}
+	return time.Time{}
+}
+
+// slot returns window slot begin and end for next activation taking
+// blackouts into account. If next falls into blackout, the activation is
+// deferred to the first open window slot after blackout.
+func (b Blackouts) slot(w Window, next time.Time) (begin, end time.Time) {
+	begin, end = w.Next(next)
+
+	t := next
+	for {
+		at := begin
+		if at.Before(t) {
+			at = t
+		}
+		v, ok := b.Find(at)
+		if !ok {
+			return begin, end
+		}
+		t = v.End
+		begin, end = w.Next(t)
+		if begin.Before(t) {
+			begin = t
+		}
+	}
+}
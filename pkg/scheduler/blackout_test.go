@@ -0,0 +1,122 @@
+// Copyright (C) 2024 ScyllaDB
+
+package scheduler
+
+import (
+	"testing"
+	"time"
+)
+
+func TestBlackoutsSlot(t *testing.T) {
+	const h = time.Hour
+
+	day := time.Date(2024, 5, 6, 0, 0, 0, 0, time.UTC) // Monday
+	at := func(d time.Duration) time.Time {
+		return day.Add(d)
+	}
+	wdt := func(d time.Duration) WeekdayTime {
+		return WeekdayTime{Weekday: time.Monday, Time: d}
+	}
+	w, err := NewWindow(wdt(2*h), wdt(4*h), wdt(6*h), wdt(8*h))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	table := []struct {
+		Name      string
+		Blackouts Blackouts
+		Window    Window
+		Next      time.Time
+		Begin     time.Time
+		End       time.Time
+	}{
+		{
+			Name:  "no blackouts",
+			Next:  at(1 * h),
+			Begin: at(1 * h),
+		},
+		{
+			Name:      "before blackout",
+			Blackouts: Blackouts{{Begin: at(2 * h), End: at(3 * h)}},
+			Next:      at(1 * h),
+			Begin:     at(1 * h),
+		},
+		{
+			Name:      "in blackout",
+			Blackouts: Blackouts{{Begin: at(1 * h), End: at(3 * h)}},
+			Next:      at(2 * h),
+			Begin:     at(3 * h),
+		},
+		{
+			Name: "in consecutive blackouts",
+			Blackouts: Blackouts{
+				{Begin: at(1 * h), End: at(3 * h)},
+				{Begin: at(3 * h), End: at(5 * h)},
+			},
+			Next:  at(2 * h),
+			Begin: at(5 * h),
+		},
+		{
+			Name:      "window slot inside blackout",
+			Blackouts: Blackouts{{Begin: at(1 * h), End: at(3 * h)}},
+			Window:    w,
+			Next:      at(0),
+			Begin:     at(3 * h),
+			End:       at(4 * h),
+		},
+		{
+			Name:      "window slot covered by blackout",
+			Blackouts: Blackouts{{Begin: at(1 * h), End: at(5 * h)}},
+			Window:    w,
+			Next:      at(0),
+			Begin:     at(6 * h),
+			End:       at(8 * h),
+		},
+		{
+			Name:      "window slot before blackout",
+			Blackouts: Blackouts{{Begin: at(5 * h), End: at(7 * h)}},
+			Window:    w,
+			Next:      at(0),
+			Begin:     at(2 * h),
+			End:       at(4 * h),
+		},
+	}
+
+	for i := range table {
+		test := table[i]
+		t.Run(test.Name, func(t *testing.T) {
+			b := NewBlackouts(test.Next, test.Blackouts...)
+			begin, end := b.slot(test.Window, test.Next)
+			if !begin.Equal(test.Begin) {
+				t.Errorf("slot() begin = %s, expected %s", begin, test.Begin)
+			}
+			if !end.Equal(test.End) {
+				t.Errorf("slot() end = %s, expected %s", end, test.End)
+			}
+		})
+	}
+}
+
+func TestNewBlackouts(t *testing.T) {
+	now := unixTime(100)
+	b := NewBlackouts(now,
+		Blackout{Begin: unixTime(300), End: unixTime(400)},
+		Blackout{Begin: unixTime(0), End: unixTime(50)},
+		Blackout{Begin: unixTime(50), End: unixTime(200)},
+	)
+	if len(b) != 2 {
+		t.Fatalf("NewBlackouts() = %v, expected 2 blackouts", b)
+	}
+	if !b[0].Begin.Equal(unixTime(50)) {
+		t.Fatalf("NewBlackouts() = %v, expected sorted", b)
+	}
+	if _, ok := b.Find(now); !ok {
+		t.Fatal("Find() expected blackout")
+	}
+	if _, ok := b.Find(unixTime(250)); ok {
+		t.Fatal("Find() unexpected blackout")
+	}
+	if v := b.NextBegin(now); !v.Equal(unixTime(300)) {
+		t.Fatalf("NextBegin() = %s, expected %s", v, unixTime(300))
+	}
+}
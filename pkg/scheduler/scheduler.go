@@ -3,6 +3,7 @@
 package scheduler
 
 import (
+	"container/heap"
 	"context"
 	"errors"
 	"sync"
@@ -30,6 +31,7 @@ type RunContext[K comparable] struct {
 var (
 	ErrStoppedTask      = errors.New("task was stopped")
 	ErrOutOfWindowTask  = errors.New("task got out of --window")
+	ErrBlackoutTask     = errors.New("task got into cluster blackout period")
 	ErrRescheduledTask  = errors.New("task is being rescheduled")
 	ErrStoppedScheduler = errors.New("scheduler and all tasks were stopped")
 )
@@ -60,6 +62,8 @@ type Details struct {
 	Backoff    retry.Backoff
 	Window     Window
 	Location   *time.Location
+	// NoBlackout makes key ignore scheduler blackouts.
+	NoBlackout bool
 }
 
 // Scheduler manages keys and triggers.
@@ -79,6 +83,9 @@ type Scheduler[K comparable] struct {
 	closed  bool
 	mu      sync.Mutex
 
+	blackouts     Blackouts
+	blackoutTimer *time.Timer
+
 	wakeupCh chan struct{}
 	wg       sync.WaitGroup
 }
@@ -169,7 +176,8 @@ func (s *Scheduler[K]) reschedule(ctx *RunContext[K]) {
 }
 
 func shouldContinue(ctx context.Context) bool {
-	return errors.Is(context.Cause(ctx), ErrOutOfWindowTask)
+	cause := context.Cause(ctx)
+	return errors.Is(cause, ErrOutOfWindowTask) || errors.Is(cause, ErrBlackoutTask)
 }
 
 func shouldRetry(ctx context.Context, err error) bool {
@@ -183,15 +191,72 @@ func (s *Scheduler[K]) scheduleLocked(ctx context.Context, key K, next time.Time
 		return
 	}
 
-	begin, end := w.Next(next)
+	begin, end := s.slotLocked(key, next, w)
 
 	s.listener.OnSchedule(ctx, key, begin, end, retno)
-	a := Activation[K]{Key: key, Time: begin, Retry: retno, Properties: p, Stop: end}
+	a := Activation[K]{Key: key, Time: begin, Retry: retno, Properties: p, Stop: end, next: next}
 	if s.queue.Push(a) {
 		s.wakeup()
 	}
 }
 
+func (s *Scheduler[K]) slotLocked(key K, next time.Time, w Window) (begin, end time.Time) {
+	if s.details[key].NoBlackout {
+		return w.Next(next)
+	}
+	return s.blackouts.slot(w, next)
+}
+
+// SetBlackouts replaces blackout periods. Pending activations falling into
+// blackout are deferred, keys running in blackout are stopped and continued
+// when it ends.
+func (s *Scheduler[K]) SetBlackouts(ctx context.Context, b Blackouts) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.blackouts = b
+	for i := range s.queue.h {
+		a := &s.queue.h[i]
+		begin, end := s.slotLocked(a.Key, a.next, s.details[a.Key].Window)
+		if !begin.Equal(a.Time) {
+			s.listener.OnSchedule(ctx, a.Key, begin, end, a.Retry)
+		}
+		a.Time, a.Stop = begin, end
+	}
+	heap.Init(&s.queue.h)
+	s.wakeup()
+
+	s.enforceBlackoutsLocked()
+}
+
+// enforceBlackoutsLocked stops keys running in blackout and sets timer
+// to do it again when the next blackout begins.
+func (s *Scheduler[K]) enforceBlackoutsLocked() {
+	if s.blackoutTimer != nil {
+		s.blackoutTimer.Stop()
+		s.blackoutTimer = nil
+	}
+	if s.closed {
+		return
+	}
+
+	now := s.now()
+	if _, ok := s.blackouts.Find(now); ok {
+		for k, cancel := range s.running {
+			if !s.details[k].NoBlackout {
+				cancel(ErrBlackoutTask)
+			}
+		}
+	}
+	if next := s.blackouts.NextBegin(now); !next.IsZero() {
+		s.blackoutTimer = time.AfterFunc(next.Sub(now), func() {
+			s.mu.Lock()
+			s.enforceBlackoutsLocked()
+			s.mu.Unlock()
+		})
+	}
+}
+
 // Unschedule cancels schedule of a key. It does not stop an active run.
 func (s *Scheduler[K]) Unschedule(ctx context.Context, key K) {
 	s.listener.OnUnschedule(ctx, key)
@@ -255,6 +320,9 @@ func (s *Scheduler[K]) Close() (running, pending []K) {
 
 	s.closed = true
 	s.wakeup()
+	if s.blackoutTimer != nil {
+		s.blackoutTimer.Stop()
+	}
 	for k, cancel := range s.running {
 		running = append(running, k)
 		cancel(ErrStoppedScheduler)
@@ -411,7 +479,7 @@ func (s *Scheduler[K]) onRunEnd(ctx *RunContext[K]) {
 		s.listener.OnRunSuccess(ctx)
 	case errors.Is(context.Cause(ctx), ErrStoppedTask):
 		s.listener.OnRunStop(ctx, err)
-	case errors.Is(context.Cause(ctx), ErrOutOfWindowTask):
+	case errors.Is(context.Cause(ctx), ErrOutOfWindowTask), errors.Is(context.Cause(ctx), ErrBlackoutTask):
 		s.listener.OnRunWindowEnd(ctx, err)
 	default:
 		s.listener.OnRunError(ctx, err)
@@ -420,7 +488,7 @@ func (s *Scheduler[K]) onRunEnd(ctx *RunContext[K]) {
 
 // IsTaskInterrupted returns true if task execution was interrupted by scheduler.
 func IsTaskInterrupted(ctx context.Context) bool {
-	schedulerErrs := []error{ErrStoppedTask, ErrOutOfWindowTask, ErrRescheduledTask, ErrStoppedScheduler}
+	schedulerErrs := []error{ErrStoppedTask, ErrOutOfWindowTask, ErrBlackoutTask, ErrRescheduledTask, ErrStoppedScheduler}
 	err := context.Cause(ctx)
 	for _, schedulerErr := range schedulerErrs {
 		if errors.Is(err, schedulerErr) {
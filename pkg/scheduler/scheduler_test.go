@@ -728,3 +728,49 @@ func TestWindowWithBackoff(t *testing.T) {
 		}
 	}
 }
+
+func TestBlackout(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	f := newFakeRunner()
+	f.F = func(ctx testRunContext) error {
+		if f.Count() > 1 {
+			return nil
+		}
+		<-ctx.Done()
+		return ctx.Err()
+	}
+	now := relativeTime()
+	s := NewScheduler[testKey](now, f.Run, ll)
+	k := randomKey()
+	s.Schedule(ctx, k, details(newFakeTrigger(StartOffset)))
+
+	ch := startAndWait(ctx, s)
+	time.Sleep(5 * StartOffset)
+
+	begin := now()
+	s.SetBlackouts(ctx, NewBlackouts(begin, Blackout{Begin: begin, End: begin.Add(200 * time.Millisecond)}))
+
+	select {
+	case <-ch:
+		t.Fatal("expected a run, scheduler exit")
+	case <-time.After(Timeout):
+		t.Fatal("expected a run, timeout")
+	case runCtx := <-f.C:
+		if !errors.Is(context.Cause(runCtx), ErrBlackoutTask) {
+			t.Fatalf("Cause() = %v, expected %v", context.Cause(runCtx), ErrBlackoutTask)
+		}
+	}
+
+	select {
+	case <-ch:
+		t.Fatal("expected a run, scheduler exit")
+	case <-time.After(Timeout):
+		t.Fatal("expected a run, timeout")
+	case <-f.C:
+		if d := now().Sub(begin); d < 200*time.Millisecond {
+			t.Fatalf("Run continued after %s, expected after blackout", d)
+		}
+	}
+}
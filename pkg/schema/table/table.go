@@ -6,6 +6,45 @@ import "github.com/scylladb/gocqlx/v2/table"
 
 // Table models.
 var (
+	ApiToken = table.New(table.Metadata{
+		Name: "api_token",
+		Columns: []string{
+			"cluster_id",
+			"created_at",
+			"id",
+			"name",
+			"role",
+			"secret_hash",
+		},
+		PartKey: []string{
+			"id",
+		},
+		SortKey: []string{},
+	})
+
+	AuditLog = table.New(table.Metadata{
+		Name: "audit_log",
+		Columns: []string{
+			"client_addr",
+			"cluster_id",
+			"diff",
+			"id",
+			"identity",
+			"method",
+			"path",
+			"resource",
+			"resource_id",
+			"status_code",
+			"time",
+		},
+		PartKey: []string{
+			"cluster_id",
+		},
+		SortKey: []string{
+			"id",
+		},
+	})
+
 	BackupRun = table.New(table.Metadata{
 		Name: "backup_run",
 		Columns: []string{
@@ -60,6 +99,20 @@ var (
 		},
 	})
 
+	BackupSlaPolicy = table.New(table.Metadata{
+		Name: "backup_sla_policy",
+		Columns: []string{
+			"cluster_id",
+			"keyspace",
+			"location",
+			"max_age",
+		},
+		PartKey: []string{
+			"cluster_id",
+		},
+		SortKey: []string{},
+	})
+
 	Cluster = table.New(table.Metadata{
 		Name: "cluster",
 		Columns: []string{
@@ -109,6 +162,37 @@ var (
 		SortKey: []string{},
 	})
 
+	LeaderLease = table.New(table.Metadata{
+		Name: "leader_lease",
+		Columns: []string{
+			"holder",
+			"name",
+		},
+		PartKey: []string{
+			"name",
+		},
+		SortKey: []string{},
+	})
+
+	NotificationDeadLetter = table.New(table.Metadata{
+		Name: "notification_dead_letter",
+		Columns: []string{
+			"attempts",
+			"cluster_id",
+			"error",
+			"id",
+			"kind",
+			"payload",
+			"sink",
+		},
+		PartKey: []string{
+			"cluster_id",
+		},
+		SortKey: []string{
+			"id",
+		},
+	})
+
 	RepairRun = table.New(table.Metadata{
 		Name: "repair_run",
 		Columns: []string{
@@ -117,6 +201,7 @@ var (
 			"end_time",
 			"host",
 			"id",
+			"incremental",
 			"intensity",
 			"parallel",
 			"prev_id",
@@ -241,6 +326,23 @@ var (
 		},
 	})
 
+	SchedulerBlackout = table.New(table.Metadata{
+		Name: "scheduler_blackout",
+		Columns: []string{
+			"cluster_id",
+			"end_time",
+			"id",
+			"reason",
+			"start_time",
+		},
+		PartKey: []string{
+			"cluster_id",
+		},
+		SortKey: []string{
+			"id",
+		},
+	})
+
 	SchedulerTask = table.New(table.Metadata{
 		Name: "scheduler_task",
 		Columns: []string{
@@ -253,7 +355,11 @@ var (
 			"last_error",
 			"last_success",
 			"name",
+			"notify",
+			"notify_stale_after",
 			"properties",
+			"run_after",
+			"run_after_condition",
 			"sched",
 			"status",
 			"success_count",
@@ -312,6 +418,7 @@ var (
 			"broken_snapshots",
 			"cluster_id",
 			"completed_at",
+			"corrupted_files",
 			"dc",
 			"deleted_files",
 			"host",
@@ -15,7 +15,11 @@ var SchedulerTaskUpdate = table.New(table.Metadata{
 		"id",
 		"name",
 		"labels",
+		"notify",
+		"notify_stale_after",
 		"properties",
+		"run_after",
+		"run_after_condition",
 		"sched",
 		"type",
 	},
@@ -6,6 +6,7 @@ import (
 	"crypto/tls"
 	"net"
 	"net/http"
+	"strings"
 	"sync"
 	"time"
 
@@ -17,6 +18,7 @@ import (
 	"github.com/scylladb/go-log"
 
 	"github.com/scylladb/scylla-manager/v3/pkg/auth"
+	"github.com/scylladb/scylla-manager/v3/pkg/rclone/rcserver"
 	"github.com/scylladb/scylla-manager/v3/pkg/util/httpx"
 	agentClient "github.com/scylladb/scylla-manager/v3/swagger/gen/agent/client"
 	agentOperations "github.com/scylladb/scylla-manager/v3/swagger/gen/agent/client/operations"
@@ -97,6 +99,7 @@ func NewClient(config Config, logger log.Logger) (*Client, error) {
 	transport = requestLogger(transport, logger)
 	transport = hostPool(transport, pool, config.Port)
 	transport = auth.AddToken(transport, config.AuthToken)
+	transport = addBackupHeaders(transport)
 	transport = fixContentType(transport)
 
 	client := &http.Client{Transport: transport}
@@ -157,3 +160,27 @@ func fixContentType(next http.RoundTripper) http.RoundTripper {
 		return next.RoundTrip(req)
 	})
 }
+
+// addBackupHeaders sets backup encryption key and compression headers in agent
+// rclone requests if they were set in context with WithBackupEncryptionKey
+// and WithBackupCompression.
+func addBackupHeaders(next http.RoundTripper) http.RoundTripper {
+	return httpx.RoundTripperFunc(func(req *http.Request) (resp *http.Response, err error) {
+		if !strings.HasPrefix(req.URL.Path, agentClient.DefaultBasePath+"/rclone/") {
+			return next.RoundTrip(req)
+		}
+		k, encrypt := backupEncryptionKey(req.Context())
+		c, compress := backupCompression(req.Context())
+		if !encrypt && !compress {
+			return next.RoundTrip(req)
+		}
+		r := httpx.CloneRequest(req)
+		if encrypt {
+			r.Header.Set(rcserver.EncryptionKeyHeader, k.Encode())
+		}
+		if compress {
+			r.Header.Set(rcserver.CompressionHeader, c)
+		}
+		return next.RoundTrip(r)
+	})
+}
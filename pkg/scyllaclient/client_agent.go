@@ -70,6 +70,18 @@ func (c *Client) PinCPU(ctx context.Context, host string) error {
 	return err
 }
 
+// ThrottleStatus returns adaptive throttling status of `host` node.
+func (c *Client) ThrottleStatus(ctx context.Context, host string) (*models.ThrottleStatus, error) {
+	p := operations.ThrottleStatusParams{
+		Context: forceHost(ctx, host),
+	}
+	resp, err := c.agentOps.ThrottleStatus(&p)
+	if err != nil {
+		return nil, errors.Wrap(err, "throttle status")
+	}
+	return resp.Payload, nil
+}
+
 // CQLAddr returns CQL address from NodeInfo.
 // Scylla can have separate rpc_address (CQL), listen_address and respectfully
 // broadcast_rpc_address and broadcast_address if some 3rd party routing
@@ -210,6 +222,20 @@ func (ni *NodeInfo) SupportsRepairSmallTableOptimization() (bool, error) {
 	return supports, nil
 }
 
+// SupportsIncrementalRepair returns true if /storage_service/tablets/repair supports incremental_mode param
+// and marks repaired sstables, so that they are skipped by the following incremental repairs.
+func (ni *NodeInfo) SupportsIncrementalRepair() (bool, error) {
+	// Detect master builds
+	if scyllaversion.MasterVersion(ni.ScyllaVersion) {
+		return true, nil
+	}
+	supports, err := scyllaversion.CheckConstraint(ni.ScyllaVersion, ">= 2025.4")
+	if err != nil {
+		return false, errors.Errorf("Unsupported Scylla version: %s", ni.ScyllaVersion)
+	}
+	return supports, nil
+}
+
 // SupportsSafeDescribeSchemaWithInternals returns true if the output of DESCRIBE SCHEMA WITH INTERNALS
 // is safe to use with backup/restore procedure.
 func (ni *NodeInfo) SupportsSafeDescribeSchemaWithInternals() (bool, error) {
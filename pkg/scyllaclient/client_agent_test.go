@@ -309,3 +309,46 @@ func TestNodeInfoSupportsRepairSmallTableOptimization(t *testing.T) {
 		}
 	}
 }
+
+func TestNodeInfoSupportsIncrementalRepair(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		scyllaVer string
+		expected  bool
+	}{
+		{
+			scyllaVer: "6.2.0",
+			expected:  false,
+		},
+		{
+			scyllaVer: "2024.2.5",
+			expected:  false,
+		},
+		{
+			scyllaVer: "2025.3.1",
+			expected:  false,
+		},
+		{
+			scyllaVer: "2025.4.0",
+			expected:  true,
+		},
+		{
+			scyllaVer: "2026.1.0",
+			expected:  true,
+		},
+	}
+
+	for _, tc := range testCases {
+		ni := scyllaclient.NodeInfo{
+			ScyllaVersion: tc.scyllaVer,
+		}
+		result, err := ni.SupportsIncrementalRepair()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if result != tc.expected {
+			t.Fatalf("expected {%v}, but got {%v}, version = {%s}", tc.expected, result, tc.scyllaVer)
+		}
+	}
+}
@@ -12,6 +12,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/go-openapi/strfmt"
 	"github.com/pkg/errors"
 	"github.com/scylladb/scylla-manager/v3/pkg/rclone/rcserver"
 	"github.com/scylladb/scylla-manager/v3/pkg/util/pointer"
@@ -33,6 +34,19 @@ func (c *Client) RcloneSetBandwidthLimit(ctx context.Context, host string, limit
 	return err
 }
 
+// RcloneSetBandwidthProfile sets bandwidth profile of all the current and
+// future transfers performed under current client session.
+// Profile is expressed in the rclone --bwlimit timetable format
+// e.g. "08:00,50M 18:00,off", host switches the limit at time slot boundaries.
+func (c *Client) RcloneSetBandwidthProfile(ctx context.Context, host, profile string) error {
+	p := operations.CoreBwlimitParams{
+		Context:       forceHost(ctx, host),
+		BandwidthRate: &models.Bandwidth{Rate: profile},
+	}
+	_, err := c.agentOps.CoreBwlimit(&p) // nolint: errcheck
+	return err
+}
+
 // RcloneGetBandwidthLimit gets bandwidth limit of all the current and future
 // transfers performed under current client session.
 func (c *Client) RcloneGetBandwidthLimit(ctx context.Context, host string) (string, error) {
@@ -246,6 +260,9 @@ const (
 	TransfersFromConfig = -1
 	// NoRateLimit describes unlimited rate limit.
 	NoRateLimit = 0
+	// KeepRateLimit describes rate limit value which results in keeping
+	// the bandwidth limit or profile already set on host.
+	KeepRateLimit = -1
 )
 
 // RcloneMoveDir moves contents of the directory pointed by srcRemotePath to
@@ -440,6 +457,64 @@ func (c *Client) RcloneDeletePaths(ctx context.Context, host, remoteDir string,
 	return res.Payload.Deletes, nil
 }
 
+// RcloneSetRetention sets object lock retention of paths in remoteDir/path.
+// Retention is never shortened, and it returns the amount of files with
+// updated retention. Mode is either governance or compliance.
+// RemoteDir:
+//   - needs to be registered with the server first
+//   - has "name:bucket/path" format
+//   - must point to a directory on S3
+func (c *Client) RcloneSetRetention(ctx context.Context, host, remoteDir string, paths []string, mode string, retainUntil time.Time) (int64, error) {
+	fs, remote, err := rcloneSplitRemotePath(remoteDir)
+	if err != nil {
+		return 0, err
+	}
+	p := operations.OperationsRetentionParams{
+		Context: customTimeout(forceHost(ctx, host), 15*time.Minute),
+		Options: &models.RetentionOptions{
+			Fs:          fs,
+			Remote:      remote,
+			Paths:       paths,
+			Mode:        mode,
+			RetainUntil: strfmt.DateTime(retainUntil),
+		},
+	}
+	res, err := c.agentOps.OperationsRetention(&p)
+	if err != nil {
+		return 0, err
+	}
+	return res.Payload.Retained, nil
+}
+
+// RcloneChecksums returns hex encoded CRC32 checksums of content of paths in
+// remoteDir/path. Files are read as a whole, remote files are decrypted and
+// decompressed as set in context. If digest is true, checksums of SSTable Data
+// components are read from their Digest.crc32 components.
+// RemoteDir:
+//   - needs to be registered with the server first
+//   - has "name:bucket/path" format
+//   - must point to a directory
+func (c *Client) RcloneChecksums(ctx context.Context, host, remoteDir string, paths []string, digest bool) (map[string]string, error) {
+	fs, remote, err := rcloneSplitRemotePath(remoteDir)
+	if err != nil {
+		return nil, err
+	}
+	p := operations.OperationsChecksumParams{
+		Context: noTimeout(forceHost(ctx, host)),
+		Options: &models.ChecksumOptions{
+			Fs:     fs,
+			Remote: remote,
+			Paths:  paths,
+			Digest: digest,
+		},
+	}
+	res, err := c.agentOps.OperationsChecksum(&p)
+	if err != nil {
+		return nil, err
+	}
+	return res.Payload.Checksums, nil
+}
+
 // RcloneDiskUsage get disk space usage.
 // Remote path format is "name:bucket/path".
 func (c *Client) RcloneDiskUsage(ctx context.Context, host, remotePath string) (*models.FileSystemDetails, error) {
@@ -759,5 +834,8 @@ func rcloneSplitRemotePath(remotePath string) (fs, path string, err error) {
 }
 
 func marshallRateLimit(limit int) string {
+	if limit == KeepRateLimit {
+		return ""
+	}
 	return fmt.Sprintf("%dM", limit)
 }
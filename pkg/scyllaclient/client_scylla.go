@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"net"
 	"net/http"
+	"path"
 	"regexp"
 	"runtime"
 	"sort"
@@ -609,6 +610,42 @@ func (c *Client) Repair(ctx context.Context, keyspace, table, master string, rep
 	return resp.Payload, nil
 }
 
+// IncrementalRepairMode specifies which data is repaired by tablet repair.
+type IncrementalRepairMode string
+
+// IncrementalRepairMode enumeration.
+const (
+	// IncrementalRepairModeIncremental repairs only data which was not marked
+	// as repaired, and marks it as repaired.
+	IncrementalRepairModeIncremental IncrementalRepairMode = "incremental"
+	// IncrementalRepairModeFull repairs all data, and marks it as repaired.
+	IncrementalRepairModeFull IncrementalRepairMode = "full"
+)
+
+// TabletRepair repairs all tablets of tablet table and waits for the repair
+// to finish. Only replicas in dcs are repaired, and if hostIDs are not empty,
+// only replicas on these hosts.
+func (c *Client) TabletRepair(ctx context.Context, keyspace, table, master string, dcs, hostIDs []string, mode IncrementalRepairMode) error {
+	p := operations.StorageServiceTabletsRepairPostParams{
+		// Repair of all tablets can take long, it's not retried as it would
+		// start another repair of the table
+		Context:         noRetry(noTimeout(forceHost(ctx, master))),
+		Ks:              keyspace,
+		Table:           table,
+		Tokens:          "all",
+		AwaitCompletion: pointer.StringPtr("true"),
+		IncrementalMode: pointer.StringPtr(string(mode)),
+	}
+	if len(dcs) > 0 {
+		p.DcsFilter = pointer.StringPtr(strings.Join(dcs, ","))
+	}
+	if len(hostIDs) > 0 {
+		p.HostsFilter = pointer.StringPtr(strings.Join(hostIDs, ","))
+	}
+	_, err := c.scyllaOps.StorageServiceTabletsRepairPost(&p)
+	return err
+}
+
 func dumpRanges(ranges []TokenRange) string {
 	var buf bytes.Buffer
 	for i, ttr := range ranges {
@@ -1052,6 +1089,32 @@ func (c *Client) LoadSSTables(ctx context.Context, host, keyspace, table string,
 	return false, err
 }
 
+const replayCommitlogTimeout = time.Hour
+
+// ReplayCommitlog replays commitlog segments that are already downloaded
+// to the directory on host. The directory is relative to host's data directory.
+// Mutations from the segments are sent to their current replicas,
+// so segments can be replayed on any node of the cluster.
+func (c *Client) ReplayCommitlog(ctx context.Context, host, dir string) error {
+	ctx = forceHost(ctx, host)
+
+	locations, err := c.scyllaOps.StorageServiceDataFileLocationsGet(&operations.StorageServiceDataFileLocationsGetParams{
+		Context: ctx,
+	})
+	if err != nil {
+		return errors.Wrap(err, "get data file locations")
+	}
+	if len(locations.Payload) == 0 {
+		return errors.New("no data file locations")
+	}
+
+	_, err = c.scyllaOps.CommitlogRecoverByPathPost(&operations.CommitlogRecoverByPathPostParams{
+		Context: customTimeout(ctx, replayCommitlogTimeout),
+		Path:    path.Join(locations.Payload[0], dir),
+	})
+	return err
+}
+
 // IsAutoCompactionEnabled checks if auto compaction of given table is enabled on the host.
 func (c *Client) IsAutoCompactionEnabled(ctx context.Context, host, keyspace, table string) (bool, error) {
 	resp, err := c.scyllaOps.ColumnFamilyAutocompactionByNameGet(&operations.ColumnFamilyAutocompactionByNameGetParams{
@@ -5,6 +5,7 @@ package scyllaclient_test
 import (
 	"context"
 	"net/http"
+	"net/url"
 	"strings"
 	"testing"
 
@@ -291,6 +292,67 @@ func TestClientKillAllRepairs(t *testing.T) {
 	}
 }
 
+func TestClientReplayCommitlog(t *testing.T) {
+	t.Parallel()
+
+	var recovered string
+	m := func(r *http.Request) string {
+		switch {
+		case r.URL.Path == "/storage_service/data_file/locations":
+			return "testdata/scylla_api/storage_service_data_file_locations.json"
+		case r.Method == http.MethodPost && strings.HasPrefix(r.URL.Path, "/commitlog/recover/"):
+			recovered = strings.TrimPrefix(r.URL.Path, "/commitlog/recover/")
+			return "testdata/scylla_api/commitlog_recover.json"
+		default:
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+			return ""
+		}
+	}
+	client, closeServer := scyllaclienttest.NewFakeScyllaServerMatching(t, m)
+	defer closeServer()
+
+	if err := client.ReplayCommitlog(context.Background(), scyllaclienttest.TestHost, "commitlog_replay/node1"); err != nil {
+		t.Fatal(err)
+	}
+	if golden := "/var/lib/scylla/data/commitlog_replay/node1"; recovered != golden {
+		t.Fatalf("ReplayCommitlog() recovered %q, expected %q", recovered, golden)
+	}
+}
+
+func TestClientTabletRepair(t *testing.T) {
+	t.Parallel()
+
+	var query url.Values
+	m := func(r *http.Request) string {
+		if r.Method != http.MethodPost || r.URL.Path != "/storage_service/tablets/repair" {
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+			return ""
+		}
+		query = r.URL.Query()
+		return "testdata/scylla_api/storage_service_tablets_repair.json"
+	}
+	client, closeServer := scyllaclienttest.NewFakeScyllaServerMatching(t, m)
+	defer closeServer()
+
+	err := client.TabletRepair(context.Background(), "ks", "tab", scyllaclienttest.TestHost,
+		[]string{"dc1", "dc2"}, []string{"id1", "id2"}, scyllaclient.IncrementalRepairModeIncremental)
+	if err != nil {
+		t.Fatal(err)
+	}
+	golden := url.Values{
+		"ks":               {"ks"},
+		"table":            {"tab"},
+		"tokens":           {"all"},
+		"await_completion": {"true"},
+		"incremental_mode": {"incremental"},
+		"dcs_filter":       {"dc1,dc2"},
+		"hosts_filter":     {"id1,id2"},
+	}
+	if diff := cmp.Diff(golden, query); diff != "" {
+		t.Fatalf("TabletRepair() query diff\n%s", diff)
+	}
+}
+
 func TestClientSnapshotDetails(t *testing.T) {
 	t.Parallel()
 
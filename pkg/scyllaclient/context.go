@@ -5,6 +5,8 @@ package scyllaclient
 import (
 	"context"
 	"time"
+
+	"github.com/scylladb/scylla-manager/v3/pkg/rclone/crypt"
 )
 
 // ctxt is a context key type.
@@ -18,6 +20,8 @@ const (
 	ctxNoTimeout
 	ctxCustomTimeout
 	ctxShouldRetryHandler
+	ctxBackupEncryptionKey
+	ctxBackupCompression
 )
 
 // Interactive context means that it should be processed fast without too much
@@ -87,3 +91,26 @@ func shouldRetryHandler(ctx context.Context) shouldRetryHandlerFunc {
 	f, _ := ctx.Value(ctxShouldRetryHandler).(shouldRetryHandlerFunc)
 	return f
 }
+
+// WithBackupEncryptionKey makes agent encrypt objects uploaded to backup
+// locations with the key, and decrypt encrypted objects read from there.
+func WithBackupEncryptionKey(ctx context.Context, key crypt.Key) context.Context {
+	return context.WithValue(ctx, ctxBackupEncryptionKey, key)
+}
+
+func backupEncryptionKey(ctx context.Context) (crypt.Key, bool) {
+	k, ok := ctx.Value(ctxBackupEncryptionKey).(crypt.Key)
+	return k, ok
+}
+
+// WithBackupCompression makes agent compress compressible SSTable components
+// uploaded to backup locations with the codec, and decompress compressed
+// objects read from there.
+func WithBackupCompression(ctx context.Context, codec string) context.Context {
+	return context.WithValue(ctx, ctxBackupCompression, codec)
+}
+
+func backupCompression(ctx context.Context) (string, bool) {
+	c, ok := ctx.Value(ctxBackupCompression).(string)
+	return c, ok && c != ""
+}
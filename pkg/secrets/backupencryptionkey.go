@@ -0,0 +1,53 @@
+// Copyright (C) 2024 ScyllaDB
+
+package secrets
+
+import (
+	"encoding/json"
+
+	"github.com/pkg/errors"
+	"github.com/scylladb/scylla-manager/v3/pkg/rclone/crypt"
+	"github.com/scylladb/scylla-manager/v3/pkg/store"
+	"github.com/scylladb/scylla-manager/v3/pkg/util"
+	"github.com/scylladb/scylla-manager/v3/pkg/util/uuid"
+)
+
+// BackupEncryptionKey specifies key used for encryption of cluster backups.
+type BackupEncryptionKey struct {
+	ClusterID uuid.UUID `json:"-"`
+	Data      []byte    `json:"key"`
+}
+
+var _ store.Entry = &BackupEncryptionKey{}
+
+func (v *BackupEncryptionKey) Key() (clusterID uuid.UUID, key string) {
+	return v.ClusterID, "backup_encryption_key"
+}
+
+func (v *BackupEncryptionKey) MarshalBinary() (data []byte, err error) {
+	if len(v.Data) == 0 {
+		return nil, nil
+	}
+	return json.Marshal(v)
+}
+
+func (v *BackupEncryptionKey) UnmarshalBinary(data []byte) error {
+	return json.Unmarshal(data, v)
+}
+
+// GetBackupEncryptionKey returns backup encryption key of a cluster,
+// ok is false if the cluster has no key.
+func GetBackupEncryptionKey(s store.Store, clusterID uuid.UUID) (key crypt.Key, ok bool, err error) {
+	e := BackupEncryptionKey{ClusterID: clusterID}
+	if err := s.Get(&e); err != nil {
+		if errors.Is(err, util.ErrNotFound) {
+			return key, false, nil
+		}
+		return key, false, errors.Wrap(err, "get backup encryption key")
+	}
+	if len(e.Data) != crypt.KeySize {
+		return key, false, errors.Errorf("invalid backup encryption key size %d", len(e.Data))
+	}
+	copy(key[:], e.Data)
+	return key, true, nil
+}
@@ -0,0 +1,108 @@
+// Copyright (C) 2024 ScyllaDB
+
+package access
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/scylladb/scylla-manager/v3/pkg/util"
+	"github.com/scylladb/scylla-manager/v3/pkg/util/uuid"
+	"go.uber.org/multierr"
+)
+
+// Role specifies operations allowed for API token.
+type Role string
+
+// Role enumeration.
+const (
+	// RoleReadOnly allows for reading clusters, tasks, backups and their progress.
+	RoleReadOnly Role = "read_only"
+	// RoleOperator allows for managing tasks and backups.
+	RoleOperator Role = "operator"
+	// RoleAdmin allows for managing clusters and API tokens.
+	RoleAdmin Role = "admin"
+)
+
+var roleRank = map[Role]int{
+	RoleReadOnly: 1,
+	RoleOperator: 2,
+	RoleAdmin:    3,
+}
+
+func (r Role) String() string {
+	return string(r)
+}
+
+func (r Role) MarshalText() (text []byte, err error) {
+	return []byte(r.String()), nil
+}
+
+func (r *Role) UnmarshalText(text []byte) error {
+	switch Role(text) {
+	case RoleReadOnly:
+		*r = RoleReadOnly
+	case RoleOperator:
+		*r = RoleOperator
+	case RoleAdmin:
+		*r = RoleAdmin
+	default:
+		return fmt.Errorf("unrecognized Role %q", text)
+	}
+	return nil
+}
+
+// Allows returns true if role includes permissions of the required role.
+func (r Role) Allows(required Role) bool {
+	rank, ok := roleRank[r]
+	return ok && rank >= roleRank[required]
+}
+
+// Token is a named API token with a role.
+// Token can be limited to a single cluster.
+type Token struct {
+	ID        uuid.UUID  `json:"id"`
+	Name      string     `json:"name"`
+	Role      Role       `json:"role"`
+	ClusterID *uuid.UUID `json:"cluster_id,omitempty"`
+	CreatedAt time.Time  `json:"created_at"`
+
+	// SecretHash is SHA-256 of the secret part of the token.
+	// The secret itself is never stored.
+	SecretHash []byte `json:"-"`
+}
+
+// AllClusters returns true if token is not limited to a single cluster.
+func (t *Token) AllClusters() bool {
+	return t.ClusterID == nil || *t.ClusterID == uuid.Nil
+}
+
+// CanAccessCluster returns true if token can be used for a given cluster.
+func (t *Token) CanAccessCluster(clusterID uuid.UUID) bool {
+	return t.AllClusters() || *t.ClusterID == clusterID
+}
+
+// Validate checks if token can be stored.
+func (t *Token) Validate() error {
+	if t == nil {
+		return util.ErrNilPtr
+	}
+
+	var errs error
+	if t.Name == "" {
+		errs = multierr.Append(errs, errors.New("missing name"))
+	}
+	if _, ok := roleRank[t.Role]; !ok {
+		errs = multierr.Append(errs, errors.Errorf("unrecognized role %q", t.Role))
+	}
+
+	return util.ErrValidate(errors.Wrap(errs, "invalid token"))
+}
+
+func sortTokens(tokens []*Token) {
+	sort.Slice(tokens, func(i, j int) bool {
+		return tokens[i].CreatedAt.Before(tokens[j].CreatedAt)
+	})
+}
@@ -0,0 +1,47 @@
+// Copyright (C) 2024 ScyllaDB
+
+package access
+
+import (
+	"testing"
+
+	"github.com/scylladb/scylla-manager/v3/pkg/util/uuid"
+)
+
+func TestRoleAllows(t *testing.T) {
+	testCases := []struct {
+		Role     Role
+		Required Role
+		Expected bool
+	}{
+		{Role: RoleReadOnly, Required: RoleReadOnly, Expected: true},
+		{Role: RoleReadOnly, Required: RoleOperator, Expected: false},
+		{Role: RoleOperator, Required: RoleReadOnly, Expected: true},
+		{Role: RoleOperator, Required: RoleAdmin, Expected: false},
+		{Role: RoleAdmin, Required: RoleOperator, Expected: true},
+		{Role: Role("unknown"), Required: RoleReadOnly, Expected: false},
+	}
+
+	for _, tc := range testCases {
+		if got := tc.Role.Allows(tc.Required); got != tc.Expected {
+			t.Errorf("%s.Allows(%s) = %v, expected %v", tc.Role, tc.Required, got, tc.Expected)
+		}
+	}
+}
+
+func TestTokenCanAccessCluster(t *testing.T) {
+	c1 := uuid.MustRandom()
+	c2 := uuid.MustRandom()
+
+	global := Token{Role: RoleAdmin}
+	if !global.CanAccessCluster(c1) || !global.CanAccessCluster(c2) {
+		t.Error("Token without cluster can't access all clusters")
+	}
+	scoped := Token{Role: RoleAdmin, ClusterID: &c1}
+	if !scoped.CanAccessCluster(c1) {
+		t.Error("Token can't access its cluster")
+	}
+	if scoped.CanAccessCluster(c2) {
+		t.Error("Token can access other cluster")
+	}
+}
@@ -0,0 +1,229 @@
+// Copyright (C) 2024 ScyllaDB
+
+package access
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/scylladb/go-log"
+	"github.com/scylladb/gocqlx/v2"
+	"github.com/scylladb/gocqlx/v2/qb"
+	"github.com/scylladb/scylla-manager/v3/pkg/schema/table"
+	"github.com/scylladb/scylla-manager/v3/pkg/util"
+	"github.com/scylladb/scylla-manager/v3/pkg/util/timeutc"
+	"github.com/scylladb/scylla-manager/v3/pkg/util/uuid"
+)
+
+// ErrInvalidToken is returned when token does not match any of the stored tokens.
+var ErrInvalidToken = errors.New("invalid token")
+
+// cacheTTL specifies for how long tokens are cached in memory.
+// Tokens are reloaded from database after that time so that changes done
+// by other instances are eventually picked up.
+const cacheTTL = 10 * time.Second
+
+// secretLen is the number of random bytes in token secret.
+const secretLen = 32
+
+// Service manages API tokens.
+type Service struct {
+	session   gocqlx.Session
+	authToken string
+	logger    log.Logger
+
+	mu       sync.Mutex
+	cache    map[uuid.UUID]*Token
+	cachedAt time.Time
+}
+
+// NewService returns Service, authToken is the admin token set in Scylla
+// Manager config, if set authentication is always enabled.
+func NewService(session gocqlx.Session, authToken string, l log.Logger) (*Service, error) {
+	if session.Session == nil || session.Closed() {
+		return nil, errors.New("invalid session")
+	}
+
+	return &Service{
+		session:   session,
+		authToken: authToken,
+		logger:    l,
+	}, nil
+}
+
+// ListTokens returns all stored tokens ordered by creation time.
+func (s *Service) ListTokens(ctx context.Context) ([]*Token, error) {
+	s.logger.Debug(ctx, "ListTokens")
+
+	q := qb.Select(table.ApiToken.Name()).Query(s.session)
+	var tokens []*Token
+	if err := q.SelectRelease(&tokens); err != nil {
+		return nil, err
+	}
+	sortTokens(tokens)
+	return tokens, nil
+}
+
+// CreateToken stores a new token and returns the secret that must be
+// presented by API clients. The secret is not stored and can't be retrieved
+// later. If there are no tokens yet, the token must have the admin role
+// as it enables authentication of all API requests.
+func (s *Service) CreateToken(ctx context.Context, t *Token) (string, error) {
+	s.logger.Debug(ctx, "CreateToken", "token", t)
+
+	if err := t.Validate(); err != nil {
+		return "", err
+	}
+	tokens, err := s.ListTokens(ctx)
+	if err != nil {
+		return "", errors.Wrap(err, "list tokens")
+	}
+	if len(tokens) == 0 && (t.Role != RoleAdmin || !t.AllClusters()) {
+		return "", util.ErrValidate(errors.New("first token must be an admin token with access to all clusters"))
+	}
+	for _, o := range tokens {
+		if o.Name == t.Name {
+			return "", util.ErrValidate(errors.Errorf("token %q already exists", t.Name))
+		}
+	}
+
+	t.ID = uuid.NewTime()
+	t.CreatedAt = timeutc.Now()
+	if t.ClusterID != nil && *t.ClusterID == uuid.Nil {
+		t.ClusterID = nil
+	}
+
+	b := make([]byte, secretLen)
+	if _, err := rand.Read(b); err != nil {
+		return "", errors.Wrap(err, "generate secret")
+	}
+	secret := base64.RawURLEncoding.EncodeToString(b)
+	t.SecretHash = hashSecret(secret)
+
+	if err := table.ApiToken.InsertQuery(s.session).BindStruct(t).ExecRelease(); err != nil {
+		return "", err
+	}
+	s.invalidateCache()
+
+	return t.ID.String() + "." + secret, nil
+}
+
+// DeleteToken removes token. The last admin token with access to all clusters
+// can only be removed if it's the only token left, which disables authentication.
+func (s *Service) DeleteToken(ctx context.Context, id uuid.UUID) error {
+	s.logger.Debug(ctx, "DeleteToken", "id", id)
+
+	tokens, err := s.ListTokens(ctx)
+	if err != nil {
+		return errors.Wrap(err, "list tokens")
+	}
+
+	var (
+		found  *Token
+		admins int
+	)
+	for _, t := range tokens {
+		if t.ID == id {
+			found = t
+		}
+		if t.Role == RoleAdmin && t.AllClusters() {
+			admins++
+		}
+	}
+	if found == nil {
+		return util.ErrNotFound
+	}
+	if found.Role == RoleAdmin && found.AllClusters() && admins == 1 && len(tokens) > 1 {
+		return util.ErrValidate(errors.New("can't delete the last admin token, delete other tokens first"))
+	}
+
+	q := table.ApiToken.DeleteQuery(s.session).BindMap(qb.M{"id": id})
+	if err := q.ExecRelease(); err != nil {
+		return err
+	}
+	s.invalidateCache()
+
+	return nil
+}
+
+// Authenticate returns token matching the token string presented by API client.
+// If auth_token is not set in Scylla Manager config and there are no tokens,
+// authentication is disabled, and it returns nil token and no error.
+// If token does not match, ErrInvalidToken is returned.
+func (s *Service) Authenticate(ctx context.Context, token string) (*Token, error) {
+	if s.authToken != "" && subtle.ConstantTimeCompare(hashSecret(token), hashSecret(s.authToken)) == 1 {
+		return configToken(), nil
+	}
+
+	tokens, err := s.cachedTokens(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(tokens) == 0 && s.authToken == "" {
+		return nil, nil
+	}
+
+	idPart, secret, ok := strings.Cut(token, ".")
+	if !ok {
+		return nil, ErrInvalidToken
+	}
+	id, err := uuid.Parse(idPart)
+	if err != nil {
+		return nil, ErrInvalidToken
+	}
+	t, ok := tokens[id]
+	if !ok {
+		return nil, ErrInvalidToken
+	}
+	if subtle.ConstantTimeCompare(t.SecretHash, hashSecret(secret)) != 1 {
+		return nil, ErrInvalidToken
+	}
+	return t, nil
+}
+
+func (s *Service) cachedTokens(ctx context.Context) (map[uuid.UUID]*Token, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.cache != nil && timeutc.Since(s.cachedAt) < cacheTTL {
+		return s.cache, nil
+	}
+
+	tokens, err := s.ListTokens(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "list tokens")
+	}
+	s.cache = make(map[uuid.UUID]*Token, len(tokens))
+	for _, t := range tokens {
+		s.cache[t.ID] = t
+	}
+	s.cachedAt = timeutc.Now()
+
+	return s.cache, nil
+}
+
+func (s *Service) invalidateCache() {
+	s.mu.Lock()
+	s.cache = nil
+	s.mu.Unlock()
+}
+
+// configToken returns token representing auth_token set in Scylla Manager config.
+func configToken() *Token {
+	return &Token{
+		Name: "auth_token",
+		Role: RoleAdmin,
+	}
+}
+
+func hashSecret(secret string) []byte {
+	h := sha256.Sum256([]byte(secret))
+	return h[:]
+}
@@ -0,0 +1,106 @@
+// Copyright (C) 2024 ScyllaDB
+
+//go:build all || integration
+// +build all integration
+
+package access_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/scylladb/go-log"
+	"github.com/scylladb/scylla-manager/v3/pkg/service/access"
+	. "github.com/scylladb/scylla-manager/v3/pkg/testutils/db"
+	"github.com/scylladb/scylla-manager/v3/pkg/util/uuid"
+)
+
+func TestServiceTokensIntegration(t *testing.T) {
+	ctx := context.Background()
+	session := CreateScyllaManagerDBSession(t)
+	s, err := access.NewService(session, "", log.NewDevelopment())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("authentication disabled without tokens", func(t *testing.T) {
+		tk, err := s.Authenticate(ctx, "")
+		if err != nil || tk != nil {
+			t.Fatalf("Authenticate() = %v, %v, expected nil, nil", tk, err)
+		}
+	})
+
+	t.Run("first token must be admin", func(t *testing.T) {
+		if _, err := s.CreateToken(ctx, &access.Token{Name: "ro", Role: access.RoleReadOnly}); err == nil {
+			t.Fatal("CreateToken() expected error")
+		}
+	})
+
+	admin := &access.Token{Name: "admin", Role: access.RoleAdmin}
+	adminSecret, err := s.CreateToken(ctx, admin)
+	if err != nil {
+		t.Fatal(err)
+	}
+	clusterID := uuid.MustRandom()
+	ro := &access.Token{Name: "ro", Role: access.RoleReadOnly, ClusterID: &clusterID}
+	roSecret, err := s.CreateToken(ctx, ro)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("authenticate", func(t *testing.T) {
+		tk, err := s.Authenticate(ctx, adminSecret)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if tk.ID != admin.ID {
+			t.Fatalf("Authenticate() = %s, expected %s", tk.ID, admin.ID)
+		}
+		tk, err = s.Authenticate(ctx, roSecret)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !tk.CanAccessCluster(clusterID) || tk.CanAccessCluster(uuid.MustRandom()) {
+			t.Fatal("Scoped token cluster access mismatch")
+		}
+		if _, err := s.Authenticate(ctx, admin.ID.String()+".invalid"); !errors.Is(err, access.ErrInvalidToken) {
+			t.Fatalf("Authenticate() error %s, expected %s", err, access.ErrInvalidToken)
+		}
+		if _, err := s.Authenticate(ctx, ""); !errors.Is(err, access.ErrInvalidToken) {
+			t.Fatalf("Authenticate() error %s, expected %s", err, access.ErrInvalidToken)
+		}
+	})
+
+	t.Run("delete last admin", func(t *testing.T) {
+		if err := s.DeleteToken(ctx, admin.ID); err == nil {
+			t.Fatal("DeleteToken() expected error")
+		}
+		if err := s.DeleteToken(ctx, ro.ID); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := s.Authenticate(ctx, roSecret); !errors.Is(err, access.ErrInvalidToken) {
+			t.Fatalf("Authenticate() error %s, expected %s", err, access.ErrInvalidToken)
+		}
+		if err := s.DeleteToken(ctx, admin.ID); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	t.Run("config token", func(t *testing.T) {
+		s, err := access.NewService(session, "config-secret", log.NewDevelopment())
+		if err != nil {
+			t.Fatal(err)
+		}
+		tk, err := s.Authenticate(ctx, "config-secret")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if tk.Role != access.RoleAdmin || !tk.AllClusters() {
+			t.Fatalf("Authenticate() = %+v, expected admin token with access to all clusters", tk)
+		}
+		if _, err := s.Authenticate(ctx, ""); !errors.Is(err, access.ErrInvalidToken) {
+			t.Fatalf("Authenticate() error %s, expected %s", err, access.ErrInvalidToken)
+		}
+	})
+}
@@ -0,0 +1,114 @@
+// Copyright (C) 2024 ScyllaDB
+
+package audit
+
+import (
+	"encoding/json"
+	"reflect"
+
+	"github.com/pkg/errors"
+)
+
+// Change describes modification of a single value.
+type Change struct {
+	Old interface{} `json:"old,omitempty"`
+	New interface{} `json:"new,omitempty"`
+}
+
+// redacted replaces values of sensitive fields in diff.
+const redacted = "***"
+
+// sensitiveFields lists fields which values are never stored in audit log.
+var sensitiveFields = map[string]struct{}{
+	"auth_token":         {},
+	"password":           {},
+	"secret":             {},
+	"ssl_user_cert_file": {},
+	"ssl_user_key_file":  {},
+}
+
+// Diff returns JSON object mapping paths of values changed between before
+// and after to their old and new values. Values are compared after
+// JSON serialization, nested objects are compared field by field,
+// paths are dot separated. Nil before or after denote resource creation
+// or deletion. Values of sensitive fields are redacted.
+func Diff(before, after interface{}) (json.RawMessage, error) {
+	b, err := toJSONValue(before)
+	if err != nil {
+		return nil, errors.Wrap(err, "marshal old value")
+	}
+	a, err := toJSONValue(after)
+	if err != nil {
+		return nil, errors.Wrap(err, "marshal new value")
+	}
+
+	changes := make(map[string]Change)
+	diff("", b, a, changes)
+	if len(changes) == 0 {
+		return nil, nil
+	}
+	return json.Marshal(changes)
+}
+
+func toJSONValue(v interface{}) (interface{}, error) {
+	if v == nil || (reflect.ValueOf(v).Kind() == reflect.Ptr && reflect.ValueOf(v).IsNil()) {
+		return nil, nil
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var out interface{}
+	if err := json.Unmarshal(b, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func diff(path string, before, after interface{}, changes map[string]Change) {
+	bm, bok := before.(map[string]interface{})
+	am, aok := after.(map[string]interface{})
+	if (bok || before == nil) && (aok || after == nil) && (bok || aok) {
+		keys := make(map[string]struct{})
+		for k := range bm {
+			keys[k] = struct{}{}
+		}
+		for k := range am {
+			keys[k] = struct{}{}
+		}
+		for k := range keys {
+			p := k
+			if path != "" {
+				p = path + "." + k
+			}
+			if _, ok := sensitiveFields[k]; ok {
+				diffSensitive(p, bm[k], am[k], changes)
+				continue
+			}
+			diff(p, bm[k], am[k], changes)
+		}
+		return
+	}
+
+	if !reflect.DeepEqual(before, after) {
+		changes[path] = Change{Old: before, New: after}
+	}
+}
+
+func diffSensitive(path string, before, after interface{}, changes map[string]Change) {
+	if reflect.DeepEqual(before, after) {
+		return
+	}
+	var c Change
+	if !isEmpty(before) {
+		c.Old = redacted
+	}
+	if !isEmpty(after) {
+		c.New = redacted
+	}
+	changes[path] = c
+}
+
+func isEmpty(v interface{}) bool {
+	return v == nil || v == ""
+}
@@ -0,0 +1,85 @@
+// Copyright (C) 2024 ScyllaDB
+
+package audit
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestDiff(t *testing.T) {
+	type resource struct {
+		Name       string          `json:"name"`
+		Password   string          `json:"password,omitempty"`
+		Tags       []string        `json:"tags,omitempty"`
+		Properties json.RawMessage `json:"properties,omitempty"`
+	}
+
+	testCases := []struct {
+		Name     string
+		Before   interface{}
+		After    interface{}
+		Expected map[string]Change
+	}{
+		{
+			Name:     "no changes",
+			Before:   &resource{Name: "a"},
+			After:    &resource{Name: "a"},
+			Expected: nil,
+		},
+		{
+			Name:   "create",
+			Before: nil,
+			After:  &resource{Name: "a", Tags: []string{"x"}},
+			Expected: map[string]Change{
+				"name": {New: "a"},
+				"tags": {New: []interface{}{"x"}},
+			},
+		},
+		{
+			Name:   "delete",
+			Before: &resource{Name: "a"},
+			After:  (*resource)(nil),
+			Expected: map[string]Change{
+				"name": {Old: "a"},
+			},
+		},
+		{
+			Name:   "nested",
+			Before: &resource{Name: "a", Properties: json.RawMessage(`{"intensity":1,"dc":["dc1"]}`)},
+			After:  &resource{Name: "a", Properties: json.RawMessage(`{"intensity":2,"dc":["dc1"]}`)},
+			Expected: map[string]Change{
+				"properties.intensity": {Old: float64(1), New: float64(2)},
+			},
+		},
+		{
+			Name:   "sensitive",
+			Before: &resource{Name: "a", Password: "old"},
+			After:  &resource{Name: "a", Password: "new"},
+			Expected: map[string]Change{
+				"password": {Old: redacted, New: redacted},
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+		t.Run(tc.Name, func(t *testing.T) {
+			b, err := Diff(tc.Before, tc.After)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var got map[string]Change
+			if b != nil {
+				if err := json.Unmarshal(b, &got); err != nil {
+					t.Fatal(err)
+				}
+			}
+			if diff := cmp.Diff(tc.Expected, got); diff != "" {
+				t.Fatal(diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,38 @@
+// Copyright (C) 2024 ScyllaDB
+
+package audit
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/scylladb/scylla-manager/v3/pkg/util/uuid"
+)
+
+// Entry describes a single mutating API operation.
+type Entry struct {
+	// ClusterID is uuid.Nil for operations not related to a cluster.
+	ClusterID  uuid.UUID `json:"cluster_id"`
+	ID         uuid.UUID `json:"id"`
+	Time       time.Time `json:"time"`
+	ClientAddr string    `json:"client_addr"`
+	// Identity is the name of API token used, or "anonymous"
+	// if authentication is disabled.
+	Identity string `json:"identity"`
+	Method   string `json:"method"`
+	Path     string `json:"path"`
+	// Resource is the type of modified resource i.e. cluster, task, backup.
+	Resource   string          `json:"resource"`
+	ResourceID string          `json:"resource_id"`
+	StatusCode int             `json:"status_code"`
+	Diff       json.RawMessage `json:"diff,omitempty"`
+}
+
+// Filter specifies which entries are listed.
+type Filter struct {
+	// ClusterID limits entries to a single cluster, uuid.Nil selects all entries.
+	ClusterID uuid.UUID
+	Since     time.Time
+	Until     time.Time
+	Limit     int
+}
@@ -0,0 +1,105 @@
+// Copyright (C) 2024 ScyllaDB
+
+package audit
+
+import (
+	"context"
+	"sort"
+
+	"github.com/pkg/errors"
+	"github.com/scylladb/go-log"
+	"github.com/scylladb/gocqlx/v2"
+	"github.com/scylladb/gocqlx/v2/qb"
+	"github.com/scylladb/scylla-manager/v3/pkg/schema/table"
+	"github.com/scylladb/scylla-manager/v3/pkg/util/timeutc"
+	"github.com/scylladb/scylla-manager/v3/pkg/util/uuid"
+)
+
+// Service stores and lists audit log entries.
+type Service struct {
+	session gocqlx.Session
+	logger  log.Logger
+}
+
+func NewService(session gocqlx.Session, l log.Logger) (*Service, error) {
+	if session.Session == nil || session.Closed() {
+		return nil, errors.New("invalid session")
+	}
+
+	return &Service{
+		session: session,
+		logger:  l,
+	}, nil
+}
+
+// Log stores entry, ID and time are set if not provided.
+// ID is a time UUID generated from entry time so that entries can be listed
+// by time range.
+func (s *Service) Log(ctx context.Context, e *Entry) error {
+	s.logger.Debug(ctx, "Log", "entry", e)
+
+	if e.Time.IsZero() {
+		e.Time = timeutc.Now()
+	}
+	if e.ID == uuid.Nil {
+		e.ID = uuid.NewFromTime(e.Time)
+	}
+	return table.AuditLog.InsertQuery(s.session).BindStruct(e).ExecRelease()
+}
+
+// List returns entries matching the filter ordered from the newest to the oldest.
+func (s *Service) List(ctx context.Context, f Filter) ([]*Entry, error) {
+	s.logger.Debug(ctx, "List", "filter", f)
+
+	clusterIDs := []uuid.UUID{f.ClusterID}
+	if f.ClusterID == uuid.Nil {
+		q := qb.Select(table.AuditLog.Name()).Distinct("cluster_id").Query(s.session)
+		clusterIDs = nil
+		if err := q.SelectRelease(&clusterIDs); err != nil {
+			return nil, errors.Wrap(err, "list clusters")
+		}
+	}
+
+	var out []*Entry
+	for _, id := range clusterIDs {
+		entries, err := s.listCluster(id, f)
+		if err != nil {
+			return nil, errors.Wrapf(err, "list cluster %s", id)
+		}
+		out = append(out, entries...)
+	}
+
+	sort.SliceStable(out, func(i, j int) bool {
+		return out[i].Time.After(out[j].Time)
+	})
+	if f.Limit > 0 && len(out) > f.Limit {
+		out = out[:f.Limit]
+	}
+	return out, nil
+}
+
+// listCluster returns entries of a single cluster partition, time range and
+// limit are applied in the query as entries are clustered by ID descending.
+func (s *Service) listCluster(clusterID uuid.UUID, f Filter) ([]*Entry, error) {
+	b := qb.Select(table.AuditLog.Name()).Where(qb.Eq("cluster_id"))
+	m := qb.M{
+		"cluster_id": clusterID,
+	}
+	if !f.Since.IsZero() {
+		b.Where(qb.GtOrEqFunc("id", qb.MinTimeuuid("since")))
+		m["since"] = f.Since
+	}
+	if !f.Until.IsZero() {
+		b.Where(qb.LtOrEqFunc("id", qb.MaxTimeuuid("until")))
+		m["until"] = f.Until
+	}
+	if f.Limit > 0 {
+		b.Limit(uint(f.Limit))
+	}
+
+	var entries []*Entry
+	if err := b.Query(s.session).BindMap(m).SelectRelease(&entries); err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
@@ -0,0 +1,84 @@
+// Copyright (C) 2024 ScyllaDB
+
+//go:build all || integration
+// +build all integration
+
+package audit_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/scylladb/go-log"
+	"github.com/scylladb/scylla-manager/v3/pkg/service/audit"
+	. "github.com/scylladb/scylla-manager/v3/pkg/testutils/db"
+	"github.com/scylladb/scylla-manager/v3/pkg/util/timeutc"
+	"github.com/scylladb/scylla-manager/v3/pkg/util/uuid"
+)
+
+func TestServiceListIntegration(t *testing.T) {
+	ctx := context.Background()
+	session := CreateScyllaManagerDBSession(t)
+	s, err := audit.NewService(session, log.NewDevelopment())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var (
+		c1  = uuid.MustRandom()
+		c2  = uuid.MustRandom()
+		now = timeutc.Now()
+	)
+	entries := []*audit.Entry{
+		{ClusterID: c1, Time: now.Add(-3 * time.Hour), Method: "PUT", Path: "/1"},
+		{ClusterID: c2, Time: now.Add(-2 * time.Hour), Method: "PUT", Path: "/2"},
+		{ClusterID: c1, Time: now.Add(-time.Hour), Method: "DELETE", Path: "/3"},
+		{Time: now, Method: "POST", Path: "/4"},
+	}
+	for _, e := range entries {
+		if err := s.Log(ctx, e); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	paths := func(entries []*audit.Entry) []string {
+		var out []string
+		for _, e := range entries {
+			if e.ClusterID == c1 || e.ClusterID == c2 || e.Path == "/4" {
+				out = append(out, e.Path)
+			}
+		}
+		return out
+	}
+
+	testCases := []struct {
+		Name     string
+		Filter   audit.Filter
+		Expected []string
+	}{
+		{Name: "cluster", Filter: audit.Filter{ClusterID: c1}, Expected: []string{"/3", "/1"}},
+		{Name: "since", Filter: audit.Filter{Since: now.Add(-150 * time.Minute)}, Expected: []string{"/4", "/3", "/2"}},
+		{Name: "until", Filter: audit.Filter{ClusterID: c1, Until: now.Add(-2 * time.Hour)}, Expected: []string{"/1"}},
+		{Name: "limit", Filter: audit.Filter{Since: now.Add(-4 * time.Hour), Limit: 2}, Expected: []string{"/4", "/3"}},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+		t.Run(tc.Name, func(t *testing.T) {
+			got, err := s.List(ctx, tc.Filter)
+			if err != nil {
+				t.Fatal(err)
+			}
+			p := paths(got)
+			if len(p) != len(tc.Expected) {
+				t.Fatalf("List() = %v, expected %v", p, tc.Expected)
+			}
+			for i := range p {
+				if p[i] != tc.Expected[i] {
+					t.Fatalf("List() = %v, expected %v", p, tc.Expected)
+				}
+			}
+		})
+	}
+}
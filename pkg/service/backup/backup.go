@@ -9,7 +9,7 @@ import (
 	"go.uber.org/multierr"
 )
 
-func makeHostInfo(nodes []scyllaclient.NodeStatusInfo, locations []Location, rateLimits []DCLimit, transfers int) ([]hostInfo, error) {
+func makeHostInfo(nodes []scyllaclient.NodeStatusInfo, locations []Location, rateLimits []DCLimit, rateLimitProfiles []DCLimitProfile, transfers int) ([]hostInfo, error) {
 	// DC location index
 	dcl := map[string]Location{}
 	for _, l := range locations {
@@ -22,6 +22,12 @@ func makeHostInfo(nodes []scyllaclient.NodeStatusInfo, locations []Location, rat
 		dcr[r.DC] = r
 	}
 
+	// DC rate limit profile index
+	dcp := map[string]DCLimitProfile{}
+	for _, p := range rateLimitProfiles {
+		dcp[p.DC] = p
+	}
+
 	var (
 		hi   = make([]hostInfo, len(nodes))
 		errs error
@@ -43,6 +49,10 @@ func makeHostInfo(nodes []scyllaclient.NodeStatusInfo, locations []Location, rat
 		if !ok {
 			hi[i].RateLimit = dcr[""] // no rate limit is ok, fallback to 0 - no limit
 		}
+		hi[i].RateLimitProfile, ok = dcp[h.Datacenter]
+		if !ok {
+			hi[i].RateLimitProfile = dcp[""] // no profile is ok, rate limit is used
+		}
 		hi[i].Transfers = transfers
 	}
 
@@ -0,0 +1,95 @@
+// Copyright (C) 2024 ScyllaDB
+
+package backupspec
+
+import (
+	"path"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/scylladb/scylla-manager/v3/pkg/util/pathparser"
+	"github.com/scylladb/scylla-manager/v3/pkg/util/timeutc"
+	"github.com/scylladb/scylla-manager/v3/pkg/util/uuid"
+)
+
+var (
+	commitlogSegmentRegexp     = regexp.MustCompile(`^CommitLog-[0-9]+-[0-9]+\.log$`)
+	commitlogSegmentFileRegexp = regexp.MustCompile(`^cl_([0-9]{14})UTC_(CommitLog-[0-9]+-[0-9]+\.log)$`)
+	errInvalidCommitlogFile    = errors.New("not a Scylla Manager commitlog segment file, expected format is cl_20060102150405UTC_CommitLog-<version>-<id>.log")
+)
+
+// IsCommitlogSegment returns true if provided file name is a name
+// of Scylla commitlog segment.
+func IsCommitlogSegment(name string) bool {
+	return commitlogSegmentRegexp.MatchString(name)
+}
+
+// CommitlogSegmentFile returns name of the archived commitlog segment file.
+// Segment seal time is encoded in the name so that segments can be selected
+// for point-in-time restore without reading them.
+func CommitlogSegmentFile(sealedAt time.Time, segment string) string {
+	return "cl_" + sealedAt.UTC().Format(tagDateFormat) + "UTC_" + segment
+}
+
+// CommitlogSegmentInfo represents archived commitlog segment on remote location.
+type CommitlogSegmentInfo struct {
+	Location  Location
+	DC        string
+	ClusterID uuid.UUID
+	NodeID    string
+	Segment   string
+	SealedAt  time.Time
+}
+
+// Path returns path to the archived commitlog segment file.
+func (s *CommitlogSegmentInfo) Path() string {
+	return path.Join(
+		RemoteCommitlogDir(s.ClusterID, s.DC, s.NodeID),
+		CommitlogSegmentFile(s.SealedAt, s.Segment),
+	)
+}
+
+// ParsePath extracts properties from full remote path to archived commitlog segment.
+func (s *CommitlogSegmentInfo) ParsePath(p string) error {
+	// Clear values
+	*s = CommitlogSegmentInfo{}
+
+	// Clean path for usage with strings.Split
+	p = strings.TrimPrefix(path.Clean(p), sep)
+
+	parsers := []pathparser.Parser{
+		pathparser.Static("backup"),
+		pathparser.Static(string(CommitlogDirKind)),
+		pathparser.Static("cluster"),
+		pathparser.ID(&s.ClusterID),
+		pathparser.Static("dc"),
+		pathparser.String(&s.DC),
+		pathparser.Static("node"),
+		pathparser.String(&s.NodeID),
+		s.fileNameParser,
+	}
+	n, err := pathparser.New(p, sep).Parse(parsers...)
+	if err != nil {
+		return err
+	}
+	if n < len(parsers) {
+		return errors.Errorf("no input at position %d", n)
+	}
+	return nil
+}
+
+func (s *CommitlogSegmentInfo) fileNameParser(v string) error {
+	m := commitlogSegmentFileRegexp.FindStringSubmatch(v)
+	if m == nil {
+		return errInvalidCommitlogFile
+	}
+	t, err := timeutc.Parse(tagDateFormat, m[1])
+	if err != nil {
+		return errors.Wrap(err, "parse seal time")
+	}
+	s.SealedAt = t
+	s.Segment = m[2]
+	return nil
+}
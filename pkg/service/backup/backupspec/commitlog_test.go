@@ -0,0 +1,105 @@
+// Copyright (C) 2024 ScyllaDB
+
+package backupspec
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	. "github.com/scylladb/scylla-manager/v3/pkg/testutils"
+	"github.com/scylladb/scylla-manager/v3/pkg/util/uuid"
+)
+
+func TestCommitlogSegmentInfoParsePath(t *testing.T) {
+	t.Parallel()
+
+	golden := CommitlogSegmentInfo{
+		ClusterID: uuid.MustRandom(),
+		DC:        "a",
+		NodeID:    "b",
+		Segment:   "CommitLog-2-2251799813685300.log",
+		SealedAt:  time.Date(2024, 5, 20, 8, 40, 25, 0, time.UTC),
+	}
+
+	var s CommitlogSegmentInfo
+	if err := s.ParsePath(golden.Path()); err != nil {
+		t.Fatal("ParsePath() error", err)
+	}
+	if diff := cmp.Diff(s, golden, UUIDComparer()); diff != "" {
+		t.Fatal("ParsePath() diff", diff)
+	}
+}
+
+func TestCommitlogSegmentInfoParsePathErrors(t *testing.T) {
+	t.Parallel()
+
+	dir := RemoteCommitlogDir(uuid.MustRandom(), "a", "b")
+
+	table := []struct {
+		Name  string
+		Path  string
+		Error string
+	}{
+		{
+			Name:  "invalid dir kind",
+			Path:  "backup/meta/cluster/" + uuid.MustRandom().String(),
+			Error: "expected commitlog",
+		},
+		{
+			Name:  "missing file",
+			Path:  dir,
+			Error: "no input",
+		},
+		{
+			Name:  "not archived segment",
+			Path:  dir + "/CommitLog-2-2251799813685300.log",
+			Error: "not a Scylla Manager commitlog segment file",
+		},
+		{
+			Name:  "recycled segment",
+			Path:  dir + "/cl_20240520084025UTC_Recycled-CommitLog-2-2251799813685300.log",
+			Error: "not a Scylla Manager commitlog segment file",
+		},
+	}
+
+	for i := range table {
+		test := table[i]
+
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+
+			var s CommitlogSegmentInfo
+			err := s.ParsePath(test.Path)
+			if err == nil {
+				t.Fatal("ParsePath() expected error")
+			}
+
+			t.Log("ParsePath():", err)
+			if !strings.Contains(err.Error(), test.Error) {
+				t.Fatalf("ParsePath() = %v, expected %v", err, test.Error)
+			}
+		})
+	}
+}
+
+func TestIsCommitlogSegment(t *testing.T) {
+	t.Parallel()
+
+	table := []struct {
+		Name   string
+		Golden bool
+	}{
+		{Name: "CommitLog-2-2251799813685300.log", Golden: true},
+		{Name: "Recycled-CommitLog-2-2251799813685300.log", Golden: false},
+		{Name: "CommitLog-2-2251799813685300.log.tmp", Golden: false},
+		{Name: "foo", Golden: false},
+	}
+
+	for _, test := range table {
+		if v := IsCommitlogSegment(test.Name); v != test.Golden {
+			t.Errorf("IsCommitlogSegment(%s) = %v, expected %v", test.Name, v, test.Golden)
+		}
+	}
+}
@@ -56,6 +56,83 @@ func (l *DCLimit) UnmarshalText(text []byte) error {
 	return nil
 }
 
+// DCLimitProfile specifies time of day rate limits for a DC.
+// Limit of a slot applies from the slot start until the start of the next
+// slot, the last slot applies until the start of the first slot next day.
+// Time of day is the local time of the node.
+type DCLimitProfile struct {
+	DC    string      `json:"dc"`
+	Slots []LimitSlot `json:"slots"`
+}
+
+// LimitSlot specifies a rate limit from start time of day in hh:mm format.
+type LimitSlot struct {
+	Start string `json:"start"`
+	Limit int    `json:"limit"`
+}
+
+func (p DCLimitProfile) String() string {
+	slots := make([]string, len(p.Slots))
+	for i, s := range p.Slots {
+		slots[i] = fmt.Sprintf("%s=%d", s.Start, s.Limit)
+	}
+	out := strings.Join(slots, " ")
+	if p.DC != "" {
+		out = p.DC + ":" + out
+	}
+	return out
+}
+
+// Datacenter returns datacenter that is limited.
+func (p DCLimitProfile) Datacenter() string {
+	return p.DC
+}
+
+// Timetable returns the profile in the rclone --bwlimit timetable format
+// e.g. "08:00,50M 18:00,off" with limits in MiB per second.
+func (p DCLimitProfile) Timetable() string {
+	slots := make([]string, len(p.Slots))
+	for i, s := range p.Slots {
+		limit := "off"
+		if s.Limit > 0 {
+			limit = fmt.Sprintf("%dM", s.Limit)
+		}
+		slots[i] = s.Start + "," + limit
+	}
+	return strings.Join(slots, " ")
+}
+
+func (p DCLimitProfile) MarshalText() (text []byte, err error) {
+	return []byte(p.String()), nil
+}
+
+func (p *DCLimitProfile) UnmarshalText(text []byte) error {
+	pattern := regexp.MustCompile(`^(([a-zA-Z0-9\-\_\.]+):)?([0-9]{2}:[0-9]{2}=[0-9]+( +[0-9]{2}:[0-9]{2}=[0-9]+)*)$`)
+
+	m := pattern.FindSubmatch(text)
+	if m == nil {
+		return errors.Errorf("invalid limit profile %q, the format is [dc:]<hh:mm>=<number>[ <hh:mm>=<number>...]", string(text))
+	}
+
+	var slots []LimitSlot
+	for _, f := range strings.Fields(string(m[3])) {
+		start, v, _ := strings.Cut(f, "=")
+		if hh, mm := start[:2], start[3:]; hh > "23" || mm > "59" {
+			return errors.Errorf("invalid limit profile time %q", start)
+		}
+		limit, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			return errors.Wrap(err, "invalid limit value")
+		}
+		slots = append(slots, LimitSlot{Start: start, Limit: int(limit)})
+	}
+
+	p.DC = string(m[2])
+	p.Slots = slots
+
+	return nil
+}
+
 // Datacenterer describes object that is connected to some datacenter.
 type Datacenterer interface {
 	Datacenter() string
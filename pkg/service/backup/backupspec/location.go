@@ -19,9 +19,11 @@ const (
 	S3    = Provider("s3")
 	GCS   = Provider("gcs")
 	Azure = Provider("azure")
+	NFS   = Provider("nfs")
+	SFTP  = Provider("sftp")
 )
 
-var providers = []Provider{S3, GCS, Azure}
+var providers = []Provider{S3, GCS, Azure, NFS, SFTP}
 
 // Providers returns a list of all supported providers as a list of strings.
 func Providers() []string {
@@ -38,6 +38,19 @@ func TestNewLocation(t *testing.T) {
 			Provider: S3,
 			Bucket:   "bucket",
 		},
+		{
+			Name:     "NFS",
+			Location: "nfs:backup",
+			Provider: NFS,
+			Bucket:   "backup",
+		},
+		{
+			Name:     "SFTP with prefix",
+			Location: "dc1:sftp:backup",
+			DC:       "dc1",
+			Provider: SFTP,
+			Bucket:   "backup",
+		},
 	}
 
 	for i := 0; i < len(table); i++ {
@@ -69,7 +82,7 @@ func TestNewLocation(t *testing.T) {
 func TestProviderMarshalUnmarshalText(t *testing.T) {
 	t.Parallel()
 
-	for _, k := range []Provider{S3} {
+	for _, k := range []Provider{S3, GCS, Azure, NFS, SFTP} {
 		b, err := k.MarshalText()
 		if err != nil {
 			t.Error(k, err)
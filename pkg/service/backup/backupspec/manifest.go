@@ -10,6 +10,7 @@ import (
 	"path"
 	"runtime"
 	"strings"
+	"time"
 
 	jsoniter "github.com/json-iterator/go"
 	"github.com/pkg/errors"
@@ -121,6 +122,30 @@ type ManifestContent struct {
 	Size        int64   `json:"size"`
 	Tokens      []int64 `json:"tokens"`
 	Schema      string  `json:"schema"`
+	// EncryptionKeyID is the ID of the key used for encryption of backup
+	// files, it's empty if files are not encrypted.
+	EncryptionKeyID string `json:"encryption_key_id,omitempty"`
+	// Compression is the codec used for compression of backup files,
+	// it's empty if files are not compressed.
+	Compression string `json:"compression,omitempty"`
+	// ParentSnapshotTag is the snapshot tag of the previous backup in the
+	// chain of incremental backups, it's empty if backup starts the chain.
+	ParentSnapshotTag string `json:"parent_snapshot_tag,omitempty"`
+	// BaseSnapshotTag is the snapshot tag of the synthetic full backup
+	// starting the chain of incremental backups, it's empty if backup
+	// is not incremental.
+	BaseSnapshotTag string `json:"base_snapshot_tag,omitempty"`
+	// ObjectLockMode is the object lock mode of backup files and
+	// RetainUntil is the date until they are locked, files can't be
+	// deleted before that date. They are empty if backup is not locked.
+	ObjectLockMode string     `json:"object_lock_mode,omitempty"`
+	RetainUntil    *time.Time `json:"retain_until,omitempty"`
+}
+
+// Locked returns true if backup files can't be deleted at the time t
+// due to object lock.
+func (m *ManifestContent) Locked(t time.Time) bool {
+	return m.RetainUntil != nil && m.RetainUntil.After(t)
 }
 
 // ManifestContentWithIndex is structure containing information about the backup
@@ -302,6 +327,12 @@ type FilesMeta struct {
 	Version  string   `json:"version"`
 	Files    []string `json:"files"`
 	Size     int64    `json:"size"`
+	// Checksums maps file names to hex encoded CRC32 checksums of files
+	// content, it's empty in manifests of older backups.
+	Checksums map[string]string `json:"checksums,omitempty"`
+	// Sizes maps names of compressible files to sizes of their original
+	// content, it's set only in manifests of compressed backups.
+	Sizes map[string]int64 `json:"sizes,omitempty"`
 
 	Path string `json:"path,omitempty"`
 }
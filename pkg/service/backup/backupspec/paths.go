@@ -31,15 +31,16 @@ type dirKind string
 
 // Enumeration of dirKinds.
 const (
-	SchemaDirKind = dirKind("schema")
-	SSTDirKind    = dirKind("sst")
-	MetaDirKind   = dirKind("meta")
+	SchemaDirKind    = dirKind("schema")
+	SSTDirKind       = dirKind("sst")
+	MetaDirKind      = dirKind("meta")
+	CommitlogDirKind = dirKind("commitlog")
 )
 
 // RemoteManifestLevel calculates maximal depth of recursive listing starting at
 // baseDir to list all manifests.
 func RemoteManifestLevel(baseDir string) int {
-	a := len(strings.Split(remoteManifestDir(uuid.Nil, "a", "b"), sep))
+	a := len(strings.Split(RemoteManifestDir(uuid.Nil, "a", "b"), sep))
 	b := len(strings.Split(baseDir, sep))
 	return a - b
 }
@@ -55,12 +56,13 @@ func RemoteManifestFile(clusterID, taskID uuid.UUID, snapshotTag, dc, nodeID str
 	}, "_")
 
 	return path.Join(
-		remoteManifestDir(clusterID, dc, nodeID),
+		RemoteManifestDir(clusterID, dc, nodeID),
 		manifestName,
 	)
 }
 
-func remoteManifestDir(clusterID uuid.UUID, dc, nodeID string) string {
+// RemoteManifestDir returns path to the manifests directory of the provided node.
+func RemoteManifestDir(clusterID uuid.UUID, dc, nodeID string) string {
 	return path.Join(
 		"backup",
 		string(MetaDirKind),
@@ -165,6 +167,29 @@ func RemoteSSTableDir(clusterID uuid.UUID, dc, nodeID, keyspace, table string) s
 	)
 }
 
+// RemoteCommitlogClusterDir returns path to the archived commitlog segments
+// directory of the provided cluster.
+func RemoteCommitlogClusterDir(clusterID uuid.UUID) string {
+	return path.Join(
+		"backup",
+		string(CommitlogDirKind),
+		"cluster",
+		clusterID.String(),
+	)
+}
+
+// RemoteCommitlogDir returns path to the archived commitlog segments directory
+// of the provided node.
+func RemoteCommitlogDir(clusterID uuid.UUID, dc, nodeID string) string {
+	return path.Join(
+		RemoteCommitlogClusterDir(clusterID),
+		"dc",
+		dc,
+		"node",
+		nodeID,
+	)
+}
+
 // TempFile returns temporary path for the provided file.
 func TempFile(f string) string {
 	return f + TempFileExt
@@ -0,0 +1,138 @@
+// Copyright (C) 2024 ScyllaDB
+
+package backup
+
+import (
+	"context"
+	"path"
+	"sort"
+
+	"github.com/pkg/errors"
+	"github.com/scylladb/go-set/strset"
+	"github.com/scylladb/scylla-manager/v3/pkg/scyllaclient"
+	. "github.com/scylladb/scylla-manager/v3/pkg/service/backup/backupspec"
+)
+
+// snapshotChecksum is a checksum of file content recorded in manifest of
+// the snapshot.
+type snapshotChecksum struct {
+	SnapshotTag string
+	Checksum    string
+}
+
+// checksumSet maps remote SSTable directories to names of files and their
+// checksums recorded in manifests.
+type checksumSet map[string]map[string][]snapshotChecksum
+
+func (cs checksumSet) Add(dir, snapshotTag string, checksums map[string]string) {
+	if len(checksums) == 0 {
+		return
+	}
+	d, ok := cs[dir]
+	if !ok {
+		d = make(map[string][]snapshotChecksum, len(checksums))
+		cs[dir] = d
+	}
+	for name, c := range checksums {
+		d[name] = append(d[name], snapshotChecksum{SnapshotTag: snapshotTag, Checksum: c})
+	}
+}
+
+func (cs checksumSet) Dirs() []string {
+	dirs := make([]string, 0, len(cs))
+	for d := range cs {
+		dirs = append(dirs, d)
+	}
+	sort.Strings(dirs)
+	return dirs
+}
+
+// resolveVersions maps files of snapshots to remote objects holding their
+// content. Versions maps file names to versions present in the location,
+// empty version describes the newest version of file. Files not present
+// in the location are skipped.
+func resolveVersions(files map[string][]snapshotChecksum, versions map[string][]string) map[string][]snapshotChecksum {
+	objects := make(map[string][]snapshotChecksum, len(files))
+	for name, checksums := range files {
+		vs, ok := versions[name]
+		if !ok {
+			continue
+		}
+		for _, c := range checksums {
+			o := VersionedSSTable{Name: name, Version: snapshotVersion(vs, c.SnapshotTag)}.FullName()
+			objects[o] = append(objects[o], c)
+		}
+	}
+	return objects
+}
+
+// snapshotVersion returns version of file that belongs to the snapshot.
+// It's the oldest version introduced after the snapshot, or the newest
+// version if there is no such version.
+func snapshotVersion(versions []string, snapshotTag string) string {
+	var v string
+	for _, version := range versions {
+		if version > snapshotTag && (v == "" || version < v) {
+			v = version
+		}
+	}
+	return v
+}
+
+// verifyChecksums reads remote files of snapshots and compares checksums of
+// their content with checksums recorded in manifests. It returns the number
+// of corrupted files and snapshot tags of snapshots containing them.
+func (p purger) verifyChecksums(ctx context.Context, location Location, checksums checksumSet) (int, []string, error) {
+	const batchSize = 1000
+
+	var (
+		corrupted int
+		broken    = strset.New()
+	)
+	for _, dir := range checksums.Dirs() {
+		versions := make(map[string][]string)
+		opts := &scyllaclient.RcloneListDirOpts{FilesOnly: true}
+		err := p.client.RcloneListDirIter(ctx, p.host, location.RemotePath(dir), opts, func(item *scyllaclient.RcloneListDirItem) {
+			name, version := SplitNameAndVersion(item.Name)
+			versions[name] = append(versions[name], version)
+		})
+		if err != nil {
+			return corrupted, nil, errors.Wrapf(err, "list %s", dir)
+		}
+
+		objects := resolveVersions(checksums[dir], versions)
+		names := make([]string, 0, len(objects))
+		for o := range objects {
+			names = append(names, o)
+		}
+		sort.Strings(names)
+
+		for len(names) > 0 {
+			batch := names[:min(len(names), batchSize)]
+			names = names[len(batch):]
+
+			got, err := p.client.RcloneChecksums(ctx, p.host, location.RemotePath(dir), batch, false)
+			if err != nil {
+				return corrupted, nil, errors.Wrapf(err, "checksum files in %s", dir)
+			}
+			for _, o := range batch {
+				ok := true
+				for _, c := range objects[o] {
+					if got[o] != c.Checksum {
+						ok = false
+						broken.Add(c.SnapshotTag)
+					}
+				}
+				if !ok {
+					corrupted++
+					p.logger.Error(ctx, "Checksum mismatch", "file", path.Join(dir, o), "checksum", got[o])
+				}
+			}
+		}
+		p.logger.Info(ctx, "Verified checksums", "dir", dir, "files", len(objects))
+	}
+
+	bs := broken.List()
+	sort.Strings(bs)
+	return corrupted, bs, nil
+}
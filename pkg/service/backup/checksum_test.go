@@ -0,0 +1,56 @@
+// Copyright (C) 2024 ScyllaDB
+
+package backup
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestResolveVersions(t *testing.T) {
+	const (
+		tag1 = "sm_20240101000000UTC"
+		tag2 = "sm_20240102000000UTC"
+		tag3 = "sm_20240103000000UTC"
+	)
+
+	cs := make(checksumSet)
+	cs.Add("dir", tag1, map[string]string{
+		"md-1-big-Data.db":  "a1",
+		"md-2-big-Data.db":  "b1",
+		"md-3-big-Data.db":  "c1",
+		"md-4-big-Index.db": "d1",
+	})
+	cs.Add("dir", tag2, map[string]string{
+		"md-1-big-Data.db": "a2",
+		"md-2-big-Data.db": "b1",
+	})
+	cs.Add("dir", tag3, map[string]string{
+		"md-1-big-Data.db": "a3",
+	})
+	cs.Add("empty", tag1, nil)
+
+	if diff := cmp.Diff([]string{"dir"}, cs.Dirs()); diff != "" {
+		t.Fatalf("Dirs() diff\n%s", diff)
+	}
+
+	versions := map[string][]string{
+		// Replaced by backups tag2 and tag3
+		"md-1-big-Data.db": {"", tag2, tag3},
+		"md-2-big-Data.db": {""},
+		"md-3-big-Data.db": {""},
+	}
+	golden := map[string][]snapshotChecksum{
+		"md-1-big-Data.db." + tag2: {{tag1, "a1"}},
+		"md-1-big-Data.db." + tag3: {{tag2, "a2"}},
+		"md-1-big-Data.db":         {{tag3, "a3"}},
+		"md-2-big-Data.db":         {{tag1, "b1"}, {tag2, "b1"}},
+		"md-3-big-Data.db":         {{tag1, "c1"}},
+	}
+
+	objects := resolveVersions(cs["dir"], versions)
+	if diff := cmp.Diff(golden, objects); diff != "" {
+		t.Fatalf("resolveVersions() diff\n%s", diff)
+	}
+}
@@ -15,6 +15,7 @@ type Config struct {
 	DiskSpaceFreeMinPercent   int           `yaml:"disk_space_free_min_percent"`
 	LongPollingTimeoutSeconds int           `yaml:"long_polling_timeout_seconds"`
 	AgeMax                    time.Duration `yaml:"age_max"`
+	SLACheckInterval          time.Duration `yaml:"sla_check_interval"`
 }
 
 func DefaultConfig() Config {
@@ -22,6 +23,7 @@ func DefaultConfig() Config {
 		DiskSpaceFreeMinPercent:   10,
 		LongPollingTimeoutSeconds: 10,
 		AgeMax:                    24 * time.Hour,
+		SLACheckInterval:          15 * time.Minute,
 	}
 }
 
@@ -37,6 +39,9 @@ func (c *Config) Validate() error {
 	if c.AgeMax < 0 {
 		err = multierr.Append(err, errors.New("invalid age_max, must be >= 0"))
 	}
+	if c.SLACheckInterval <= 0 {
+		err = multierr.Append(err, errors.New("invalid sla_check_interval, must be > 0"))
+	}
 
 	return err
 }
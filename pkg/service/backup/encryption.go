@@ -0,0 +1,125 @@
+// Copyright (C) 2024 ScyllaDB
+
+package backup
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/scylladb/scylla-manager/v3/pkg/rclone/crypt"
+	"github.com/scylladb/scylla-manager/v3/pkg/scyllaclient"
+	"github.com/scylladb/scylla-manager/v3/pkg/secrets"
+	"github.com/scylladb/scylla-manager/v3/pkg/util"
+	"github.com/scylladb/scylla-manager/v3/pkg/util/uuid"
+)
+
+// getOrCreateEncryptionKey returns backup encryption key of a cluster,
+// the key is generated on first use.
+func (s *Service) getOrCreateEncryptionKey(clusterID uuid.UUID) (crypt.Key, error) {
+	key, ok, err := secrets.GetBackupEncryptionKey(s.secretsStore, clusterID)
+	if err != nil || ok {
+		return key, err
+	}
+
+	key, err = crypt.NewKey()
+	if err != nil {
+		return key, err
+	}
+	e := secrets.BackupEncryptionKey{
+		ClusterID: clusterID,
+		Data:      key[:],
+	}
+	if err := s.secretsStore.Put(&e); err != nil {
+		return key, errors.Wrap(err, "put backup encryption key")
+	}
+	return key, nil
+}
+
+// encryptionKeyContext returns context with backup encryption key of a cluster
+// so that encrypted manifests and files can be read.
+// If the cluster has no key ctx is returned unchanged.
+func (s *Service) encryptionKeyContext(ctx context.Context, clusterID uuid.UUID) (context.Context, error) {
+	key, ok, err := secrets.GetBackupEncryptionKey(s.secretsStore, clusterID)
+	if err != nil || !ok {
+		return ctx, err
+	}
+	return scyllaclient.WithBackupEncryptionKey(ctx, key), nil
+}
+
+// EncryptionKey is backup encryption key of a cluster, Key is base64 encoded.
+type EncryptionKey struct {
+	ClusterID uuid.UUID `json:"cluster_id"`
+	ID        string    `json:"id"`
+	Key       string    `json:"key"`
+}
+
+// GetEncryptionKey returns backup encryption key of a cluster so that it can be
+// kept outside of Scylla Manager. The cluster doesn't have to be registered.
+func (s *Service) GetEncryptionKey(ctx context.Context, clusterID uuid.UUID) (EncryptionKey, error) {
+	s.logger.Debug(ctx, "GetEncryptionKey", "cluster_id", clusterID)
+
+	key, ok, err := secrets.GetBackupEncryptionKey(s.secretsStore, clusterID)
+	if err != nil {
+		return EncryptionKey{}, err
+	}
+	if !ok {
+		return EncryptionKey{}, util.ErrNotFound
+	}
+	return EncryptionKey{
+		ClusterID: clusterID,
+		ID:        key.ID(),
+		Key:       key.Encode(),
+	}, nil
+}
+
+// PutEncryptionKey imports backup encryption key of a cluster. The cluster
+// doesn't have to be registered, so that backups of a removed cluster can be
+// restored into another cluster. Key of a cluster can't be replaced with
+// a different one, backups encrypted with the old key would be lost.
+func (s *Service) PutEncryptionKey(ctx context.Context, k EncryptionKey) error {
+	s.logger.Debug(ctx, "PutEncryptionKey", "cluster_id", k.ClusterID, "id", k.ID)
+
+	if k.ClusterID == uuid.Nil {
+		return util.ErrValidate(errors.New("missing cluster ID"))
+	}
+	key, err := crypt.ParseKey(k.Key)
+	if err != nil {
+		return util.ErrValidate(err)
+	}
+	if k.ID != "" && k.ID != key.ID() {
+		return util.ErrValidate(errors.Errorf("key ID mismatch, got %s expected %s", key.ID(), k.ID))
+	}
+
+	cur, ok, err := secrets.GetBackupEncryptionKey(s.secretsStore, k.ClusterID)
+	if err != nil {
+		return err
+	}
+	if ok {
+		if cur.ID() == key.ID() {
+			return nil
+		}
+		return util.ErrValidate(errors.Errorf("cluster %s already has backup encryption key %s", k.ClusterID, cur.ID()))
+	}
+
+	e := secrets.BackupEncryptionKey{
+		ClusterID: k.ClusterID,
+		Data:      key[:],
+	}
+	if err := s.secretsStore.Put(&e); err != nil {
+		return errors.Wrap(err, "put backup encryption key")
+	}
+	s.logger.Info(ctx, "Imported backup encryption key", "cluster_id", k.ClusterID, "id", key.ID())
+	return nil
+}
+
+// DeleteEncryptionKey deletes backup encryption key of a cluster.
+// Encrypted backups of the cluster can't be restored without exported copy of the key.
+func (s *Service) DeleteEncryptionKey(ctx context.Context, clusterID uuid.UUID) error {
+	s.logger.Debug(ctx, "DeleteEncryptionKey", "cluster_id", clusterID)
+
+	if err := s.secretsStore.Delete(&secrets.BackupEncryptionKey{ClusterID: clusterID}); err != nil {
+		return errors.Wrap(err, "delete backup encryption key")
+	}
+	s.logger.Info(ctx, "Deleted backup encryption key", "cluster_id", clusterID)
+	return nil
+}
@@ -18,6 +18,7 @@ import (
 	"github.com/scylladb/scylla-manager/v3/pkg/util/inexlist/ksfilter"
 	"go.uber.org/multierr"
 
+	"github.com/scylladb/scylla-manager/v3/pkg/rclone/compress"
 	"github.com/scylladb/scylla-manager/v3/pkg/scyllaclient"
 	. "github.com/scylladb/scylla-manager/v3/pkg/service/backup/backupspec"
 	"github.com/scylladb/scylla-manager/v3/pkg/util/uuid"
@@ -25,9 +26,11 @@ import (
 
 // SnapshotInfo contains detailed information about snapshot.
 type SnapshotInfo struct {
-	SnapshotTag string `json:"snapshot_tag"`
-	Nodes       int    `json:"nodes"`
-	Size        int64  `json:"size"`
+	SnapshotTag       string `json:"snapshot_tag"`
+	Nodes             int    `json:"nodes"`
+	Size              int64  `json:"size"`
+	ParentSnapshotTag string `json:"parent_snapshot_tag,omitempty"`
+	BaseSnapshotTag   string `json:"base_snapshot_tag,omitempty"`
 }
 
 // ListItem represents contents of a snapshot within list boundaries.
@@ -42,19 +45,25 @@ type ListItem struct {
 
 // Target specifies what should be backed up and where.
 type Target struct {
-	Units            []Unit       `json:"units,omitempty"`
-	DC               []string     `json:"dc,omitempty"`
-	Location         []Location   `json:"location"`
-	Retention        int          `json:"retention"`
-	RetentionDays    int          `json:"retention_days"`
-	RetentionMap     RetentionMap `json:"-"` // policy for all tasks, injected in runtime
-	RateLimit        []DCLimit    `json:"rate_limit,omitempty"`
-	Transfers        int          `json:"transfers"`
-	SnapshotParallel []DCLimit    `json:"snapshot_parallel,omitempty"`
-	UploadParallel   []DCLimit    `json:"upload_parallel,omitempty"`
-	Continue         bool         `json:"continue,omitempty"`
-	PurgeOnly        bool         `json:"purge_only,omitempty"`
-	SkipSchema       bool         `json:"skip_schema,omitempty"`
+	Units            []Unit           `json:"units,omitempty"`
+	DC               []string         `json:"dc,omitempty"`
+	Location         []Location       `json:"location"`
+	Retention        int              `json:"retention"`
+	RetentionDays    int              `json:"retention_days"`
+	RetentionMap     RetentionMap     `json:"-"` // policy for all tasks, injected in runtime
+	RateLimit        []DCLimit        `json:"rate_limit,omitempty"`
+	RateLimitProfile []DCLimitProfile `json:"rate_limit_profile,omitempty"`
+	Transfers        int              `json:"transfers"`
+	SnapshotParallel []DCLimit        `json:"snapshot_parallel,omitempty"`
+	UploadParallel   []DCLimit        `json:"upload_parallel,omitempty"`
+	Continue         bool             `json:"continue,omitempty"`
+	PurgeOnly        bool             `json:"purge_only,omitempty"`
+	SkipSchema       bool             `json:"skip_schema,omitempty"`
+	Encrypt          bool             `json:"encrypt,omitempty"`
+	Compression      string           `json:"compression,omitempty"`
+	Incremental      bool             `json:"incremental,omitempty"`
+	FullEvery        int              `json:"full_every,omitempty"`
+	ObjectLockMode   string           `json:"object_lock_mode,omitempty"`
 
 	// LiveNodes caches node status for GetTarget GetTargetSize calls.
 	liveNodes scyllaclient.NodeStatusInfoSlice `json:"-"`
@@ -108,18 +117,20 @@ type Run struct {
 }
 
 type fileInfo struct {
-	Name string
-	Size int64
+	Name     string
+	Size     int64
+	Checksum string
 }
 
 // RunProgress describes backup progress on per file basis.
 //
 // Each RunProgress either has Uploaded or Skipped fields set to respective
-// amount of bytes. Failed shows amount of bytes that is assumed to have
-// failed. Since current implementation doesn't support resume at file level
-// this value will always be the same as Uploaded as file needs to be uploaded
-// again. In summary Failed is supposed to mean, out of uploaded bytes how much
-// bytes have to be uploaded again.
+// amount of bytes. Failed shows amount of bytes of files that failed to
+// upload. In summary Failed is supposed to mean, out of uploaded bytes how much
+// bytes have to be uploaded again. Uploads of large files to S3, GCS and Azure
+// are resumable at file level, parts of failed files that were already
+// uploaded are not uploaded again by the next attempt, and they are counted
+// as Skipped.
 type RunProgress struct {
 	ClusterID uuid.UUID
 	TaskID    uuid.UUID
@@ -135,7 +146,7 @@ type RunProgress struct {
 	Error       string
 	Size        int64 // Total file size in bytes.
 	Uploaded    int64 // Amount of total uploaded bytes.
-	Skipped     int64 // Amount of skipped bytes because file was present.
+	Skipped     int64 // Amount of skipped bytes because file or its part was present.
 	// Amount of bytes that have been uploaded but due to error have to be
 	// uploaded again.
 	Failed int64
@@ -199,19 +210,25 @@ type TableProgress struct {
 
 // taskProperties is the main data structure of the runner.Properties blob.
 type taskProperties struct {
-	Keyspace         []string     `json:"keyspace"`
-	DC               []string     `json:"dc"`
-	Location         []Location   `json:"location"`
-	Retention        *int         `json:"retention"`
-	RetentionDays    *int         `json:"retention_days"`
-	RetentionMap     RetentionMap `json:"retention_map"`
-	RateLimit        []DCLimit    `json:"rate_limit"`
-	Transfers        int          `json:"transfers"`
-	SnapshotParallel []DCLimit    `json:"snapshot_parallel"`
-	UploadParallel   []DCLimit    `json:"upload_parallel"`
-	Continue         bool         `json:"continue"`
-	PurgeOnly        bool         `json:"purge_only"`
-	SkipSchema       bool         `json:"skip_schema"`
+	Keyspace         []string         `json:"keyspace"`
+	DC               []string         `json:"dc"`
+	Location         []Location       `json:"location"`
+	Retention        *int             `json:"retention"`
+	RetentionDays    *int             `json:"retention_days"`
+	RetentionMap     RetentionMap     `json:"retention_map"`
+	RateLimit        []DCLimit        `json:"rate_limit"`
+	RateLimitProfile []DCLimitProfile `json:"rate_limit_profile"`
+	Transfers        int              `json:"transfers"`
+	SnapshotParallel []DCLimit        `json:"snapshot_parallel"`
+	UploadParallel   []DCLimit        `json:"upload_parallel"`
+	Continue         bool             `json:"continue"`
+	PurgeOnly        bool             `json:"purge_only"`
+	SkipSchema       bool             `json:"skip_schema"`
+	Encrypt          bool             `json:"encrypt"`
+	Compression      string           `json:"compression"`
+	Incremental      bool             `json:"incremental"`
+	FullEvery        int              `json:"full_every"`
+	ObjectLockMode   string           `json:"object_lock_mode"`
 }
 
 func (p taskProperties) validate(dcs []string, dcMap map[string][]string) error {
@@ -225,6 +242,27 @@ func (p taskProperties) validate(dcs []string, dcMap map[string][]string) error
 		return errors.New("transfers param has to be equal to -1 (set transfers to the value from scylla-manager-agent.yaml config) " +
 			"or greater than zero")
 	}
+	if p.Compression != "" {
+		if _, err := compress.ParseCodec(p.Compression); err != nil {
+			return err
+		}
+	}
+	if p.FullEvery < 0 {
+		return errors.New("full every param has to be greater or equal to zero")
+	}
+	if err := validateObjectLockMode(p.ObjectLockMode); err != nil {
+		return err
+	}
+	if p.ObjectLockMode != "" && p.extractRetention().RetentionDays == 0 {
+		return errors.New("object lock requires retention days policy, files are locked until snapshot is removed by retention days")
+	}
+	if p.ObjectLockMode != "" {
+		for _, l := range p.Location {
+			if l.Provider == NFS || l.Provider == SFTP {
+				return errors.Errorf("object lock is not supported by %s location %s", l.Provider, l)
+			}
+		}
+	}
 
 	// Validate location DCs
 	if err := CheckDCs(p.Location, dcMap); err != nil {
@@ -234,6 +272,10 @@ func (p taskProperties) validate(dcs []string, dcMap map[string][]string) error
 	if err := CheckDCs(p.RateLimit, dcMap); err != nil {
 		return errors.Wrap(err, "invalid rate-limit")
 	}
+	// Validate rate limit profile DCs
+	if err := CheckDCs(p.RateLimitProfile, dcMap); err != nil {
+		return errors.Wrap(err, "invalid rate-limit-profile")
+	}
 	// Validate upload parallel DCs
 	if err := CheckDCs(p.SnapshotParallel, dcMap); err != nil {
 		return errors.Wrap(err, "invalid snapshot-parallel")
@@ -263,6 +305,14 @@ func (p taskProperties) toTarget(ctx context.Context, client *scyllaclient.Clien
 		return Target{}, errors.Wrap(err, "create units")
 	}
 
+	var fullEvery int
+	if p.Incremental {
+		fullEvery = p.FullEvery
+		if fullEvery == 0 {
+			fullEvery = defaultFullEvery
+		}
+	}
+
 	return Target{
 		Units:            units,
 		DC:               dcs,
@@ -271,12 +321,18 @@ func (p taskProperties) toTarget(ctx context.Context, client *scyllaclient.Clien
 		RetentionDays:    policy.RetentionDays,
 		RetentionMap:     p.RetentionMap,
 		RateLimit:        rateLimit,
+		RateLimitProfile: FilterDCs(p.RateLimitProfile, dcs),
 		Transfers:        p.Transfers,
 		SnapshotParallel: FilterDCs(p.SnapshotParallel, dcs),
 		UploadParallel:   FilterDCs(p.UploadParallel, dcs),
 		Continue:         p.Continue,
 		PurgeOnly:        p.PurgeOnly,
 		SkipSchema:       p.SkipSchema,
+		Encrypt:          p.Encrypt,
+		Compression:      p.Compression,
+		Incremental:      p.Incremental,
+		FullEvery:        fullEvery,
+		ObjectLockMode:   p.ObjectLockMode,
 		liveNodes:        liveNodes,
 	}, nil
 }
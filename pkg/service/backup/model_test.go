@@ -54,6 +54,75 @@ func TestDCLimitMarshalUnmarshalText(t *testing.T) {
 	}
 }
 
+func TestDCLimitProfileMarshalUnmarshalText(t *testing.T) {
+	t.Parallel()
+
+	table := []struct {
+		Name      string
+		Text      string
+		Profile   DCLimitProfile
+		Timetable string
+		Error     bool
+	}{
+		{
+			Name: "with dc",
+			Text: "dc1:08:00=50 18:00=0",
+			Profile: DCLimitProfile{
+				DC:    "dc1",
+				Slots: []LimitSlot{{Start: "08:00", Limit: 50}, {Start: "18:00", Limit: 0}},
+			},
+			Timetable: "08:00,50M 18:00,off",
+		},
+		{
+			Name: "without dc",
+			Text: "08:00=50",
+			Profile: DCLimitProfile{
+				Slots: []LimitSlot{{Start: "08:00", Limit: 50}},
+			},
+			Timetable: "08:00,50M",
+		},
+		{
+			Name:  "invalid time",
+			Text:  "25:00=50",
+			Error: true,
+		},
+		{
+			Name:  "missing limit",
+			Text:  "dc1:08:00",
+			Error: true,
+		},
+	}
+
+	for i := range table {
+		test := table[i]
+
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+
+			var p DCLimitProfile
+			err := p.UnmarshalText([]byte(test.Text))
+			if test.Error {
+				if err == nil {
+					t.Fatalf("UnmarshalText(%s) expected error", test.Text)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(test.Profile, p); diff != "" {
+				t.Fatal(diff)
+			}
+			if p.String() != test.Text {
+				t.Errorf("String() = %s, expected %s", p, test.Text)
+			}
+			if v := p.Timetable(); v != test.Timetable {
+				t.Errorf("Timetable() = %s, expected %s", v, test.Timetable)
+			}
+		})
+	}
+}
+
 func TestExtractLocations(t *testing.T) {
 	t.Parallel()
 
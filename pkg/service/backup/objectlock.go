@@ -0,0 +1,109 @@
+// Copyright (C) 2024 ScyllaDB
+
+package backup
+
+import (
+	"context"
+	"net/http"
+	"path"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/scylladb/scylla-manager/v3/pkg/scyllaclient"
+	. "github.com/scylladb/scylla-manager/v3/pkg/service/backup/backupspec"
+	"github.com/scylladb/scylla-manager/v3/pkg/util/parallel"
+)
+
+// Object lock modes, see S3 Object Lock retention modes.
+// In governance mode users with special permissions can remove the lock,
+// in compliance mode nobody can remove the lock before it expires.
+const (
+	ObjectLockGovernance = "governance"
+	ObjectLockCompliance = "compliance"
+)
+
+func validateObjectLockMode(mode string) error {
+	switch mode {
+	case "", ObjectLockGovernance, ObjectLockCompliance:
+		return nil
+	default:
+		return errors.Errorf("unsupported object lock mode %q, use %s or %s", mode, ObjectLockGovernance, ObjectLockCompliance)
+	}
+}
+
+// retainUntil returns the date until files of snapshot are locked,
+// it's the time snapshot is removed by retention days policy.
+func retainUntil(snapshotTag string, retentionDays int) (time.Time, error) {
+	t, err := SnapshotTagTime(snapshotTag)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return t.AddDate(0, 0, retentionDays), nil
+}
+
+// lockFiles sets object lock retention of snapshot files uploaded from host.
+// Files shared with previous snapshots are also locked, their retention
+// is extended if needed.
+// Object lock retention is set on every object only in S3 locations,
+// GCS and Azure locations rely on bucket or container retention policies.
+func (w *worker) lockFiles(ctx context.Context, h hostInfo) error {
+	if w.ObjectLockMode == "" || h.Location.Provider != S3 {
+		return nil
+	}
+
+	dirs := w.hostSnapshotDirs(h)
+	f := func(i int) error {
+		d := dirs[i]
+		if len(d.Progress.files) == 0 {
+			return nil
+		}
+		paths := make([]string, 0, len(d.Progress.files))
+		for _, f := range d.Progress.files {
+			paths = append(paths, f.Name)
+		}
+		remoteDir := h.Location.RemotePath(w.remoteSSTableDir(h, d))
+		n, err := w.Client.RcloneSetRetention(ctx, h.IP, remoteDir, paths, w.ObjectLockMode, w.RetainUntil)
+		if err != nil {
+			return errors.Wrapf(err, "%s.%s", d.Keyspace, d.Table)
+		}
+		w.Logger.Debug(ctx, "Locked table snapshot files",
+			"host", h.IP,
+			"keyspace", d.Keyspace,
+			"table", d.Table,
+			"files", n,
+			"retain_until", w.RetainUntil,
+		)
+		return nil
+	}
+
+	return parallel.Run(len(dirs), 1, f, nil)
+}
+
+// lockManifest sets object lock retention of manifest and schema files.
+// Schema files that were not uploaded are skipped by agent.
+func (w *worker) lockManifest(ctx context.Context, h hostInfo) error {
+	if w.ObjectLockMode == "" || h.Location.Provider != S3 {
+		return nil
+	}
+
+	files := []string{
+		RemoteManifestFile(w.ClusterID, w.TaskID, w.SnapshotTag, h.DC, h.ID),
+		RemoteSchemaFile(w.ClusterID, w.TaskID, w.SnapshotTag),
+		RemoteUnsafeSchemaFile(w.ClusterID, w.TaskID, w.SnapshotTag),
+	}
+	for _, f := range files {
+		remoteDir := h.Location.RemotePath(path.Dir(f))
+		if _, err := w.Client.RcloneSetRetention(ctx, h.IP, remoteDir, []string{path.Base(f)}, w.ObjectLockMode, w.RetainUntil); err != nil {
+			return errors.Wrapf(err, "lock %s", f)
+		}
+	}
+	return nil
+}
+
+// isObjectLockedError returns true if err was caused by deleting object
+// protected by object lock, bucket retention or immutability policy.
+// Agent reports such errors with HTTP 423 Locked status,
+// see rclone.IsObjectLockedError.
+func isObjectLockedError(err error) bool {
+	return scyllaclient.StatusCodeOf(err) == http.StatusLocked
+}
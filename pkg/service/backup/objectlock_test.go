@@ -0,0 +1,94 @@
+// Copyright (C) 2024 ScyllaDB
+
+package backup
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/runtime"
+	"github.com/pkg/errors"
+	. "github.com/scylladb/scylla-manager/v3/pkg/service/backup/backupspec"
+)
+
+func TestRetainUntil(t *testing.T) {
+	tag := SnapshotTagAt(time.Date(2024, 1, 30, 12, 0, 0, 0, time.UTC))
+
+	v, err := retainUntil(tag, 7)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if golden := time.Date(2024, 2, 6, 12, 0, 0, 0, time.UTC); !v.Equal(golden) {
+		t.Fatalf("retainUntil() = %s, expected %s", v, golden)
+	}
+}
+
+func TestTaskPropertiesValidateObjectLock(t *testing.T) {
+	days := 7
+	table := []struct {
+		Name          string
+		Mode          string
+		Provider      Provider
+		RetentionDays *int
+		Error         bool
+	}{
+		{Name: "no lock", Mode: ""},
+		{Name: "governance", Mode: ObjectLockGovernance, RetentionDays: &days},
+		{Name: "compliance", Mode: ObjectLockCompliance, RetentionDays: &days},
+		{Name: "unsupported mode", Mode: "legal-hold", RetentionDays: &days, Error: true},
+		{Name: "no retention days", Mode: ObjectLockGovernance, Error: true},
+		{Name: "nfs", Mode: ObjectLockGovernance, Provider: NFS, RetentionDays: &days, Error: true},
+		{Name: "sftp no lock", Provider: SFTP},
+	}
+
+	for i := range table {
+		test := table[i]
+		t.Run(test.Name, func(t *testing.T) {
+			p := defaultTaskProperties()
+			p.Location = []Location{{Provider: S3, Path: "bucket"}}
+			if test.Provider != "" {
+				p.Location[0].Provider = test.Provider
+			}
+			p.ObjectLockMode = test.Mode
+			p.RetentionDays = test.RetentionDays
+
+			err := p.validate(nil, nil)
+			if test.Error && err == nil {
+				t.Fatal("Expected error")
+			}
+			if !test.Error && err != nil {
+				t.Fatalf("validate() error %s", err)
+			}
+		})
+	}
+}
+
+func TestIsObjectLockedError(t *testing.T) {
+	table := []struct {
+		Name   string
+		Err    error
+		Locked bool
+	}{
+		{Name: "nil"},
+		{
+			Name:   "agent locked status",
+			Err:    errors.Wrap(runtime.NewAPIError("delete paths", nil, http.StatusLocked), "delete purged files"),
+			Locked: true,
+		},
+		{
+			Name: "agent forbidden status",
+			Err:  runtime.NewAPIError("delete paths", nil, http.StatusForbidden),
+		},
+		{
+			Name: "message only",
+			Err:  errors.New("failed to delete 1 files: AccessDenied: Access Denied because object protected by object lock."),
+		},
+	}
+
+	for _, test := range table {
+		if v := isObjectLockedError(test.Err); v != test.Locked {
+			t.Errorf("%s: isObjectLockedError(%v) = %v, expected %v", test.Name, test.Err, v, test.Locked)
+		}
+	}
+}
@@ -107,17 +107,34 @@ func (p purger) PurgeSnapshotTags(ctx context.Context, manifests []*ManifestInfo
 		anyM  = manifests[0]
 		files = make(fileSet)
 		stale = 0
+		now   = timeutc.Now()
+		// Stale manifests with files locked by object lock are kept
+		locked = make(map[*ManifestInfo]*ManifestContentWithIndex)
 	)
 
 	for _, m := range manifests {
 		if tags.Has(m.SnapshotTag) {
+			c, err := p.readManifest(ctx, m)
+			if err != nil {
+				return 0, errors.Wrapf(err, "load manifest (snapshot) %s", m.Path())
+			}
+			if c.Locked(now) {
+				p.logger.Info(ctx, "Skipping locked manifest",
+					"task", m.TaskID,
+					"snapshot_tag", m.SnapshotTag,
+					"retain_until", c.RetainUntil,
+				)
+				locked[m] = c
+				continue
+			}
+
 			stale++
 			p.logger.Info(ctx, "Found manifest to remove",
 				"task", m.TaskID,
 				"snapshot_tag", m.SnapshotTag,
 				"temporary", m.Temporary,
 			)
-			if err := p.forEachDirInManifest(ctx, m, files.AddFiles); err != nil {
+			if err := c.ForEachIndexIterFiles(nil, m, files.AddFiles); err != nil {
 				return 0, errors.Wrapf(err, "load manifest (snapshot) %s", m.Path())
 			}
 		}
@@ -126,6 +143,16 @@ func (p purger) PurgeSnapshotTags(ctx context.Context, manifests []*ManifestInfo
 		return 0, nil
 	}
 	for _, m := range manifests {
+		if c, ok := locked[m]; ok {
+			if err := c.ForEachIndexIterFiles(nil, m, files.RemoveFiles); err != nil {
+				return 0, errors.Wrapf(err, "load manifest (locked) %s", m.Path())
+			}
+			// Keep versioned files that locked snapshot may refer to
+			if t, err := SnapshotTagTime(m.SnapshotTag); err == nil && t.Before(oldest) {
+				oldest = t
+			}
+			continue
+		}
 		if !tags.Has(m.SnapshotTag) {
 			if err := p.forEachDirInManifest(ctx, m, files.RemoveFiles); err != nil {
 				return 0, errors.Wrapf(err, "load manifest (no snapshot) %s", m.Path())
@@ -171,6 +198,9 @@ func (p purger) PurgeSnapshotTags(ctx context.Context, manifests []*ManifestInfo
 
 	deletedManifests := 0
 	for _, m := range manifests {
+		if _, ok := locked[m]; ok {
+			continue
+		}
 		if tags.Has(m.SnapshotTag) {
 			// Note that schema files might not be backed up in the first place
 			unsafePath := RemoteUnsafeSchemaFile(m.ClusterID, m.TaskID, m.SnapshotTag)
@@ -196,12 +226,16 @@ type ValidationResult struct {
 	ScannedFiles    int      `json:"scanned_files"`
 	BrokenSnapshots []string `json:"broken_snapshots"`
 	MissingFiles    int      `json:"missing_files"`
+	CorruptedFiles  int      `json:"corrupted_files"`
 	OrphanedFiles   int      `json:"orphaned_files"`
 	OrphanedBytes   int64    `json:"orphaned_bytes"`
 	DeletedFiles    int      `json:"deleted_files"`
 }
 
-func (p purger) Validate(ctx context.Context, manifests []*ManifestInfo, deleteOrphanedFiles bool) (ValidationResult, error) {
+// Validate checks that files of snapshots are present and finds orphaned
+// files. If verifyChecksums is set, files are read to compare checksums of
+// their content with checksums recorded in manifests.
+func (p purger) Validate(ctx context.Context, manifests []*ManifestInfo, deleteOrphanedFiles, verifyChecksums bool) (ValidationResult, error) {
 	var result ValidationResult
 
 	if len(manifests) == 0 {
@@ -214,18 +248,31 @@ func (p purger) Validate(ctx context.Context, manifests []*ManifestInfo, deleteO
 		files             = make(fileSet)
 		tempManifestFiles = make(fileSet)
 		orphanedFiles     = make(fileSet)
+		checksums         = make(checksumSet)
+		compression       string
 	)
 
 	for _, m := range manifests {
-		var f func(dir string, files []string)
-		if m.Temporary {
-			f = tempManifestFiles.AddFiles
-		} else {
-			f = files.AddFiles
+		c, err := p.readManifest(ctx, m)
+		if err == nil {
+			err = c.ForEachIndexIter(nil, func(fm FilesMeta) {
+				dir := RemoteSSTableVersionDir(m.ClusterID, m.DC, m.NodeID, fm.Keyspace, fm.Table, fm.Version)
+				if m.Temporary {
+					tempManifestFiles.AddFiles(dir, fm.Files)
+					return
+				}
+				files.AddFiles(dir, fm.Files)
+				if verifyChecksums {
+					checksums.Add(dir, m.SnapshotTag, fm.Checksums)
+				}
+			})
 		}
-		if err := p.forEachDirInManifest(ctx, m, f); err != nil {
+		if err != nil {
 			return result, errors.Wrapf(err, "load manifest (validate) %s", m.Path())
 		}
+		if c.Compression != "" {
+			compression = c.Compression
+		}
 	}
 
 	handler := func(item *scyllaclient.RcloneListDirItem) {
@@ -273,6 +320,24 @@ func (p purger) Validate(ctx context.Context, manifests []*ManifestInfo, deleteO
 		}
 	}
 
+	if verifyChecksums {
+		p.logger.Info(ctx, "Verifying checksums of files")
+		// Compressed files are recognized by header,
+		// any codec can be used to decompress them.
+		if compression != "" {
+			ctx = scyllaclient.WithBackupCompression(ctx, compression)
+		}
+		n, bs, err := p.verifyChecksums(ctx, manifests[0].Location, checksums)
+		result.CorruptedFiles = n
+		if err != nil {
+			return result, errors.Wrap(err, "verify checksums")
+		}
+		if len(bs) > 0 {
+			result.BrokenSnapshots = strset.New(append(result.BrokenSnapshots, bs...)...).List()
+			sort.Strings(result.BrokenSnapshots)
+		}
+	}
+
 	// Remove orphaned files
 	if deleteOrphanedFiles {
 		n, err := p.deleteFiles(ctx, manifests[0].Location, orphanedFiles)
@@ -326,6 +391,14 @@ func (p purger) findBrokenSnapshots(ctx context.Context, manifests []*ManifestIn
 }
 
 func (p purger) forEachDirInManifest(ctx context.Context, m *ManifestInfo, callback func(dir string, files []string)) error {
+	c, err := p.readManifest(ctx, m)
+	if err != nil {
+		return err
+	}
+	return c.ForEachIndexIterFiles(nil, m, callback)
+}
+
+func (p purger) readManifest(ctx context.Context, m *ManifestInfo) (*ManifestContentWithIndex, error) {
 	p.logger.Info(ctx, "Reading manifest",
 		"task", m.TaskID,
 		"snapshot_tag", m.SnapshotTag,
@@ -335,7 +408,7 @@ func (p purger) forEachDirInManifest(ctx context.Context, m *ManifestInfo, callb
 
 	r, err := p.client.RcloneOpen(ctx, p.host, m.Location.RemotePath(m.Path()))
 	if err != nil {
-		return err
+		return nil, err
 	}
 	defer r.Close()
 
@@ -346,10 +419,9 @@ func (p purger) forEachDirInManifest(ctx context.Context, m *ManifestInfo, callb
 	}()
 
 	if err := c.Read(r); err != nil {
-		return err
+		return nil, err
 	}
-
-	return c.ForEachIndexIterFiles(nil, m, callback)
+	return &c, nil
 }
 
 func (p purger) forEachRemoteFile(ctx context.Context, m *ManifestInfo, f func(*scyllaclient.RcloneListDirItem)) error {
@@ -387,6 +459,11 @@ func (p purger) deleteFiles(ctx context.Context, location Location, files fileSe
 		dir := dirs[i]
 		toDelete := files.DirSet(dir).List()
 		cnt, err := p.deletePaths(ctx, location.RemotePath(dir), toDelete, 1000)
+		if isObjectLockedError(err) {
+			// Files are removed by the next purge after the lock expires
+			p.logger.Info(ctx, "Skipping locked files", "dir", dir, "error", err)
+			err = nil
+		}
 		if err != nil {
 			return errors.Wrap(err, "delete purged files")
 		}
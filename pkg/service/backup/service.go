@@ -23,6 +23,7 @@ import (
 	. "github.com/scylladb/scylla-manager/v3/pkg/service/backup/backupspec"
 	"github.com/scylladb/scylla-manager/v3/pkg/service/cluster"
 	"github.com/scylladb/scylla-manager/v3/pkg/service/scheduler"
+	"github.com/scylladb/scylla-manager/v3/pkg/store"
 	"github.com/scylladb/scylla-manager/v3/pkg/util"
 	"github.com/scylladb/scylla-manager/v3/pkg/util/inexlist/dcfilter"
 	"github.com/scylladb/scylla-manager/v3/pkg/util/inexlist/ksfilter"
@@ -34,7 +35,11 @@ import (
 	"go.uber.org/atomic"
 )
 
-const defaultRateLimit = 100 // 100MiB
+const (
+	defaultRateLimit = 100 // 100MiB
+	// defaultFullEvery is the default length of chain of incremental backups.
+	defaultFullEvery = 7
+)
 
 // Service orchestrates clusterName backups.
 type Service struct {
@@ -45,13 +50,14 @@ type Service struct {
 	clusterName    cluster.NameFunc
 	scyllaClient   scyllaclient.ProviderFunc
 	clusterSession cluster.SessionFunc
+	secretsStore   store.Store
 	logger         log.Logger
 
 	dth deduplicateTestHooks
 }
 
 func NewService(session gocqlx.Session, config Config, metrics metrics.BackupMetrics,
-	clusterName cluster.NameFunc, scyllaClient scyllaclient.ProviderFunc, clusterSession cluster.SessionFunc, logger log.Logger,
+	clusterName cluster.NameFunc, scyllaClient scyllaclient.ProviderFunc, clusterSession cluster.SessionFunc, secretsStore store.Store, logger log.Logger,
 ) (*Service, error) {
 	if session.Session == nil || session.Closed() {
 		return nil, errors.New("invalid session")
@@ -76,6 +82,7 @@ func NewService(session gocqlx.Session, config Config, metrics metrics.BackupMet
 		clusterName:    clusterName,
 		scyllaClient:   scyllaClient,
 		clusterSession: clusterSession,
+		secretsStore:   secretsStore,
 		logger:         logger,
 	}, nil
 }
@@ -379,9 +386,11 @@ func (s *Service) List(ctx context.Context, clusterID uuid.UUID, locations []Loc
 		}
 		if siptr == nil {
 			ptr.SnapshotInfo = append(ptr.SnapshotInfo, SnapshotInfo{
-				SnapshotTag: mc.SnapshotTag,
-				Nodes:       1,
-				Size:        size,
+				SnapshotTag:       mc.SnapshotTag,
+				Nodes:             1,
+				Size:              size,
+				ParentSnapshotTag: mc.ParentSnapshotTag,
+				BaseSnapshotTag:   mc.BaseSnapshotTag,
 			})
 		} else {
 			siptr.Nodes++
@@ -494,9 +503,21 @@ func (s *Service) forEachManifest(ctx context.Context, clusterID uuid.UUID, loca
 	}
 	manifests = filterManifests(manifests, filter)
 
+	// Manifests of different clusters are encrypted with different keys
+	keyCtx := make(map[uuid.UUID]context.Context)
+
 	// Load manifest content
 	load := func(c *ManifestContentWithIndex, m *ManifestInfo) error {
-		r, err := client.RcloneOpen(ctx, locationHost[m.Location], m.Location.RemotePath(m.Path()))
+		mctx, ok := keyCtx[m.ClusterID]
+		if !ok {
+			mctx, err = s.encryptionKeyContext(ctx, m.ClusterID)
+			if err != nil {
+				return err
+			}
+			keyCtx[m.ClusterID] = mctx
+		}
+
+		r, err := client.RcloneOpen(mctx, locationHost[m.Location], m.Location.RemotePath(m.Path()))
 		if err != nil {
 			return err
 		}
@@ -657,7 +678,7 @@ func (s *Service) Backup(ctx context.Context, clusterID, taskID, runID uuid.UUID
 	}
 
 	// Create hostInfo for run hosts
-	hi, err := makeHostInfo(liveNodes, target.Location, target.RateLimit, target.Transfers)
+	hi, err := makeHostInfo(liveNodes, target.Location, target.RateLimit, target.RateLimitProfile, target.Transfers)
 	if err != nil {
 		return err
 	}
@@ -673,6 +694,26 @@ func (s *Service) Backup(ctx context.Context, clusterID, taskID, runID uuid.UUID
 		return errors.Wrap(err, "invalid cluster")
 	}
 
+	// Agents encrypt uploaded files and decrypt encrypted files they read
+	// when the key is set in context. Purge reads manifests of all backups
+	// so it needs the key even if this backup is not encrypted.
+	var encryptionKeyID string
+	purgeCtx := ctx
+	if target.Encrypt {
+		key, err := s.getOrCreateEncryptionKey(clusterID)
+		if err != nil {
+			return errors.Wrap(err, "initialize: get encryption key")
+		}
+		ctx = scyllaclient.WithBackupEncryptionKey(ctx, key)
+		purgeCtx = ctx
+		encryptionKeyID = key.ID()
+	} else {
+		purgeCtx, err = s.encryptionKeyContext(ctx, clusterID)
+		if err != nil {
+			return errors.Wrap(err, "initialize: get encryption key")
+		}
+	}
+
 	// Create a worker
 	w := &worker{
 		workerTools: workerTools{
@@ -685,6 +726,8 @@ func (s *Service) Backup(ctx context.Context, clusterID, taskID, runID uuid.UUID
 			Client:      client,
 		},
 		PrevStage:            run.Stage,
+		EncryptionKeyID:      encryptionKeyID,
+		Compression:          target.Compression,
 		Metrics:              s.metrics,
 		Units:                run.Units,
 		OnRunProgress:        s.putRunProgressLogError,
@@ -696,6 +739,13 @@ func (s *Service) Backup(ctx context.Context, clusterID, taskID, runID uuid.UUID
 		},
 		dth: s.dth,
 	}
+	if target.ObjectLockMode != "" {
+		w.ObjectLockMode = target.ObjectLockMode
+		w.RetainUntil, err = retainUntil(run.SnapshotTag, target.RetentionDays)
+		if err != nil {
+			return errors.Wrap(err, "initialize: get object lock retain until date")
+		}
+	}
 
 	// Map stages to worker functions
 	gaurdFunc := func() error {
@@ -715,6 +765,12 @@ func (s *Service) Backup(ctx context.Context, clusterID, taskID, runID uuid.UUID
 			return w.Index(ctx, hi, target.UploadParallel)
 		},
 		StageManifest: func() error {
+			if target.Incremental {
+				// Previous manifests might be encrypted
+				if err := w.InitChain(purgeCtx, hi, target.FullEvery); err != nil {
+					return errors.Wrap(err, "init incremental backup chain")
+				}
+			}
 			return w.UploadManifest(ctx, hi)
 		},
 		StageSchema: func() error {
@@ -724,13 +780,14 @@ func (s *Service) Backup(ctx context.Context, clusterID, taskID, runID uuid.UUID
 			return w.Deduplicate(ctx, hi, target.UploadParallel)
 		},
 		StageUpload: func() error {
-			return w.Upload(ctx, hi, target.UploadParallel)
+			// Agents compress compressible files when codec is set in context
+			return w.Upload(scyllaclient.WithBackupCompression(ctx, target.Compression), hi, target.UploadParallel)
 		},
 		StageMoveManifest: func() error {
 			return w.MoveManifest(ctx, hi)
 		},
 		StagePurge: func() error {
-			return w.Purge(ctx, hi, target.RetentionMap)
+			return w.Purge(purgeCtx, hi, target.RetentionMap)
 		},
 		StageDone: gaurdFunc,
 
@@ -1033,6 +1090,12 @@ func (s *Service) DeleteSnapshot(ctx context.Context, clusterID uuid.UUID, locat
 		return errors.Wrap(err, "get scylla client")
 	}
 
+	// Purger reads manifests that might be encrypted
+	ctx, err = s.encryptionKeyContext(ctx, clusterID)
+	if err != nil {
+		return err
+	}
+
 	// Resolve hosts for locations
 	hosts := make([]hostInfo, len(locations))
 	for i := range locations {
@@ -36,6 +36,7 @@ import (
 	"github.com/scylladb/scylla-manager/v3/pkg/scyllaclient"
 	"github.com/scylladb/scylla-manager/v3/pkg/service/backup"
 	. "github.com/scylladb/scylla-manager/v3/pkg/service/backup/backupspec"
+	"github.com/scylladb/scylla-manager/v3/pkg/store"
 	. "github.com/scylladb/scylla-manager/v3/pkg/testutils"
 	. "github.com/scylladb/scylla-manager/v3/pkg/testutils/db"
 	. "github.com/scylladb/scylla-manager/v3/pkg/testutils/testconfig"
@@ -127,6 +128,7 @@ func newTestServiceWithUser(t *testing.T, session gocqlx.Session, client *scylla
 			}
 			return CreateManagedClusterSession(t, false, client, user, pass), nil
 		},
+		store.NewTableStore(session, table.Secrets),
 		logger.Named("backup"),
 	)
 	if err != nil {
@@ -1260,7 +1262,7 @@ func TestBackupTemporaryManifestsIntegration(t *testing.T) {
 		DC:           []string{"dc1"},
 		Location:     []Location{location},
 		Retention:    1,
-		RetentionMap: backup.RetentionMap{h.TaskID: {0, 1}},
+		RetentionMap: backup.RetentionMap{h.TaskID: {RetentionDays: 0, Retention: 1}},
 	}
 
 	if err := h.service.InitTarget(ctx, h.ClusterID, &target); err != nil {
@@ -1484,7 +1486,7 @@ func TestPurgeIntegration(t *testing.T) {
 		DC:           []string{"dc1"},
 		Location:     []Location{location},
 		Retention:    1,
-		RetentionMap: map[uuid.UUID]backup.RetentionPolicy{task1: {7, 1}, task2: {7, 1}, task3: {2, 7}},
+		RetentionMap: map[uuid.UUID]backup.RetentionPolicy{task1: {RetentionDays: 7, Retention: 1}, task2: {RetentionDays: 7, Retention: 1}, task3: {RetentionDays: 2, Retention: 7}},
 	}
 
 	if err := h.service.InitTarget(ctx, h.ClusterID, &target); err != nil {
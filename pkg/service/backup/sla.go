@@ -0,0 +1,423 @@
+// Copyright (C) 2024 ScyllaDB
+
+package backup
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/scylladb/go-set/strset"
+	"github.com/scylladb/gocqlx/v2/qb"
+	"github.com/scylladb/scylla-manager/v3/pkg/schema/table"
+	"github.com/scylladb/scylla-manager/v3/pkg/scyllaclient"
+	. "github.com/scylladb/scylla-manager/v3/pkg/service/backup/backupspec"
+	"github.com/scylladb/scylla-manager/v3/pkg/service/notify"
+	"github.com/scylladb/scylla-manager/v3/pkg/util"
+	"github.com/scylladb/scylla-manager/v3/pkg/util/duration"
+	"github.com/scylladb/scylla-manager/v3/pkg/util/inexlist"
+	"github.com/scylladb/scylla-manager/v3/pkg/util/timeutc"
+	"github.com/scylladb/scylla-manager/v3/pkg/util/uuid"
+	"go.uber.org/multierr"
+)
+
+// SLAPolicy specifies that each keyspace matching the patterns must have
+// a complete backup of all its tables younger than MaxAge in each of
+// the locations.
+type SLAPolicy struct {
+	ClusterID uuid.UUID         `json:"cluster_id"`
+	Keyspace  []string          `json:"keyspace"`
+	Location  []Location        `json:"location"`
+	MaxAge    duration.Duration `json:"max_age"`
+}
+
+// Validate checks if policy is correct.
+func (p *SLAPolicy) Validate() error {
+	if p == nil {
+		return util.ErrNilPtr
+	}
+
+	var errs error
+	if p.ClusterID == uuid.Nil {
+		errs = multierr.Append(errs, errors.New("missing ClusterID"))
+	}
+	if len(p.Location) == 0 {
+		errs = multierr.Append(errs, errors.New("missing location"))
+	}
+	if p.MaxAge <= 0 {
+		errs = multierr.Append(errs, errors.New("max age must be greater than zero"))
+	}
+	for _, k := range p.Keyspace {
+		if strings.Contains(k, ".") {
+			errs = multierr.Append(errs, errors.Errorf("keyspace pattern %q must not contain table", k))
+		}
+	}
+	if _, err := inexlist.ParseInExList(p.keyspacePatterns()); err != nil {
+		errs = multierr.Append(errs, err)
+	}
+
+	return util.ErrValidate(errors.Wrap(errs, "invalid SLA policy"))
+}
+
+func (p *SLAPolicy) keyspacePatterns() []string {
+	if len(p.Keyspace) == 0 {
+		return []string{"*"}
+	}
+	return p.Keyspace
+}
+
+// SLAStatus describes compliance of a keyspace in a location.
+type SLAStatus struct {
+	Keyspace    string     `json:"keyspace"`
+	Location    Location   `json:"location"`
+	SnapshotTag string     `json:"snapshot_tag,omitempty"`
+	BackupTime  *time.Time `json:"backup_time,omitempty"`
+	Compliant   bool       `json:"compliant"`
+	Error       string     `json:"error,omitempty"`
+}
+
+// SLAReport is the result of evaluating SLA policy of a cluster.
+type SLAReport struct {
+	ClusterID uuid.UUID   `json:"cluster_id"`
+	Policy    SLAPolicy   `json:"policy"`
+	Time      time.Time   `json:"time"`
+	Compliant bool        `json:"compliant"`
+	Status    []SLAStatus `json:"status"`
+}
+
+// Violations returns statuses that are not compliant.
+func (r *SLAReport) Violations() []SLAStatus {
+	var out []SLAStatus
+	for _, s := range r.Status {
+		if !s.Compliant {
+			out = append(out, s)
+		}
+	}
+	return out
+}
+
+// slaSnapshot aggregates manifests of a snapshot in a location.
+type slaSnapshot struct {
+	nodes *strset.Set
+	dcs   *strset.Set
+	// tables maps keyspace to tables contained in the snapshot.
+	tables map[string]*strset.Set
+}
+
+func newSLASnapshot() *slaSnapshot {
+	return &slaSnapshot{
+		nodes:  strset.New(),
+		dcs:    strset.New(),
+		tables: make(map[string]*strset.Set),
+	}
+}
+
+func (s *slaSnapshot) addTable(ks, table string) {
+	if s.tables[ks] == nil {
+		s.tables[ks] = strset.New()
+	}
+	s.tables[ks].Add(table)
+}
+
+// covers returns true if snapshot contains all the tables of a keyspace.
+func (s *slaSnapshot) covers(ks string, tables []string) bool {
+	v, ok := s.tables[ks]
+	if !ok {
+		return len(tables) == 0
+	}
+	for _, t := range tables {
+		if !v.Has(t) {
+			return false
+		}
+	}
+	return true
+}
+
+// complete returns true if snapshot contains manifests of all nodes
+// of the datacenters it was taken in.
+func (s *slaSnapshot) complete(dcNodes map[string]*strset.Set) bool {
+	ok := true
+	s.dcs.Each(func(dc string) bool {
+		n, exists := dcNodes[dc]
+		if !exists || !s.nodes.IsSubset(n) {
+			ok = false
+		}
+		return ok
+	})
+	return ok
+}
+
+// evaluateSLA returns status of each keyspace in each location based on
+// the newest complete snapshot containing all the current tables of
+// the keyspace. Tables maps keyspaces to their current tables.
+func evaluateSLA(p SLAPolicy, now time.Time, tables map[string][]string, dcNodes map[string]*strset.Set,
+	snapshots map[Location]map[string]*slaSnapshot, locationErr map[Location]error,
+) []SLAStatus {
+	keyspaces := make([]string, 0, len(tables))
+	for ks := range tables {
+		keyspaces = append(keyspaces, ks)
+	}
+	sort.Strings(keyspaces)
+
+	var out []SLAStatus
+	for _, l := range p.Location {
+		if err := locationErr[l]; err != nil {
+			for _, ks := range keyspaces {
+				out = append(out, SLAStatus{Keyspace: ks, Location: l, Error: err.Error()})
+			}
+			continue
+		}
+
+		type newest struct {
+			tag string
+			t   time.Time
+		}
+		n := make(map[string]newest)
+		for tag, s := range snapshots[l] {
+			if !s.complete(dcNodes) {
+				continue
+			}
+			t, err := SnapshotTagTime(tag)
+			if err != nil {
+				continue
+			}
+			for _, ks := range keyspaces {
+				if s.covers(ks, tables[ks]) && t.After(n[ks].t) {
+					n[ks] = newest{tag: tag, t: t}
+				}
+			}
+		}
+
+		for _, ks := range keyspaces {
+			st := SLAStatus{Keyspace: ks, Location: l}
+			if v, ok := n[ks]; ok {
+				t := v.t
+				st.SnapshotTag = v.tag
+				st.BackupTime = &t
+				st.Compliant = now.Sub(t) <= p.MaxAge.Duration()
+			}
+			out = append(out, st)
+		}
+	}
+	return out
+}
+
+// GetSLAPolicy returns SLA policy of a cluster, if there is none
+// ErrNotFound is returned.
+func (s *Service) GetSLAPolicy(ctx context.Context, clusterID uuid.UUID) (*SLAPolicy, error) {
+	s.logger.Debug(ctx, "GetSLAPolicy", "cluster_id", clusterID)
+
+	p := &SLAPolicy{ClusterID: clusterID}
+	q := table.BackupSlaPolicy.GetQuery(s.session).BindStruct(p)
+	return p, q.GetRelease(p)
+}
+
+// PutSLAPolicy upserts SLA policy of a cluster.
+func (s *Service) PutSLAPolicy(ctx context.Context, p *SLAPolicy) error {
+	s.logger.Info(ctx, "PutSLAPolicy", "policy", p)
+
+	if err := p.Validate(); err != nil {
+		return err
+	}
+	return table.BackupSlaPolicy.InsertQuery(s.session).BindStruct(p).ExecRelease()
+}
+
+// DeleteSLAPolicy removes SLA policy of a cluster.
+func (s *Service) DeleteSLAPolicy(ctx context.Context, clusterID uuid.UUID) error {
+	s.logger.Info(ctx, "DeleteSLAPolicy", "cluster_id", clusterID)
+
+	p := &SLAPolicy{ClusterID: clusterID}
+	if err := table.BackupSlaPolicy.DeleteQuery(s.session).BindStruct(p).ExecRelease(); err != nil {
+		return err
+	}
+	s.metrics.ResetSLA(clusterID)
+	return nil
+}
+
+// CheckSLA evaluates SLA policy of a cluster against backup manifests
+// and updates SLA metrics.
+func (s *Service) CheckSLA(ctx context.Context, clusterID uuid.UUID) (SLAReport, error) {
+	s.logger.Info(ctx, "Checking backup SLA", "cluster_id", clusterID)
+
+	p, err := s.GetSLAPolicy(ctx, clusterID)
+	if err != nil {
+		return SLAReport{}, errors.Wrap(err, "get SLA policy")
+	}
+
+	client, err := s.scyllaClient(ctx, clusterID)
+	if err != nil {
+		return SLAReport{}, errors.Wrap(err, "get client")
+	}
+	tables, err := s.slaTables(ctx, client, p)
+	if err != nil {
+		return SLAReport{}, err
+	}
+	status, err := client.Status(ctx)
+	if err != nil {
+		return SLAReport{}, errors.Wrap(err, "get status")
+	}
+	dcNodes := make(map[string]*strset.Set)
+	for _, n := range status {
+		if dcNodes[n.Datacenter] == nil {
+			dcNodes[n.Datacenter] = strset.New()
+		}
+		dcNodes[n.Datacenter].Add(n.HostID)
+	}
+
+	snapshots := make(map[Location]map[string]*slaSnapshot)
+	locationErr := make(map[Location]error)
+	for _, l := range p.Location {
+		v := make(map[string]*slaSnapshot)
+		err := s.forEachManifest(ctx, clusterID, []Location{l}, ListFilter{ClusterID: clusterID}, func(mc ManifestInfoWithContent) error {
+			sn, ok := v[mc.SnapshotTag]
+			if !ok {
+				sn = newSLASnapshot()
+				v[mc.SnapshotTag] = sn
+			}
+			sn.nodes.Add(mc.NodeID)
+			sn.dcs.Add(mc.DC)
+			return mc.ForEachIndexIter(nil, func(fm FilesMeta) {
+				sn.addTable(fm.Keyspace, fm.Table)
+			})
+		})
+		if err != nil {
+			s.logger.Error(ctx, "Failed to read backup manifests", "location", l, "error", err)
+			locationErr[l] = err
+		}
+		snapshots[l] = v
+	}
+
+	now := timeutc.Now()
+	r := SLAReport{
+		ClusterID: clusterID,
+		Policy:    *p,
+		Time:      now,
+		Status:    evaluateSLA(*p, now, tables, dcNodes, snapshots, locationErr),
+	}
+	r.Compliant = len(r.Violations()) == 0
+
+	s.metrics.ResetSLA(clusterID)
+	for _, st := range r.Status {
+		rpo := time.Duration(-1)
+		if st.BackupTime != nil {
+			rpo = now.Sub(*st.BackupTime)
+		}
+		s.metrics.SetSLA(clusterID, st.Keyspace, st.Location.String(), st.Compliant, rpo)
+	}
+
+	return r, nil
+}
+
+// slaTables returns tables of user keyspaces matching policy.
+func (s *Service) slaTables(ctx context.Context, client *scyllaclient.Client, p *SLAPolicy) (map[string][]string, error) {
+	ks, err := client.KeyspacesByType(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "get keyspaces by type")
+	}
+	inex, err := inexlist.ParseInExList(p.keyspacePatterns())
+	if err != nil {
+		return nil, err
+	}
+	out := make(map[string][]string)
+	for _, k := range inex.Filter(ks[scyllaclient.KeyspaceTypeUser]) {
+		tables, err := client.Tables(ctx, k)
+		if err != nil {
+			return nil, errors.Wrapf(err, "keyspace %s: get tables", k)
+		}
+		out[k] = tables
+	}
+	return out, nil
+}
+
+// Notifier is informed about SLA violations.
+type Notifier interface {
+	Notify(ctx context.Context, e notify.Event)
+}
+
+// WatchSLA periodically checks SLA policies of all clusters and informs
+// notifier when a cluster stops being compliant. Each violation is reported
+// once until the cluster is compliant again.
+// It blocks until context is canceled.
+func (s *Service) WatchSLA(ctx context.Context, n Notifier) {
+	violated := make(map[uuid.UUID]bool)
+
+	ticker := time.NewTicker(s.config.SLACheckInterval)
+	defer ticker.Stop()
+	for {
+		s.checkAllSLA(ctx, n, violated)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *Service) checkAllSLA(ctx context.Context, n Notifier, violated map[uuid.UUID]bool) {
+	var clusters []uuid.UUID
+	q := qb.Select(table.BackupSlaPolicy.Name()).Columns("cluster_id").Query(s.session)
+	if err := q.SelectRelease(&clusters); err != nil {
+		s.logger.Error(ctx, "Cannot list SLA policies", "error", err)
+		return
+	}
+
+	seen := make(map[uuid.UUID]struct{})
+	for _, c := range clusters {
+		seen[c] = struct{}{}
+
+		r, err := s.CheckSLA(ctx, c)
+		if err != nil {
+			s.logger.Error(ctx, "Cannot check backup SLA", "cluster_id", c, "error", err)
+			continue
+		}
+		if r.Compliant {
+			delete(violated, c)
+			continue
+		}
+		if violated[c] {
+			continue
+		}
+		violated[c] = true
+
+		v := r.Violations()
+		s.logger.Info(ctx, "Backup SLA violated", "cluster_id", c, "violations", len(v))
+		if n != nil {
+			n.Notify(ctx, slaViolationEvent(r, v))
+		}
+	}
+
+	for c := range violated {
+		if _, ok := seen[c]; !ok {
+			delete(violated, c)
+		}
+	}
+}
+
+func slaViolationEvent(r SLAReport, v []SLAStatus) notify.Event {
+	b := new(strings.Builder)
+	for i, st := range v {
+		if i > 0 {
+			b.WriteString("; ")
+		}
+		switch {
+		case st.Error != "":
+			fmt.Fprintf(b, "%s in %s: %s", st.Keyspace, st.Location, st.Error)
+		case st.BackupTime == nil:
+			fmt.Fprintf(b, "%s in %s: no complete backup", st.Keyspace, st.Location)
+		default:
+			fmt.Fprintf(b, "%s in %s: last complete backup %s", st.Keyspace, st.Location, st.BackupTime.Format(time.RFC3339))
+		}
+	}
+
+	return notify.Event{
+		Kind:      notify.EventSLAViolation,
+		Time:      r.Time,
+		ClusterID: r.ClusterID,
+		TaskType:  "backup",
+		Cause:     b.String(),
+	}
+}
@@ -0,0 +1,112 @@
+// Copyright (C) 2024 ScyllaDB
+
+package backup
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"github.com/scylladb/go-set/strset"
+	. "github.com/scylladb/scylla-manager/v3/pkg/service/backup/backupspec"
+	"github.com/scylladb/scylla-manager/v3/pkg/util/duration"
+	"github.com/scylladb/scylla-manager/v3/pkg/util/uuid"
+)
+
+func TestEvaluateSLA(t *testing.T) {
+	now := time.Date(2024, 5, 6, 12, 0, 0, 0, time.UTC)
+	l1 := Location{Provider: S3, Path: "l1"}
+	l2 := Location{Provider: S3, Path: "l2"}
+
+	p := SLAPolicy{
+		ClusterID: uuid.MustRandom(),
+		Location:  []Location{l1, l2},
+		MaxAge:    duration.Duration(24 * time.Hour),
+	}
+	dcNodes := map[string]*strset.Set{
+		"dc1": strset.New("n1", "n2"),
+		"dc2": strset.New("n3"),
+	}
+	tables := map[string][]string{
+		"ks1": {"t1", "t2"},
+		"ks2": {"t1"},
+		"ks3": {"t1"},
+	}
+	// snapshot contains all tables of keyspaces in ks and tables in
+	// the ks.table form.
+	snapshot := func(nodes, dcs, ks []string) *slaSnapshot {
+		s := newSLASnapshot()
+		s.nodes.Add(nodes...)
+		s.dcs.Add(dcs...)
+		for _, k := range ks {
+			if name, table, ok := strings.Cut(k, "."); ok {
+				s.addTable(name, table)
+				continue
+			}
+			for _, t := range tables[k] {
+				s.addTable(k, t)
+			}
+		}
+		return s
+	}
+	tag := func(d time.Duration) string {
+		return SnapshotTagAt(now.Add(-d))
+	}
+	at := func(d time.Duration) *time.Time {
+		t := now.Add(-d)
+		return &t
+	}
+
+	snapshots := map[Location]map[string]*slaSnapshot{
+		l1: {
+			// Complete and fresh
+			tag(time.Hour): snapshot([]string{"n1", "n2"}, []string{"dc1"}, []string{"ks1"}),
+			// Complete and fresh but missing table of ks2
+			tag(2 * time.Hour): snapshot([]string{"n1", "n2"}, []string{"dc1"}, []string{"ks2.other"}),
+			// Complete and fresh but missing table of ks3
+			tag(3 * time.Hour): snapshot([]string{"n1", "n2"}, []string{"dc1"}, []string{"ks1.t1", "ks3.t2"}),
+			// Incomplete
+			tag(30 * time.Minute): snapshot([]string{"n1"}, []string{"dc1"}, []string{"ks1", "ks2"}),
+			// Complete and stale
+			tag(48 * time.Hour): snapshot([]string{"n1", "n2", "n3"}, []string{"dc1", "dc2"}, []string{"ks1", "ks2"}),
+		},
+	}
+	errAccess := errors.New("access denied")
+	locationErr := map[Location]error{
+		l2: errAccess,
+	}
+
+	golden := []SLAStatus{
+		{Keyspace: "ks1", Location: l1, SnapshotTag: tag(time.Hour), BackupTime: at(time.Hour), Compliant: true},
+		{Keyspace: "ks2", Location: l1, SnapshotTag: tag(48 * time.Hour), BackupTime: at(48 * time.Hour)},
+		{Keyspace: "ks3", Location: l1},
+		{Keyspace: "ks1", Location: l2, Error: errAccess.Error()},
+		{Keyspace: "ks2", Location: l2, Error: errAccess.Error()},
+		{Keyspace: "ks3", Location: l2, Error: errAccess.Error()},
+	}
+
+	s := evaluateSLA(p, now, tables, dcNodes, snapshots, locationErr)
+	if diff := cmp.Diff(golden, s); diff != "" {
+		t.Fatal(diff)
+	}
+}
+
+func TestSLAPolicyValidate(t *testing.T) {
+	p := SLAPolicy{
+		ClusterID: uuid.MustRandom(),
+		Keyspace:  []string{"ks.table"},
+		MaxAge:    0,
+	}
+	if err := p.Validate(); err == nil {
+		t.Fatal("Validate() expected error")
+	}
+
+	p.Keyspace = []string{"ks*", "!ks2"}
+	p.Location = []Location{{Provider: S3, Path: "bucket"}}
+	p.MaxAge = duration.Duration(time.Hour)
+	if err := p.Validate(); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -30,6 +30,7 @@ import (
 type ValidationTarget struct {
 	Location            []Location `json:"location"`
 	DeleteOrphanedFiles bool       `json:"delete_orphaned_files"`
+	VerifyChecksums     bool       `json:"verify_checksums"`
 	Parallel            int        `json:"parallel"`
 
 	liveNodes scyllaclient.NodeStatusInfoSlice
@@ -123,6 +124,8 @@ type validationRunProgress struct {
 // Validate checks that all SSTable files that are referenced in manifests are
 // present. It also checks there are no additional files that somehow leaked
 // the purging process. If it finds such files there are removed.
+// With VerifyChecksums files are read, and checksums of their content are
+// compared with checksums recorded in manifests to detect corrupted files.
 //
 // The process is based on listing all files in SSTable directories. This is
 // done in parallel, each node works with its data.
@@ -147,7 +150,13 @@ func (s *Service) Validate(ctx context.Context, clusterID, taskID, runID uuid.UU
 		}
 	}
 
-	hosts, err := makeHostInfo(target.liveNodes, target.Location, nil, 0)
+	hosts, err := makeHostInfo(target.liveNodes, target.Location, nil, nil, 0)
+	if err != nil {
+		return err
+	}
+
+	// Purger reads manifests that might be encrypted
+	ctx, err = s.encryptionKeyContext(ctx, clusterID)
 	if err != nil {
 		return err
 	}
@@ -245,7 +254,7 @@ func (s *Service) Validate(ctx context.Context, clusterID, taskID, runID uuid.UU
 				putProgress()
 			}()
 
-			v, err := p.Validate(ctx, manifests, target.DeleteOrphanedFiles)
+			v, err := p.Validate(ctx, manifests, target.DeleteOrphanedFiles, target.VerifyChecksums)
 			progress.ValidationResult = v
 
 			// Aggregate results
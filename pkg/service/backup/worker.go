@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"sync"
+	"time"
 
 	"github.com/scylladb/go-log"
 	"github.com/scylladb/scylla-manager/v3/pkg/metrics"
@@ -18,12 +19,13 @@ import (
 
 // hostInfo groups target host properties needed for backup.
 type hostInfo struct {
-	DC        string
-	IP        string
-	ID        string
-	Location  Location
-	RateLimit DCLimit
-	Transfers int
+	DC               string
+	IP               string
+	ID               string
+	Location         Location
+	RateLimit        DCLimit
+	RateLimitProfile DCLimitProfile
+	Transfers        int
 }
 
 func (h hostInfo) String() string {
@@ -65,17 +67,28 @@ type workerTools struct {
 type worker struct {
 	workerTools
 
-	PrevStage      Stage
-	Metrics        metrics.BackupMetrics
-	Units          []Unit
-	Schema         bytes.Buffer
-	SchemaFilePath string
-	OnRunProgress  func(ctx context.Context, p *RunProgress)
+	PrevStage Stage
+	Metrics   metrics.BackupMetrics
+	// EncryptionKeyID is set when uploaded files are encrypted.
+	EncryptionKeyID string
+	// Compression is the codec used for compression of uploaded files.
+	Compression string
+	// ParentSnapshotTag and BaseSnapshotTag are set for incremental backups,
+	// see ManifestContent for details.
+	ParentSnapshotTag string
+	BaseSnapshotTag   string
+	Units             []Unit
+	Schema            bytes.Buffer
+	SchemaFilePath    string
+	OnRunProgress     func(ctx context.Context, p *RunProgress)
 	// ResumeUploadProgress populates upload stats of the provided run progress
 	// with previous run progress.
 	// If there is no previous run there should be no update.
 	// It's required to provide Size as current disk size of files.
 	ResumeUploadProgress func(ctx context.Context, p *RunProgress)
+	// ObjectLockMode is set when uploaded files are locked until RetainUntil.
+	ObjectLockMode string
+	RetainUntil    time.Time
 
 	// Cache for host snapshotDirs
 	snapshotDirs map[string][]snapshotDir
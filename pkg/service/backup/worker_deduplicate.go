@@ -11,10 +11,13 @@ import (
 	"strings"
 
 	"github.com/pkg/errors"
+	"github.com/scylladb/scylla-manager/v3/pkg/rclone/compress"
+	"github.com/scylladb/scylla-manager/v3/pkg/rclone/crypt"
 	"github.com/scylladb/scylla-manager/v3/pkg/scyllaclient"
 	. "github.com/scylladb/scylla-manager/v3/pkg/service/backup/backupspec"
 	"github.com/scylladb/scylla-manager/v3/pkg/sstable"
 	"github.com/scylladb/scylla-manager/v3/pkg/util/parallel"
+	"github.com/scylladb/scylla-manager/v3/pkg/util/uuid"
 )
 
 type deduplicateTestHooks interface {
@@ -60,6 +63,14 @@ func (w *worker) deduplicateHost(ctx context.Context, h hostInfo) error {
 		return errors.Wrap(err, "set rate limit")
 	}
 
+	var compressedSizes map[string]map[string]int64
+	if w.Compression != "" {
+		var err error
+		if compressedSizes, err = w.compressedFileSizes(ctx, h); err != nil {
+			return errors.Wrap(err, "read sizes of compressed files")
+		}
+	}
+
 	dirs := w.hostSnapshotDirs(h)
 	f := func(i int) (err error) {
 		d := &dirs[i]
@@ -71,7 +82,22 @@ func (w *worker) deduplicateHost(ctx context.Context, h hostInfo) error {
 			Recurse:   true,
 		}
 		if err := w.Client.RcloneListDirIter(ctx, h.IP, dataDst, listOpts, func(f *scyllaclient.RcloneListDirItem) {
-			if err := remoteSSTableBundles.add(f.Name, f.Size); err != nil {
+			size := f.Size
+			switch {
+			case w.Compression != "" && compress.Compressible(f.Name):
+				// Files missing in manifests are not deduplicated
+				s, ok := compressedSizes[w.remoteSSTableDir(h, *d)][f.Name]
+				if !ok {
+					s = -1
+				}
+				size = s
+			case w.EncryptionKeyID != "":
+				// Remote sizes of encrypted files include encryption overhead
+				if s, err := crypt.DecryptedSize(size); err == nil {
+					size = s
+				}
+			}
+			if err := remoteSSTableBundles.add(f.Name, size); err != nil {
 				w.Logger.Error(ctx, "Couldn't create remote sstable bundle info", "file", f.Name, "error", err)
 			}
 		}); err != nil {
@@ -121,6 +147,54 @@ func (w *worker) deduplicateHost(ctx context.Context, h hostInfo) error {
 	return parallel.Run(len(dirs), 1, f, notify)
 }
 
+// compressedFileSizes returns sizes of original content of compressible files
+// by remote SSTable dir. Sizes of compressed files can't be obtained from
+// listing, they are read from the newest manifests of every task of the node.
+func (w *worker) compressedFileSizes(ctx context.Context, h hostInfo) (map[string]map[string]int64, error) {
+	manifests, err := listManifests(ctx, w.Client, h.IP, h.Location, w.ClusterID)
+	if err != nil {
+		return nil, errors.Wrap(err, "list manifests")
+	}
+	newest := make(map[uuid.UUID]*ManifestInfo)
+	for _, m := range manifests {
+		if m.NodeID != h.ID || m.Temporary || m.SnapshotTag >= w.SnapshotTag {
+			continue
+		}
+		if n, ok := newest[m.TaskID]; !ok || m.SnapshotTag > n.SnapshotTag {
+			newest[m.TaskID] = m
+		}
+	}
+
+	sizes := make(map[string]map[string]int64)
+	for _, m := range newest {
+		r, err := w.Client.RcloneOpen(ctx, h.IP, m.Location.RemotePath(m.Path()))
+		if err != nil {
+			return nil, errors.Wrapf(err, "open manifest %s", m.Path())
+		}
+		var c ManifestContentWithIndex
+		err = c.Read(r)
+		r.Close()
+		if err != nil {
+			return nil, errors.Wrapf(err, "read manifest %s", m.Path())
+		}
+		if err := c.ForEachIndexIter(nil, func(fm FilesMeta) {
+			if len(fm.Sizes) == 0 {
+				return
+			}
+			dir := RemoteSSTableVersionDir(m.ClusterID, m.DC, m.NodeID, fm.Keyspace, fm.Table, fm.Version)
+			if sizes[dir] == nil {
+				sizes[dir] = make(map[string]int64, len(fm.Sizes))
+			}
+			for name, size := range fm.Sizes {
+				sizes[dir][name] = size
+			}
+		}); err != nil {
+			return nil, errors.Wrapf(err, "read index of manifest %s", m.Path())
+		}
+	}
+	return sizes, nil
+}
+
 func (w *worker) deduplicateUUIDSStables(remoteSSTables, localSSTables *sstableBundlesByID) []fileInfo {
 	// SSTable bundle with UUID generation ID can be manually deduplicated
 	// when SSTable bundle with the same UUID is already present on the remote.
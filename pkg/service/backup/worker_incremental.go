@@ -0,0 +1,115 @@
+// Copyright (C) 2024 ScyllaDB
+
+package backup
+
+import (
+	"compress/gzip"
+	"context"
+	"encoding/json"
+
+	"github.com/pkg/errors"
+	"github.com/scylladb/go-set/strset"
+	"github.com/scylladb/scylla-manager/v3/pkg/scyllaclient"
+	. "github.com/scylladb/scylla-manager/v3/pkg/service/backup/backupspec"
+)
+
+// InitChain sets parent and base snapshot tags of incremental backup.
+// Backup is appended to the chain of the latest backup of the task
+// unless the chain already contains fullEvery backups, in that case
+// backup is a synthetic full backup starting a new chain.
+// Chain only labels backups, files are deduplicated and manifests contain
+// the complete index as for any other backup, and retention doesn't take
+// chains into account.
+func (w *worker) InitChain(ctx context.Context, hosts []hostInfo, fullEvery int) error {
+	w.ParentSnapshotTag = ""
+	w.BaseSnapshotTag = w.SnapshotTag
+
+	manifests, err := listManifestsInAllLocations(ctx, w.Client, hosts, w.ClusterID)
+	if err != nil {
+		return errors.Wrap(err, "list manifests")
+	}
+
+	var (
+		parent *ManifestInfo
+		tags   []string
+	)
+	for _, m := range manifests {
+		if m.TaskID != w.TaskID || m.Temporary || m.SnapshotTag >= w.SnapshotTag {
+			continue
+		}
+		tags = append(tags, m.SnapshotTag)
+		if parent == nil || m.SnapshotTag > parent.SnapshotTag {
+			parent = m
+		}
+	}
+	if parent == nil {
+		w.Logger.Info(ctx, "No previous backup, starting new chain of incremental backups")
+		return nil
+	}
+
+	host, ok := hostWithLocation(hosts, parent.Location)
+	if !ok {
+		return errors.Errorf("no host with access to location %s", parent.Location)
+	}
+	c, err := readManifestContent(ctx, w.Client, host, parent)
+	if err != nil {
+		return errors.Wrapf(err, "read manifest %s", parent.Path())
+	}
+	if c.BaseSnapshotTag == "" {
+		w.Logger.Info(ctx, "Previous backup is not incremental, starting new chain of incremental backups",
+			"parent", parent.SnapshotTag,
+		)
+		return nil
+	}
+
+	chain := strset.New()
+	for _, t := range tags {
+		if t >= c.BaseSnapshotTag {
+			chain.Add(t)
+		}
+	}
+	if chain.Size() >= fullEvery {
+		w.Logger.Info(ctx, "Chain of incremental backups is complete, starting new chain with synthetic full backup",
+			"base", c.BaseSnapshotTag,
+			"length", chain.Size(),
+		)
+		return nil
+	}
+
+	w.ParentSnapshotTag = parent.SnapshotTag
+	w.BaseSnapshotTag = c.BaseSnapshotTag
+	w.Logger.Info(ctx, "Appending backup to chain of incremental backups",
+		"parent", w.ParentSnapshotTag,
+		"base", w.BaseSnapshotTag,
+	)
+	return nil
+}
+
+func hostWithLocation(hosts []hostInfo, l Location) (string, bool) {
+	for _, h := range hosts {
+		if h.Location == l {
+			return h.IP, true
+		}
+	}
+	return "", false
+}
+
+// readManifestContent reads manifest content without the index.
+func readManifestContent(ctx context.Context, client *scyllaclient.Client, host string, m *ManifestInfo) (ManifestContent, error) {
+	var c ManifestContent
+
+	r, err := client.RcloneOpen(ctx, host, m.Location.RemotePath(m.Path()))
+	if err != nil {
+		return c, err
+	}
+	defer r.Close()
+
+	gr, err := gzip.NewReader(r)
+	if err != nil {
+		return c, err
+	}
+	defer gr.Close()
+
+	err = json.NewDecoder(gr).Decode(&c)
+	return c, err
+}
@@ -128,6 +128,13 @@ func (w *worker) indexSnapshotDirs(ctx context.Context, h hostInfo) ([]snapshotD
 				}
 				return nil, errors.Wrap(err, "list table")
 			}
+			if err := w.checksumFiles(ctx, h.IP, d.Path, files); err != nil {
+				w.Logger.Error(ctx, "Failed to checksum snapshot files, they won't be verified",
+					"host", h.IP,
+					"dir", d.Path,
+					"error", err,
+				)
+			}
 
 			d.Progress = &RunProgress{
 				ClusterID: w.ClusterID,
@@ -164,6 +171,27 @@ func (w *worker) indexSnapshotDirs(ctx context.Context, h hostInfo) ([]snapshotD
 	return dirs, nil
 }
 
+// checksumFiles sets checksums of files in snapshot dir. Checksums of Data
+// components are read from Digest.crc32 components written by Scylla,
+// only the remaining components are read as a whole.
+func (w *worker) checksumFiles(ctx context.Context, host, dir string, files []fileInfo) error {
+	if len(files) == 0 {
+		return nil
+	}
+	names := make([]string, len(files))
+	for i := range files {
+		names[i] = files[i].Name
+	}
+	checksums, err := w.Client.RcloneChecksums(ctx, host, dir, names, true)
+	if err != nil {
+		return err
+	}
+	for i := range files {
+		files[i].Checksum = checksums[files[i].Name]
+	}
+	return nil
+}
+
 func (w *worker) newFilesTimeThreshold() time.Time {
 	t, err := SnapshotTagTime(w.SnapshotTag)
 	if err != nil {
@@ -8,6 +8,7 @@ import (
 	"net/http"
 
 	"github.com/pkg/errors"
+	"github.com/scylladb/scylla-manager/v3/pkg/rclone/compress"
 	"github.com/scylladb/scylla-manager/v3/pkg/scyllaclient"
 	. "github.com/scylladb/scylla-manager/v3/pkg/service/backup/backupspec"
 	"github.com/scylladb/scylla-manager/v3/pkg/util/parallel"
@@ -64,16 +65,24 @@ func (w *worker) createTemporaryManifest(h hostInfo, tokens []int64) ManifestInf
 
 	c := &ManifestContentWithIndex{
 		ManifestContent: ManifestContent{
-			Version:     "v2",
-			ClusterName: w.ClusterName,
-			IP:          h.IP,
-			Tokens:      tokens,
+			Version:           "v2",
+			ClusterName:       w.ClusterName,
+			IP:                h.IP,
+			Tokens:            tokens,
+			EncryptionKeyID:   w.EncryptionKeyID,
+			Compression:       w.Compression,
+			ParentSnapshotTag: w.ParentSnapshotTag,
+			BaseSnapshotTag:   w.BaseSnapshotTag,
+			ObjectLockMode:    w.ObjectLockMode,
 		},
 		Index: make([]FilesMeta, len(dirs)),
 	}
 	if w.SchemaFilePath != "" {
 		c.Schema = w.SchemaFilePath
 	}
+	if w.ObjectLockMode != "" {
+		c.RetainUntil = &w.RetainUntil
+	}
 
 	for i, d := range dirs {
 		idx := &c.Index[i]
@@ -84,6 +93,18 @@ func (w *worker) createTemporaryManifest(h hostInfo, tokens []int64) ManifestInf
 		for _, f := range d.Progress.files {
 			idx.Files = append(idx.Files, f.Name)
 			idx.Size += f.Size
+			if f.Checksum != "" {
+				if idx.Checksums == nil {
+					idx.Checksums = make(map[string]string, len(d.Progress.files))
+				}
+				idx.Checksums[f.Name] = f.Checksum
+			}
+			if w.Compression != "" && compress.Compressible(f.Name) {
+				if idx.Sizes == nil {
+					idx.Sizes = make(map[string]int64, len(d.Progress.files))
+				}
+				idx.Sizes[f.Name] = f.Size
+			}
 		}
 		c.Size += d.Progress.Size
 	}
@@ -141,10 +162,12 @@ func (w *worker) MoveManifest(ctx context.Context, hosts []hostInfo) (err error)
 			}
 		}
 
-		if err == nil {
-			w.Logger.Info(ctx, "Done moving manifest file on host", "host", h.IP)
+		if err != nil {
+			return err
 		}
-		return err
+		w.Logger.Info(ctx, "Done moving manifest file on host", "host", h.IP)
+
+		return errors.Wrap(w.lockManifest(ctx, h), "lock manifest")
 	}
 
 	notify := func(i int, err error) {
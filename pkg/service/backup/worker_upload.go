@@ -99,7 +99,10 @@ func (w *worker) uploadHost(ctx context.Context, h hostInfo) error {
 		)
 	}
 
-	return parallel.Run(len(dirs), 1, f, notify)
+	if err := parallel.Run(len(dirs), 1, f, notify); err != nil {
+		return err
+	}
+	return errors.Wrap(w.lockFiles(ctx, h), "lock snapshot files")
 }
 
 // attachToJob returns true if previous job was found and wait procedure was
@@ -152,10 +155,23 @@ func (w *worker) snapshotJobID(ctx context.Context, d snapshotDir) int64 {
 }
 
 func (w *worker) setRateLimit(ctx context.Context, h hostInfo) error {
+	if len(h.RateLimitProfile.Slots) > 0 {
+		w.Logger.Info(ctx, "Setting rate limit profile", "host", h.IP, "profile", h.RateLimitProfile)
+		return w.Client.RcloneSetBandwidthProfile(ctx, h.IP, h.RateLimitProfile.Timetable())
+	}
 	w.Logger.Info(ctx, "Setting rate limit", "host", h.IP, "limit", h.RateLimit.Limit)
 	return w.Client.RcloneSetBandwidthLimit(ctx, h.IP, h.RateLimit.Limit)
 }
 
+// uploadRateLimit returns rate limit of upload jobs, if rate limit profile
+// is set the limit set by setRateLimit is kept, so that agent can switch it.
+func (h hostInfo) uploadRateLimit() int {
+	if len(h.RateLimitProfile.Slots) > 0 {
+		return scyllaclient.KeepRateLimit
+	}
+	return h.RateLimit.Limit
+}
+
 func (w *worker) uploadSnapshotDir(ctx context.Context, h hostInfo, d snapshotDir) error {
 	w.Logger.Info(ctx, "Uploading table snapshot",
 		"host", h.IP,
@@ -186,7 +202,7 @@ func (w *worker) uploadSnapshotDir(ctx context.Context, h hostInfo, d snapshotDi
 
 func (w *worker) uploadDataDir(ctx context.Context, hi hostInfo, dst, src string, d snapshotDir) error {
 	// Ensure file versioning during upload
-	id, err := w.Client.RcloneMoveDir(ctx, d.Host, hi.Transfers, hi.RateLimit.Limit, dst, src, VersionedFileExt(w.SnapshotTag))
+	id, err := w.Client.RcloneMoveDir(ctx, d.Host, hi.Transfers, hi.uploadRateLimit(), dst, src, VersionedFileExt(w.SnapshotTag))
 	if err != nil {
 		return err
 	}
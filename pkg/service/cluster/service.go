@@ -525,6 +525,8 @@ func (s *Service) validateHostsConnectivity(ctx context.Context, c *Cluster) err
 }
 
 // DeleteCluster removes cluster and it's secrets.
+// Backup encryption key is kept, it's needed to restore encrypted backups
+// of the cluster, and must be deleted explicitly with backup service.
 func (s *Service) DeleteCluster(ctx context.Context, clusterID uuid.UUID) error {
 	s.logger.Debug(ctx, "DeleteCluster", "cluster_id", clusterID)
 
@@ -536,7 +538,7 @@ func (s *Service) DeleteCluster(ctx context.Context, clusterID uuid.UUID) error
 		return err
 	}
 
-	if err := s.secretsStore.DeleteAll(clusterID); err != nil {
+	if err := s.deleteSecrets(clusterID); err != nil {
 		s.logger.Error(ctx, "Failed to delete cluster secrets",
 			"cluster_id", clusterID,
 			"error", err,
@@ -549,6 +551,13 @@ func (s *Service) DeleteCluster(ctx context.Context, clusterID uuid.UUID) error
 	return s.notifyChangeListener(ctx, Change{ID: clusterID, Type: Delete})
 }
 
+func (s *Service) deleteSecrets(clusterID uuid.UUID) error {
+	if err := s.secretsStore.Delete(&secrets.CQLCreds{ClusterID: clusterID}); err != nil {
+		return err
+	}
+	return s.secretsStore.Delete(&secrets.TLSIdentity{ClusterID: clusterID})
+}
+
 // CheckCQLCredentials checks if associated CQLCreds exist in secrets store.
 func (s *Service) CheckCQLCredentials(id uuid.UUID) (bool, error) {
 	credentials := secrets.CQLCreds{
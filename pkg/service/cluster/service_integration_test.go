@@ -23,6 +23,7 @@ import (
 	"github.com/scylladb/scylla-manager/v3/pkg/util"
 
 	"github.com/scylladb/scylla-manager/v3/pkg/metrics"
+	"github.com/scylladb/scylla-manager/v3/pkg/rclone/crypt"
 	"github.com/scylladb/scylla-manager/v3/pkg/schema/table"
 	"github.com/scylladb/scylla-manager/v3/pkg/scyllaclient"
 	"github.com/scylladb/scylla-manager/v3/pkg/secrets"
@@ -422,6 +423,30 @@ func TestServiceStorageIntegration(t *testing.T) {
 		}
 	})
 
+	t.Run("delete cluster keeps backup encryption key", func(t *testing.T) {
+		setup(t)
+
+		c := validCluster()
+		c.ID = uuid.Nil
+
+		if err := s.PutCluster(ctx, c); err != nil {
+			t.Fatal(err)
+		}
+		key := &secrets.BackupEncryptionKey{
+			ClusterID: c.ID,
+			Data:      make([]byte, crypt.KeySize),
+		}
+		if err := secretsStore.Put(key); err != nil {
+			t.Fatal(err)
+		}
+		if err := s.DeleteCluster(ctx, c.ID); err != nil {
+			t.Fatal(err)
+		}
+		if _, ok, err := secrets.GetBackupEncryptionKey(secretsStore, c.ID); err != nil || !ok {
+			t.Fatal("GetBackupEncryptionKey() expected key", ok, err)
+		}
+	})
+
 	t.Run("delete CQL credentials", func(t *testing.T) {
 		setup(t)
 
@@ -0,0 +1,56 @@
+// Copyright (C) 2024 ScyllaDB
+
+package leader
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/scylladb/scylla-manager/v3/pkg/util"
+	"go.uber.org/multierr"
+)
+
+// Config specifies the leader election configuration.
+type Config struct {
+	// Enabled allows for running multiple instances against the same manager
+	// keyspace. Only the elected leader schedules and runs tasks.
+	Enabled bool `yaml:"enabled"`
+	// InstanceID identifies the instance holding the lease, it's reported
+	// to clients calling followers. If empty, hostname with a random suffix
+	// is used.
+	InstanceID string `yaml:"instance_id"`
+	// LeaseTTL specifies after how long the lease expires if the leader
+	// stops renewing it.
+	LeaseTTL time.Duration `yaml:"lease_ttl"`
+	// RenewInterval specifies how often the leader renews the lease and
+	// followers try to acquire it.
+	RenewInterval time.Duration `yaml:"renew_interval"`
+}
+
+func DefaultConfig() Config {
+	return Config{
+		LeaseTTL:      30 * time.Second,
+		RenewInterval: 10 * time.Second,
+	}
+}
+
+func (c *Config) Validate() error {
+	if c == nil {
+		return util.ErrNilPtr
+	}
+	if !c.Enabled {
+		return nil
+	}
+
+	var err error
+	if c.LeaseTTL < time.Second {
+		err = multierr.Append(err, errors.New("invalid lease_ttl, must be >= 1s"))
+	}
+	if c.RenewInterval <= 0 {
+		err = multierr.Append(err, errors.New("invalid renew_interval, must be > 0"))
+	}
+	if 2*c.RenewInterval > c.LeaseTTL {
+		err = multierr.Append(err, errors.New("invalid renew_interval, must be at most half of lease_ttl"))
+	}
+	return err
+}
@@ -0,0 +1,220 @@
+// Copyright (C) 2024 ScyllaDB
+
+package leader
+
+import (
+	"context"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/scylladb/go-log"
+	"github.com/scylladb/gocqlx/v2"
+	"github.com/scylladb/gocqlx/v2/qb"
+	"github.com/scylladb/scylla-manager/v3/pkg/schema/table"
+	"github.com/scylladb/scylla-manager/v3/pkg/util/timeutc"
+	"github.com/scylladb/scylla-manager/v3/pkg/util/uuid"
+)
+
+// leaseName is the name of the lease held by the instance running tasks.
+const leaseName = "scheduler"
+
+// lease is a row in leader_lease table, it's inserted with TTL and expires
+// if not renewed by the holder.
+type lease struct {
+	Name   string
+	Holder string
+}
+
+// Service elects the leader among Scylla Manager instances sharing the same
+// keyspace. Leadership is a lease in the database that is acquired and renewed
+// with lightweight transactions.
+type Service struct {
+	config  Config
+	session gocqlx.Session
+	logger  log.Logger
+	id      string
+
+	mu     sync.Mutex
+	leader bool
+}
+
+func NewService(config Config, session gocqlx.Session, logger log.Logger) (*Service, error) {
+	if err := config.Validate(); err != nil {
+		return nil, errors.Wrap(err, "invalid config")
+	}
+	if session.Session == nil || session.Closed() {
+		return nil, errors.New("invalid session")
+	}
+
+	id := config.InstanceID
+	if id == "" {
+		hostname, err := os.Hostname()
+		if err != nil {
+			return nil, errors.Wrap(err, "get hostname")
+		}
+		id = hostname + "-" + uuid.MustRandom().String()[0:8]
+	}
+
+	return &Service{
+		config:  config,
+		session: session,
+		logger:  logger,
+		id:      id,
+	}, nil
+}
+
+// ID returns identifier of this instance.
+func (s *Service) ID() string {
+	return s.id
+}
+
+// IsLeader returns true if this instance holds the lease.
+func (s *Service) IsLeader() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.leader
+}
+
+func (s *Service) setLeader(v bool) {
+	s.mu.Lock()
+	s.leader = v
+	s.mu.Unlock()
+}
+
+// Leader returns identifier of the instance holding the lease.
+// If there is no leader, ErrNotFound is returned.
+func (s *Service) Leader(ctx context.Context) (string, error) {
+	l := lease{Name: leaseName}
+	q := table.LeaderLease.GetQuery(s.session).WithContext(ctx).BindStruct(&l)
+	if err := q.GetRelease(&l); err != nil {
+		return "", err
+	}
+	return l.Holder, nil
+}
+
+// Run campaigns for leadership until ctx is canceled.
+// When the lease is acquired elected is called with a context that is
+// canceled when leadership is lost. If elected returns an error or the lease
+// can't be renewed, demoted is called and the instance becomes a follower.
+// Run does not call demoted when ctx is canceled, the caller shall stop
+// running tasks and call Release.
+func (s *Service) Run(ctx context.Context, elected func(ctx context.Context) error, demoted func(ctx context.Context)) {
+	s.logger.Info(ctx, "Campaigning for leadership", "instance_id", s.id)
+
+	t := time.NewTicker(s.config.RenewInterval)
+	defer t.Stop()
+
+	for {
+		ok, err := s.acquire(ctx)
+		if err != nil {
+			s.logger.Info(ctx, "Failed to acquire leader lease", "error", err)
+		}
+		if ok {
+			s.lead(ctx, t, elected, demoted)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-t.C:
+		}
+	}
+}
+
+func (s *Service) lead(ctx context.Context, t *time.Ticker, elected func(ctx context.Context) error, demoted func(ctx context.Context)) {
+	s.logger.Info(ctx, "Elected leader", "instance_id", s.id)
+	s.setLeader(true)
+
+	lctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	demote := func() {
+		cancel()
+		s.setLeader(false)
+		demoted(log.CopyTraceID(context.Background(), ctx))
+	}
+
+	if err := elected(lctx); err != nil {
+		s.logger.Error(ctx, "Failed to start as leader, stepping down", "error", err)
+		demote()
+		s.Release(ctx)
+		return
+	}
+
+	lastRenew := timeutc.Now()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-t.C:
+		}
+
+		ok, err := s.renew(ctx)
+		switch {
+		case err != nil:
+			if ctx.Err() != nil {
+				return
+			}
+			// Lease may expire before next renewal
+			if timeutc.Since(lastRenew)+s.config.RenewInterval >= s.config.LeaseTTL {
+				s.logger.Error(ctx, "Failed to renew leader lease, stepping down", "error", err)
+				demote()
+				return
+			}
+			s.logger.Info(ctx, "Failed to renew leader lease, retrying", "error", err)
+		case !ok:
+			s.logger.Error(ctx, "Leader lease taken over by other instance, stepping down")
+			demote()
+			return
+		default:
+			lastRenew = timeutc.Now()
+		}
+	}
+}
+
+// acquire inserts lease if it does not exist. If lease is already held by
+// this instance, i.e. after restart with configured instance ID, it's renewed.
+func (s *Service) acquire(ctx context.Context) (bool, error) {
+	l := lease{Name: leaseName, Holder: s.id}
+	q := table.LeaderLease.InsertBuilder().Unique().TTL(s.config.LeaseTTL).
+		Query(s.session).
+		WithContext(ctx).
+		BindStruct(&l)
+
+	var cur lease
+	ok, err := q.GetCASRelease(&cur)
+	if err != nil {
+		return false, err
+	}
+	if !ok && cur.Holder == s.id {
+		return s.renew(ctx)
+	}
+	return ok, nil
+}
+
+func (s *Service) renew(ctx context.Context) (bool, error) {
+	q := table.LeaderLease.UpdateBuilder("holder").
+		TTL(s.config.LeaseTTL).
+		If(qb.EqNamed("holder", "holder")).
+		Query(s.session).
+		WithContext(ctx).
+		BindStruct(&lease{Name: leaseName, Holder: s.id})
+	return q.ExecCASRelease()
+}
+
+// Release removes the lease if it's held by this instance so that other
+// instance can take over without waiting for the lease to expire.
+func (s *Service) Release(ctx context.Context) {
+	s.setLeader(false)
+
+	q := table.LeaderLease.DeleteBuilder().
+		If(qb.EqNamed("holder", "holder")).
+		Query(s.session).
+		WithContext(context.WithoutCancel(ctx)).
+		BindStruct(&lease{Name: leaseName, Holder: s.id})
+	if _, err := q.ExecCASRelease(); err != nil {
+		s.logger.Info(ctx, "Failed to release leader lease", "error", err)
+	}
+}
@@ -0,0 +1,120 @@
+// Copyright (C) 2024 ScyllaDB
+
+//go:build all || integration
+// +build all integration
+
+package leader_test
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/scylladb/go-log"
+	"github.com/scylladb/scylla-manager/v3/pkg/service/leader"
+	. "github.com/scylladb/scylla-manager/v3/pkg/testutils/db"
+)
+
+func TestServiceElectionIntegration(t *testing.T) {
+	session := CreateScyllaManagerDBSession(t)
+
+	newService := func(id string) *leader.Service {
+		c := leader.DefaultConfig()
+		c.Enabled = true
+		c.InstanceID = id
+		c.LeaseTTL = 2 * time.Second
+		c.RenewInterval = 200 * time.Millisecond
+		s, err := leader.NewService(c, session, log.NewDevelopment().Named(id))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return s
+	}
+
+	type instance struct {
+		*leader.Service
+		elected int32
+		demoted int32
+		cancel  context.CancelFunc
+		done    chan struct{}
+	}
+	run := func(id string) *instance {
+		ctx, cancel := context.WithCancel(context.Background())
+		i := &instance{Service: newService(id), cancel: cancel, done: make(chan struct{})}
+		go func() {
+			defer close(i.done)
+			i.Run(ctx,
+				func(ctx context.Context) error {
+					atomic.AddInt32(&i.elected, 1)
+					return nil
+				},
+				func(ctx context.Context) {
+					atomic.AddInt32(&i.demoted, 1)
+				},
+			)
+		}()
+		return i
+	}
+	waitFor := func(f func() bool) {
+		t.Helper()
+		deadline := time.Now().Add(10 * time.Second)
+		for !f() {
+			if time.Now().After(deadline) {
+				t.Fatal("Timeout")
+			}
+			time.Sleep(50 * time.Millisecond)
+		}
+	}
+
+	a := run("a")
+	waitFor(a.IsLeader)
+	b := run("b")
+
+	t.Run("follower does not take over", func(t *testing.T) {
+		time.Sleep(time.Second)
+		if b.IsLeader() {
+			t.Fatal("Expected b to be follower")
+		}
+		l, err := b.Leader(context.Background())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if l != "a" {
+			t.Fatalf("Leader() = %s, expected a", l)
+		}
+	})
+
+	t.Run("follower takes over released lease", func(t *testing.T) {
+		a.cancel()
+		<-a.done
+		a.Release(context.Background())
+
+		waitFor(b.IsLeader)
+		if atomic.LoadInt32(&b.elected) != 1 {
+			t.Fatalf("elected called %d times, expected 1", b.elected)
+		}
+		if atomic.LoadInt32(&a.demoted) != 0 {
+			t.Fatalf("demoted called %d times on shutdown, expected 0", a.demoted)
+		}
+	})
+
+	t.Run("follower takes over expired lease", func(t *testing.T) {
+		// Stop b without releasing lease
+		b.cancel()
+		<-b.done
+
+		c := run("c")
+		defer func() {
+			c.cancel()
+			<-c.done
+			c.Release(context.Background())
+		}()
+
+		start := time.Now()
+		waitFor(c.IsLeader)
+		if time.Since(start) < time.Second {
+			t.Fatalf("Lease taken over after %s, expected to wait for lease to expire", time.Since(start))
+		}
+	})
+}
@@ -0,0 +1,136 @@
+// Copyright (C) 2024 ScyllaDB
+
+package notify
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/scylladb/scylla-manager/v3/pkg/util"
+	"go.uber.org/multierr"
+)
+
+// SinkType specifies how notifications are delivered.
+type SinkType string
+
+const (
+	// SinkWebhook sends event as JSON in HTTP POST request.
+	SinkWebhook SinkType = "webhook"
+	// SinkSlack sends message in Slack incoming webhook format.
+	SinkSlack SinkType = "slack"
+	// SinkEmail sends message via SMTP.
+	SinkEmail SinkType = "email"
+)
+
+// SMTPConfig specifies SMTP server used by email sink.
+type SMTPConfig struct {
+	Host     string   `yaml:"host"`
+	Port     int      `yaml:"port"`
+	Username string   `yaml:"username"`
+	Password string   `yaml:"password"`
+	From     string   `yaml:"from"`
+	To       []string `yaml:"to"`
+}
+
+// SinkConfig specifies a single notification sink.
+type SinkConfig struct {
+	// Name is used to select the sink in task notification settings.
+	Name string   `yaml:"name"`
+	Type SinkType `yaml:"type"`
+	// Events limits the kinds of events sent to the sink, all kinds if empty.
+	Events []EventKind `yaml:"events"`
+	// AllTasks enables the sink for all tasks, otherwise the sink is used
+	// only for tasks that select it by name.
+	AllTasks bool `yaml:"all_tasks"`
+	// URL of webhook and slack sinks.
+	URL     string            `yaml:"url"`
+	Headers map[string]string `yaml:"headers"`
+	// SMTP configuration of email sink.
+	SMTP SMTPConfig `yaml:"smtp"`
+}
+
+// Config specifies the notification service configuration.
+type Config struct {
+	// Timeout of a single delivery attempt.
+	Timeout time.Duration `yaml:"timeout"`
+	// Retries specifies how many times failed delivery is retried
+	// before notification is written to dead letter log.
+	Retries int `yaml:"retries"`
+	// RetryWait is the initial exponential backoff between delivery attempts.
+	RetryWait time.Duration `yaml:"retry_wait"`
+	// StaleAfter is the default time after which a scheduled task that has
+	// not succeeded triggers stale notification, zero disables the check.
+	StaleAfter time.Duration `yaml:"stale_after"`
+	// StaleCheckInterval specifies how often tasks are checked for staleness.
+	StaleCheckInterval time.Duration `yaml:"stale_check_interval"`
+	Sinks              []SinkConfig  `yaml:"sinks"`
+}
+
+func DefaultConfig() Config {
+	return Config{
+		Timeout:            10 * time.Second,
+		Retries:            3,
+		RetryWait:          10 * time.Second,
+		StaleCheckInterval: 10 * time.Minute,
+	}
+}
+
+func (c *Config) Validate() error {
+	if c == nil {
+		return util.ErrNilPtr
+	}
+
+	var err error
+	if c.Timeout <= 0 {
+		err = multierr.Append(err, errors.New("invalid timeout, must be > 0"))
+	}
+	if c.Retries < 0 {
+		err = multierr.Append(err, errors.New("invalid retries, must be >= 0"))
+	}
+	if c.RetryWait <= 0 {
+		err = multierr.Append(err, errors.New("invalid retry_wait, must be > 0"))
+	}
+	if c.StaleAfter < 0 {
+		err = multierr.Append(err, errors.New("invalid stale_after, must be >= 0"))
+	}
+	if c.StaleCheckInterval <= 0 {
+		err = multierr.Append(err, errors.New("invalid stale_check_interval, must be > 0"))
+	}
+
+	names := make(map[string]struct{}, len(c.Sinks))
+	for i, s := range c.Sinks {
+		if s.Name == "" {
+			err = multierr.Append(err, errors.Errorf("sinks[%d]: missing name", i))
+		}
+		if _, ok := names[s.Name]; ok {
+			err = multierr.Append(err, errors.Errorf("sinks[%d]: duplicated name %s", i, s.Name))
+		}
+		names[s.Name] = struct{}{}
+
+		switch s.Type {
+		case SinkWebhook, SinkSlack:
+			if s.URL == "" {
+				err = multierr.Append(err, errors.Errorf("sinks[%d]: missing url", i))
+			}
+		case SinkEmail:
+			if s.SMTP.Host == "" || s.SMTP.Port <= 0 {
+				err = multierr.Append(err, errors.Errorf("sinks[%d]: missing smtp host or port", i))
+			}
+			if s.SMTP.From == "" || len(s.SMTP.To) == 0 {
+				err = multierr.Append(err, errors.Errorf("sinks[%d]: missing smtp from or to", i))
+			}
+		default:
+			err = multierr.Append(err, errors.Errorf("sinks[%d]: invalid type value %s", i, s.Type))
+		}
+
+		for _, k := range s.Events {
+			switch k {
+			case EventError, EventAborted, EventRecovered, EventStale, EventSLAViolation:
+			default:
+				err = multierr.Append(err, errors.Errorf("sinks[%d]: invalid event value %s", i, k))
+			}
+		}
+	}
+
+	return err
+}
@@ -0,0 +1,128 @@
+// Copyright (C) 2024 ScyllaDB
+
+package notify
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/scylladb/scylla-manager/v3/pkg/util/uuid"
+)
+
+// EventKind specifies why notification is sent.
+type EventKind string
+
+const (
+	// EventError is sent when task run ends with ERROR status.
+	EventError EventKind = "error"
+	// EventAborted is sent when task run ends with ABORTED status.
+	EventAborted EventKind = "aborted"
+	// EventRecovered is sent when task run succeeds after a failed run.
+	EventRecovered EventKind = "recovered"
+	// EventStale is sent when scheduled task has not succeeded for longer
+	// than the configured time.
+	EventStale EventKind = "stale"
+	// EventSLAViolation is sent when cluster backups stop meeting
+	// the backup SLA policy.
+	EventSLAViolation EventKind = "sla_violation"
+)
+
+// Event describes task run outcome that requires attention.
+type Event struct {
+	Kind        EventKind  `json:"kind"`
+	Time        time.Time  `json:"time"`
+	ClusterID   uuid.UUID  `json:"cluster_id"`
+	ClusterName string     `json:"cluster_name,omitempty"`
+	TaskType    string     `json:"task_type"`
+	TaskID      uuid.UUID  `json:"task_id"`
+	TaskName    string     `json:"task_name,omitempty"`
+	RunID       uuid.UUID  `json:"run_id"`
+	Status      string     `json:"status,omitempty"`
+	Cause       string     `json:"cause,omitempty"`
+	StartTime   *time.Time `json:"start_time,omitempty"`
+	EndTime     *time.Time `json:"end_time,omitempty"`
+	LastSuccess *time.Time `json:"last_success,omitempty"`
+	// Sinks selected by task in addition to sinks enabled for all tasks.
+	Sinks []string `json:"-"`
+}
+
+// Task returns task identifier in the form used by sctool.
+func (e Event) Task() string {
+	if e.TaskName != "" {
+		return e.TaskType + "/" + e.TaskName
+	}
+	return e.TaskType + "/" + e.TaskID.String()
+}
+
+func (e Event) cluster() string {
+	if e.ClusterName != "" {
+		return e.ClusterName
+	}
+	return e.ClusterID.String()
+}
+
+// Subject returns one line summary of the event.
+func (e Event) Subject() string {
+	if e.Kind == EventSLAViolation {
+		return fmt.Sprintf("Scylla Manager backup SLA on cluster %s violated", e.cluster())
+	}
+
+	var what string
+	switch e.Kind {
+	case EventError:
+		what = "failed"
+	case EventAborted:
+		what = "was aborted"
+	case EventRecovered:
+		what = "succeeded after failure"
+	case EventStale:
+		what = "has not succeeded recently"
+	default:
+		what = string(e.Kind)
+	}
+	return fmt.Sprintf("Scylla Manager task %s on cluster %s %s", e.Task(), e.cluster(), what)
+}
+
+// Message returns human readable description of the event.
+func (e Event) Message() string {
+	return e.Subject() + "\n" + e.details()
+}
+
+func (e Event) details() string {
+	b := new(strings.Builder)
+	if e.RunID != uuid.Nil {
+		fmt.Fprintf(b, "Run: %s\n", e.RunID)
+	}
+	if e.Status != "" {
+		fmt.Fprintf(b, "Status: %s\n", e.Status)
+	}
+	if e.Cause != "" {
+		fmt.Fprintf(b, "Cause: %s\n", e.Cause)
+	}
+	if e.StartTime != nil {
+		fmt.Fprintf(b, "Start time: %s\n", e.StartTime.Format(time.RFC3339))
+	}
+	if e.EndTime != nil {
+		fmt.Fprintf(b, "End time: %s\n", e.EndTime.Format(time.RFC3339))
+	}
+	if e.Kind == EventStale {
+		if e.LastSuccess != nil {
+			fmt.Fprintf(b, "Last success: %s\n", e.LastSuccess.Format(time.RFC3339))
+		} else {
+			b.WriteString("Last success: never\n")
+		}
+	}
+	return b.String()
+}
+
+// deadLetter is notification that could not be delivered.
+type deadLetter struct {
+	ClusterID uuid.UUID
+	ID        uuid.UUID
+	Sink      string
+	Kind      EventKind
+	Payload   []byte
+	Attempts  int
+	Error     string
+}
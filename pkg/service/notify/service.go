@@ -0,0 +1,216 @@
+// Copyright (C) 2024 ScyllaDB
+
+package notify
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/scylladb/go-log"
+	"github.com/scylladb/gocqlx/v2"
+	"github.com/scylladb/scylla-manager/v3/pkg/schema/table"
+	"github.com/scylladb/scylla-manager/v3/pkg/service/cluster"
+	"github.com/scylladb/scylla-manager/v3/pkg/util/retry"
+	"github.com/scylladb/scylla-manager/v3/pkg/util/slice"
+	"github.com/scylladb/scylla-manager/v3/pkg/util/timeutc"
+	"github.com/scylladb/scylla-manager/v3/pkg/util/uuid"
+)
+
+// namedSink is sink with its configuration.
+type namedSink struct {
+	SinkConfig
+	sink
+}
+
+// Service delivers notifications about task run outcomes to configured sinks.
+// Notifications are delivered in background, failed deliveries are retried
+// and eventually written to dead letter log.
+type Service struct {
+	config      Config
+	session     gocqlx.Session
+	clusterName cluster.NameFunc
+	logger      log.Logger
+
+	sinks  []namedSink
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	// mu guards closed so that deliveries are not added to wg after Close
+	// started waiting for it.
+	mu     sync.Mutex
+	closed bool
+	wg     sync.WaitGroup
+}
+
+func NewService(config Config, session gocqlx.Session, clusterName cluster.NameFunc, logger log.Logger) (*Service, error) {
+	if err := config.Validate(); err != nil {
+		return nil, errors.Wrap(err, "invalid config")
+	}
+	if session.Session == nil || session.Closed() {
+		return nil, errors.New("invalid session")
+	}
+
+	return newService(config, session, clusterName, logger), nil
+}
+
+func newService(config Config, session gocqlx.Session, clusterName cluster.NameFunc, logger log.Logger) *Service {
+	client := &http.Client{
+		Transport: http.DefaultTransport,
+	}
+	sinks := make([]namedSink, 0, len(config.Sinks))
+	for _, c := range config.Sinks {
+		sinks = append(sinks, namedSink{SinkConfig: c, sink: newSink(c, client)})
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+
+	return &Service{
+		config:      config,
+		session:     session,
+		clusterName: clusterName,
+		logger:      logger,
+		sinks:       sinks,
+		ctx:         ctx,
+		cancel:      cancel,
+	}
+}
+
+// Notify sends event to all sinks enabled for it, it does not wait for
+// the delivery to finish.
+func (s *Service) Notify(ctx context.Context, e Event) {
+	sinks := s.selectSinks(ctx, e)
+	if len(sinks) == 0 {
+		return
+	}
+
+	if e.Time.IsZero() {
+		e.Time = timeutc.Now()
+	}
+	if e.ClusterName == "" && s.clusterName != nil {
+		name, err := s.clusterName(ctx, e.ClusterID)
+		if err != nil {
+			s.logger.Info(ctx, "Failed to get cluster name", "cluster_id", e.ClusterID, "error", err)
+		} else {
+			e.ClusterName = name
+		}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closed {
+		s.logger.Info(ctx, "Service is closed, notification dropped", "event", e)
+		return
+	}
+
+	dctx := log.CopyTraceID(s.ctx, ctx)
+	for i := range sinks {
+		ns := sinks[i]
+		s.wg.Add(1)
+		go func() {
+			defer s.wg.Done()
+			s.deliver(dctx, ns, e)
+		}()
+	}
+}
+
+// selectSinks returns sinks that are enabled for all tasks or selected by
+// the event task, and accept the event kind.
+func (s *Service) selectSinks(ctx context.Context, e Event) []namedSink {
+	for _, name := range e.Sinks {
+		if !slice.Contains(len(s.sinks), func(i int) bool { return s.sinks[i].Name == name }) {
+			s.logger.Error(ctx, "Unknown notification sink", "sink", name, "task", e.Task())
+		}
+	}
+
+	var out []namedSink
+	for _, ns := range s.sinks {
+		if !ns.AllTasks && !slice.ContainsString(e.Sinks, ns.Name) {
+			continue
+		}
+		if len(ns.Events) > 0 && !slice.Contains(len(ns.Events), func(i int) bool { return ns.Events[i] == e.Kind }) {
+			continue
+		}
+		out = append(out, ns)
+	}
+	return out
+}
+
+func (s *Service) deliver(ctx context.Context, ns namedSink, e Event) {
+	logger := s.logger.With("sink", ns.Name, "kind", e.Kind, "task", e.Task())
+
+	payload, err := ns.payload(e)
+	if err != nil {
+		logger.Error(ctx, "Failed to encode notification", "error", err)
+		return
+	}
+
+	attempts, err := s.send(ctx, ns, payload)
+	if err != nil {
+		logger.Error(ctx, "Failed to deliver notification, writing it to dead letter log",
+			"attempts", attempts,
+			"error", err,
+		)
+		s.putDeadLetter(ctx, &deadLetter{
+			ClusterID: e.ClusterID,
+			ID:        uuid.NewTime(),
+			Sink:      ns.Name,
+			Kind:      e.Kind,
+			Payload:   payload,
+			Attempts:  attempts,
+			Error:     err.Error(),
+		})
+		return
+	}
+	logger.Info(ctx, "Notification delivered", "attempts", attempts)
+}
+
+// send delivers payload to sink retrying on failure, it returns the number
+// of delivery attempts.
+func (s *Service) send(ctx context.Context, ns namedSink, payload []byte) (int, error) {
+	attempts := 0
+	op := func() error {
+		attempts++
+		sctx, cancel := context.WithTimeout(ctx, s.config.Timeout)
+		defer cancel()
+		return ns.send(sctx, payload)
+	}
+	notify := func(err error, wait time.Duration) {
+		s.logger.Info(ctx, "Failed to deliver notification, retrying",
+			"sink", ns.Name,
+			"wait", wait,
+			"error", err,
+		)
+	}
+	err := retry.WithNotify(ctx, op, s.backoff(), notify)
+	return attempts, err
+}
+
+func (s *Service) backoff() retry.Backoff {
+	b := retry.NewExponentialBackoff(s.config.RetryWait, 0, 10*s.config.RetryWait, 2, 0.1)
+	return retry.WithMaxRetries(b, uint64(s.config.Retries))
+}
+
+func (s *Service) putDeadLetter(ctx context.Context, d *deadLetter) {
+	// Writing to dead letter log must succeed even if service is closing
+	q := table.NotificationDeadLetter.InsertQuery(s.session).WithContext(context.WithoutCancel(ctx)).BindStruct(d)
+	if err := q.ExecRelease(); err != nil {
+		s.logger.Error(ctx, "Failed to write notification to dead letter log",
+			"sink", d.Sink,
+			"payload", string(d.Payload),
+			"error", err,
+		)
+	}
+}
+
+// Close cancels pending deliveries and waits for them to be written
+// to dead letter log.
+func (s *Service) Close() {
+	s.mu.Lock()
+	s.closed = true
+	s.mu.Unlock()
+
+	s.cancel()
+	s.wg.Wait()
+}
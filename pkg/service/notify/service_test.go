@@ -0,0 +1,179 @@
+// Copyright (C) 2024 ScyllaDB
+
+package notify
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/scylladb/go-log"
+	"github.com/scylladb/gocqlx/v2"
+	"github.com/scylladb/scylla-manager/v3/pkg/testutils"
+	"github.com/scylladb/scylla-manager/v3/pkg/util/uuid"
+)
+
+func TestServiceSelectSinks(t *testing.T) {
+	t.Parallel()
+
+	c := DefaultConfig()
+	c.Sinks = []SinkConfig{
+		{Name: "all", Type: SinkWebhook, URL: "http://localhost", AllTasks: true},
+		{Name: "all-errors", Type: SinkWebhook, URL: "http://localhost", AllTasks: true, Events: []EventKind{EventError}},
+		{Name: "selected", Type: SinkSlack, URL: "http://localhost"},
+		{Name: "other", Type: SinkSlack, URL: "http://localhost"},
+	}
+	s := newService(c, gocqlx.Session{}, nil, log.NewDevelopment())
+
+	testCases := []struct {
+		Name     string
+		Event    Event
+		Expected []string
+	}{
+		{
+			Name:     "error",
+			Event:    Event{Kind: EventError},
+			Expected: []string{"all", "all-errors"},
+		},
+		{
+			Name:     "stale",
+			Event:    Event{Kind: EventStale},
+			Expected: []string{"all"},
+		},
+		{
+			Name:     "selected by task",
+			Event:    Event{Kind: EventRecovered, Sinks: []string{"selected", "unknown"}},
+			Expected: []string{"all", "selected"},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+
+			var names []string
+			for _, ns := range s.selectSinks(context.Background(), tc.Event) {
+				names = append(names, ns.Name)
+			}
+			if diff := cmp.Diff(tc.Expected, names); diff != "" {
+				t.Fatal(diff)
+			}
+		})
+	}
+}
+
+func TestServiceSendWebhook(t *testing.T) {
+	t.Parallel()
+
+	var (
+		calls int32
+		got   Event
+		auth  string
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&calls, 1) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		auth = r.Header.Get("Authorization")
+		b, _ := io.ReadAll(r.Body)
+		if err := json.Unmarshal(b, &got); err != nil {
+			t.Error(err)
+		}
+	}))
+	defer srv.Close()
+
+	c := DefaultConfig()
+	c.RetryWait = time.Millisecond
+	c.Sinks = []SinkConfig{
+		{Name: "webhook", Type: SinkWebhook, URL: srv.URL, Headers: map[string]string{"Authorization": "Bearer secret"}},
+	}
+	s := newService(c, gocqlx.Session{}, nil, log.NewDevelopment())
+
+	e := Event{
+		Kind:      EventError,
+		Time:      time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		ClusterID: uuid.MustRandom(),
+		TaskType:  "backup",
+		TaskID:    uuid.MustRandom(),
+		RunID:     uuid.MustRandom(),
+		Status:    "ERROR",
+		Cause:     "boom",
+	}
+	ns := s.sinks[0]
+	payload, err := ns.payload(e)
+	if err != nil {
+		t.Fatal(err)
+	}
+	attempts, err := s.send(context.Background(), ns, payload)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if attempts != 2 {
+		t.Fatalf("send() attempts = %d, expected 2", attempts)
+	}
+	if auth != "Bearer secret" {
+		t.Fatalf("Authorization = %q, expected configured header", auth)
+	}
+	if diff := cmp.Diff(e, got, testutils.UUIDComparer()); diff != "" {
+		t.Fatal(diff)
+	}
+}
+
+func TestServiceSendRetriesExhausted(t *testing.T) {
+	t.Parallel()
+
+	var calls int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer srv.Close()
+
+	c := DefaultConfig()
+	c.Retries = 2
+	c.RetryWait = time.Millisecond
+	c.Sinks = []SinkConfig{
+		{Name: "slack", Type: SinkSlack, URL: srv.URL},
+	}
+	s := newService(c, gocqlx.Session{}, nil, log.NewDevelopment())
+
+	attempts, err := s.send(context.Background(), s.sinks[0], []byte("{}"))
+	if err == nil {
+		t.Fatal("send() expected error")
+	}
+	if attempts != 3 || atomic.LoadInt32(&calls) != 3 {
+		t.Fatalf("send() attempts = %d, calls = %d, expected 3", attempts, calls)
+	}
+}
+
+func TestServiceNotifyAfterClose(t *testing.T) {
+	t.Parallel()
+
+	var calls int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+	}))
+	defer srv.Close()
+
+	c := DefaultConfig()
+	c.Sinks = []SinkConfig{
+		{Name: "webhook", Type: SinkWebhook, URL: srv.URL, AllTasks: true},
+	}
+	s := newService(c, gocqlx.Session{}, nil, log.NewDevelopment())
+	s.Close()
+
+	s.Notify(context.Background(), Event{Kind: EventError, ClusterName: "test"})
+	s.wg.Wait()
+
+	if n := atomic.LoadInt32(&calls); n != 0 {
+		t.Fatalf("Sink called %d times after Close, expected 0", n)
+	}
+}
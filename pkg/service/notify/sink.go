@@ -0,0 +1,158 @@
+// Copyright (C) 2024 ScyllaDB
+
+package notify
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/smtp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/scylladb/scylla-manager/v3/pkg/util/timeutc"
+)
+
+// sink delivers encoded notification payload.
+type sink interface {
+	// payload encodes event in the format expected by sink.
+	payload(e Event) ([]byte, error)
+	// send delivers payload, it must respect context deadline.
+	send(ctx context.Context, payload []byte) error
+}
+
+func newSink(c SinkConfig, client *http.Client) sink {
+	switch c.Type {
+	case SinkWebhook:
+		return webhookSink{config: c, client: client}
+	case SinkSlack:
+		return slackSink{webhookSink{config: c, client: client}}
+	case SinkEmail:
+		return emailSink{config: c.SMTP}
+	default:
+		panic("unknown sink type " + c.Type)
+	}
+}
+
+// webhookSink sends event as JSON in HTTP POST request.
+type webhookSink struct {
+	config SinkConfig
+	client *http.Client
+}
+
+func (s webhookSink) payload(e Event) ([]byte, error) {
+	return json.Marshal(e)
+}
+
+func (s webhookSink) send(ctx context.Context, payload []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.config.URL, bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for k, v := range s.config.Headers {
+		req.Header.Set(k, v)
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 512)) // nolint: errcheck
+		return errors.Errorf("unexpected status %s: %s", resp.Status, strings.TrimSpace(string(body)))
+	}
+	return nil
+}
+
+// slackSink sends message in Slack incoming webhook format.
+type slackSink struct {
+	webhookSink
+}
+
+func (s slackSink) payload(e Event) ([]byte, error) {
+	return json.Marshal(struct {
+		Text string `json:"text"`
+	}{
+		Text: "*" + e.Subject() + "*\n" + e.details(),
+	})
+}
+
+// emailSink sends plain text message via SMTP.
+// STARTTLS is used if supported by server.
+type emailSink struct {
+	config SMTPConfig
+}
+
+func (s emailSink) payload(e Event) ([]byte, error) {
+	b := new(bytes.Buffer)
+	fmt.Fprintf(b, "From: %s\r\n", s.config.From)
+	fmt.Fprintf(b, "To: %s\r\n", strings.Join(s.config.To, ", "))
+	fmt.Fprintf(b, "Subject: %s\r\n", e.Subject())
+	fmt.Fprintf(b, "Date: %s\r\n", timeutc.Now().Format(time.RFC1123Z))
+	b.WriteString("MIME-Version: 1.0\r\n")
+	b.WriteString("Content-Type: text/plain; charset=UTF-8\r\n")
+	b.WriteString("\r\n")
+	b.WriteString(strings.ReplaceAll(e.Message(), "\n", "\r\n"))
+	return b.Bytes(), nil
+}
+
+func (s emailSink) send(ctx context.Context, payload []byte) error {
+	addr := net.JoinHostPort(s.config.Host, strconv.Itoa(s.config.Port))
+	var d net.Dialer
+	conn, err := d.DialContext(ctx, "tcp", addr)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	if deadline, ok := ctx.Deadline(); ok {
+		if err := conn.SetDeadline(deadline); err != nil {
+			return err
+		}
+	}
+
+	c, err := smtp.NewClient(conn, s.config.Host)
+	if err != nil {
+		return err
+	}
+	defer c.Close()
+
+	if ok, _ := c.Extension("STARTTLS"); ok {
+		if err := c.StartTLS(&tls.Config{ServerName: s.config.Host, MinVersion: tls.VersionTLS12}); err != nil {
+			return errors.Wrap(err, "starttls")
+		}
+	}
+	if s.config.Username != "" {
+		if err := c.Auth(smtp.PlainAuth("", s.config.Username, s.config.Password, s.config.Host)); err != nil {
+			return errors.Wrap(err, "auth")
+		}
+	}
+	if err := c.Mail(s.config.From); err != nil {
+		return errors.Wrap(err, "mail")
+	}
+	for _, to := range s.config.To {
+		if err := c.Rcpt(to); err != nil {
+			return errors.Wrapf(err, "rcpt %s", to)
+		}
+	}
+	w, err := c.Data()
+	if err != nil {
+		return errors.Wrap(err, "data")
+	}
+	if _, err := w.Write(payload); err != nil {
+		return errors.Wrap(err, "data")
+	}
+	if err := w.Close(); err != nil {
+		return errors.Wrap(err, "data")
+	}
+	return c.Quit()
+}
@@ -0,0 +1,83 @@
+// Copyright (C) 2024 ScyllaDB
+
+package notify
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/scylladb/scylla-manager/v3/pkg/util/uuid"
+)
+
+func TestSlackSinkPayload(t *testing.T) {
+	t.Parallel()
+
+	start := time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)
+	e := Event{
+		Kind:        EventError,
+		ClusterName: "prod",
+		TaskType:    "repair",
+		TaskID:      uuid.MustRandom(),
+		TaskName:    "nightly",
+		RunID:       uuid.MustRandom(),
+		Status:      "ERROR",
+		Cause:       "host down",
+		StartTime:   &start,
+	}
+
+	b, err := newSink(SinkConfig{Type: SinkSlack}, nil).payload(e)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var v struct {
+		Text string `json:"text"`
+	}
+	if err := json.Unmarshal(b, &v); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, s := range []string{
+		"*Scylla Manager task repair/nightly on cluster prod failed*\n",
+		"Run: " + e.RunID.String(),
+		"Cause: host down",
+		"Start time: 2024-01-01T10:00:00Z",
+	} {
+		if !strings.Contains(v.Text, s) {
+			t.Errorf("payload text %q does not contain %q", v.Text, s)
+		}
+	}
+}
+
+func TestEmailSinkPayload(t *testing.T) {
+	t.Parallel()
+
+	e := Event{
+		Kind:      EventStale,
+		ClusterID: uuid.MustRandom(),
+		TaskType:  "backup",
+		TaskID:    uuid.MustRandom(),
+	}
+	c := SinkConfig{
+		Type: SinkEmail,
+		SMTP: SMTPConfig{From: "manager@example.com", To: []string{"a@example.com", "b@example.com"}},
+	}
+
+	b, err := newSink(c, nil).payload(e)
+	if err != nil {
+		t.Fatal(err)
+	}
+	msg := string(b)
+	for _, s := range []string{
+		"From: manager@example.com\r\n",
+		"To: a@example.com, b@example.com\r\n",
+		"Subject: Scylla Manager task backup/" + e.TaskID.String() + " on cluster " + e.ClusterID.String() + " has not succeeded recently\r\n",
+		"\r\n\r\n",
+		"Last success: never\r\n",
+	} {
+		if !strings.Contains(msg, s) {
+			t.Errorf("message %q does not contain %q", msg, s)
+		}
+	}
+}
@@ -0,0 +1,269 @@
+// Copyright (C) 2024 ScyllaDB
+
+package repair
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/scylladb/go-set/strset"
+	"github.com/scylladb/gocqlx/v2"
+	"github.com/scylladb/gocqlx/v2/qb"
+	"github.com/scylladb/scylla-manager/v3/pkg/schema/table"
+	"github.com/scylladb/scylla-manager/v3/pkg/util/query"
+	"github.com/scylladb/scylla-manager/v3/pkg/util/uuid"
+)
+
+// tombstoneGC describes tombstone garbage collection settings of a table.
+type tombstoneGC struct {
+	// Mode is the table's tombstone_gc mode, 'timeout' by default.
+	Mode    string
+	GCGrace time.Duration
+}
+
+// HasDeadline returns true if tombstones are garbage collected after
+// gc_grace_seconds regardless of repair. Such table needs to be repaired
+// within gc_grace_seconds in order to avoid data resurrection.
+func (gc tombstoneGC) HasDeadline() bool {
+	return gc.Mode == "timeout" && gc.GCGrace > 0
+}
+
+// adaptiveSort orders plan by tables' tombstone_gc deadlines, so that tables
+// closest to their deadline are repaired first. Deadline is based on table's
+// gc_grace_seconds and the time of its last successful repair done by the task.
+// It also updates "tombstone_gc_deadline_timestamp_seconds" metric and returns
+// tombstone_gc settings of repaired tables.
+func (s *Service) adaptiveSort(ctx context.Context, clusterID, taskID uuid.UUID, p *plan) (map[string]tombstoneGC, error) {
+	clusterSession, err := s.clusterSession(ctx, clusterID)
+	if err != nil {
+		return nil, errors.Wrap(err, "get CQL cluster session")
+	}
+	defer clusterSession.Close()
+
+	gcs := make(map[string]tombstoneGC)
+	for _, kp := range p.Keyspaces {
+		if err := getKeyspaceTombstoneGC(clusterSession, kp.Keyspace, gcs); err != nil {
+			return nil, errors.Wrapf(err, "get tombstone_gc of keyspace %s", kp.Keyspace)
+		}
+	}
+	deadlines, err := s.updateTombstoneGCDeadlines(clusterID, taskID, p, gcs)
+	if err != nil {
+		return nil, err
+	}
+
+	p.PrioritySort(newDeadlineTablePreference(deadlines))
+	// Ensure that internal tables and base tables are still repaired first
+	p.PrioritySort(NewInternalTablePreference())
+	views, err := query.GetAllViews(clusterSession)
+	if err != nil {
+		return nil, errors.Wrap(err, "get cluster views")
+	}
+	p.ViewSort(views)
+	return gcs, nil
+}
+
+// updateTombstoneGCDeadlines returns tombstone_gc deadlines of tables and
+// exports them as "tombstone_gc_deadline_timestamp_seconds" metric.
+// It's called when the repair starts and when it ends, so that tables
+// repaired by the run get their new deadlines.
+func (s *Service) updateTombstoneGCDeadlines(clusterID, taskID uuid.UUID, p *plan, gcs map[string]tombstoneGC) (map[string]time.Time, error) {
+	tables := strset.New()
+	for _, kp := range p.Keyspaces {
+		for _, tp := range kp.Tables {
+			if key := kp.Keyspace + "." + tp.Table; gcs[key].HasDeadline() {
+				tables.Add(key)
+			}
+		}
+	}
+	lastRepair, err := s.lastSuccessfulRepair(clusterID, taskID, tables)
+	if err != nil {
+		return nil, errors.Wrap(err, "get last successful repair of tables")
+	}
+	deadlines := tombstoneGCDeadlines(p, gcs, lastRepair)
+	for _, kp := range p.Keyspaces {
+		for _, tp := range kp.Tables {
+			if d, ok := deadlines[kp.Keyspace+"."+tp.Table]; ok {
+				s.metrics.SetTombstoneGCDeadline(clusterID, kp.Keyspace, tp.Table, d)
+			}
+		}
+	}
+	return deadlines, nil
+}
+
+// tombstoneGCDeadlines returns deadlines of tables with tombstone_gc deadline
+// based on time of their last successful repair. Deadline of table without
+// known repair is zero, as it's the most urgent one.
+func tombstoneGCDeadlines(p *plan, gcs map[string]tombstoneGC, lastRepair map[string]time.Time) map[string]time.Time {
+	deadlines := make(map[string]time.Time)
+	for _, kp := range p.Keyspaces {
+		for _, tp := range kp.Tables {
+			key := kp.Keyspace + "." + tp.Table
+			gc := gcs[key]
+			if !gc.HasDeadline() {
+				continue
+			}
+			last, ok := lastRepair[key]
+			if !ok {
+				deadlines[key] = time.Time{}
+				continue
+			}
+			deadlines[key] = last.Add(gc.GCGrace)
+		}
+	}
+	return deadlines
+}
+
+// getKeyspaceTombstoneGC fills gcs with tombstone_gc settings of keyspace tables.
+func getKeyspaceTombstoneGC(session gocqlx.Session, keyspace string, gcs map[string]tombstoneGC) error {
+	q := qb.Select("system_schema.tables").
+		Columns("table_name", "gc_grace_seconds", "extensions").
+		Where(qb.Eq("keyspace_name")).
+		Query(session).
+		Bind(keyspace)
+	defer q.Release()
+
+	var (
+		name    string
+		gcGrace int
+		ext     map[string]string
+	)
+	iter := q.Iter()
+	for iter.Scan(&name, &gcGrace, &ext) {
+		gcs[keyspace+"."+name] = tombstoneGC{
+			Mode:    tombstoneGCMode(ext),
+			GCGrace: time.Duration(gcGrace) * time.Second,
+		}
+		ext = nil
+	}
+	return iter.Close()
+}
+
+// tombstoneGCMode parses tombstone_gc mode from table's extensions.
+func tombstoneGCMode(ext map[string]string) string {
+	mode, ok := ext["tombstone_gc"]
+	if !ok {
+		return "timeout"
+	}
+	for _, m := range []string{"disabled", "timeout", "repair", "immediate"} {
+		if strings.Contains(mode, m) {
+			return m
+		}
+	}
+	return mode
+}
+
+// lastSuccessfulRepair returns time of the last successful repair of tables
+// done by the task. Table is successfully repaired in a run when all of its
+// token ranges were repaired without errors on all hosts.
+// Runs are read from the newest one, and reading stops when all tables have
+// a successful repair. Repairs done by other tasks, restore or nodetool are
+// not taken into account, neither are runs removed from the database after
+// their TTL, so the returned time is never later than the actual one.
+func (s *Service) lastSuccessfulRepair(clusterID, taskID uuid.UUID, tables *strset.Set) (map[string]time.Time, error) {
+	out := make(map[string]time.Time)
+	if tables.IsEmpty() {
+		return out, nil
+	}
+
+	// Runs are clustered by ID in descending order
+	q := qb.Select(table.RepairRun.Name()).Columns("id").Where(
+		qb.Eq("cluster_id"),
+		qb.Eq("task_id"),
+	).Query(s.session).BindMap(qb.M{
+		"cluster_id": clusterID,
+		"task_id":    taskID,
+	})
+	defer q.Release()
+
+	var (
+		id   uuid.UUID
+		iter = q.Iter()
+	)
+	for len(out) < tables.Size() && iter.Scan(&id) {
+		q := qb.Select(table.RepairRunProgress.Name()).Where(
+			qb.Eq("cluster_id"),
+			qb.Eq("task_id"),
+			qb.Eq("run_id"),
+		).Query(s.session).BindMap(qb.M{
+			"cluster_id": clusterID,
+			"task_id":    taskID,
+			"run_id":     id,
+		})
+		var rps []*RunProgress
+		if err := q.SelectRelease(&rps); err != nil {
+			iter.Close() // nolint: errcheck
+			return nil, errors.Wrapf(err, "get progress of run %s", id)
+		}
+		for k, v := range successfullyRepairedTables(rps) {
+			if _, ok := out[k]; !ok && tables.Has(k) {
+				out[k] = v
+			}
+		}
+	}
+	if err := iter.Close(); err != nil {
+		return nil, errors.Wrap(err, "get runs")
+	}
+	return out, nil
+}
+
+// successfullyRepairedTables returns completion time of tables successfully repaired in a run.
+func successfullyRepairedTables(rps []*RunProgress) map[string]time.Time {
+	var (
+		out    = make(map[string]time.Time)
+		failed = make(map[string]struct{})
+	)
+	for _, rp := range rps {
+		key := rp.Keyspace + "." + rp.Table
+		if rp.Error > 0 || !rp.Completed() || !isTimeSet(rp.CompletedAt) {
+			failed[key] = struct{}{}
+			continue
+		}
+		if rp.CompletedAt.After(out[key]) {
+			out[key] = *rp.CompletedAt
+		}
+	}
+	for k := range failed {
+		delete(out, k)
+	}
+	return out
+}
+
+// deadlineTablePreference orders tables by their tombstone_gc deadlines.
+// Tables without deadline are ordered last.
+type deadlineTablePreference struct {
+	tables    map[string]time.Time
+	keyspaces map[string]time.Time
+}
+
+func newDeadlineTablePreference(deadlines map[string]time.Time) TablePreference {
+	ks := make(map[string]time.Time)
+	for k, d := range deadlines {
+		keyspace, _, _ := strings.Cut(k, ".")
+		if cur, ok := ks[keyspace]; !ok || d.Before(cur) {
+			ks[keyspace] = d
+		}
+	}
+	return deadlineTablePreference{
+		tables:    deadlines,
+		keyspaces: ks,
+	}
+}
+
+func (dp deadlineTablePreference) KSLess(ks1, ks2 string) bool {
+	return deadlineLess(dp.keyspaces, ks1, ks2)
+}
+
+func (dp deadlineTablePreference) TLess(ks, t1, t2 string) bool {
+	return deadlineLess(dp.tables, ks+"."+t1, ks+"."+t2)
+}
+
+func deadlineLess(deadlines map[string]time.Time, k1, k2 string) bool {
+	d1, ok1 := deadlines[k1]
+	d2, ok2 := deadlines[k2]
+	if ok1 && ok2 {
+		return d1.Before(d2)
+	}
+	return ok1 && !ok2
+}
@@ -0,0 +1,118 @@
+// Copyright (C) 2024 ScyllaDB
+
+package repair
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestDeadlinePrioritySort(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	deadlines := map[string]time.Time{
+		"ks1.t1": now.Add(10 * time.Hour),
+		"ks1.t2": now.Add(time.Hour),
+		"ks2.t1": now.Add(5 * time.Hour),
+		"ks2.t3": {},
+	}
+
+	p := &plan{
+		Keyspaces: keyspacePlans{
+			{Keyspace: "ks0", Tables: []tablePlan{{Table: "t1"}}},
+			{Keyspace: "ks1", Tables: []tablePlan{{Table: "t0"}, {Table: "t1"}, {Table: "t2"}}},
+			{Keyspace: "ks2", Tables: []tablePlan{{Table: "t1"}, {Table: "t2"}, {Table: "t3"}}},
+			{Keyspace: "system_auth", Tables: []tablePlan{{Table: "roles"}}},
+		},
+	}
+	p.PrioritySort(newDeadlineTablePreference(deadlines))
+	p.PrioritySort(NewInternalTablePreference())
+
+	var got []string
+	for _, kp := range p.Keyspaces {
+		for _, tp := range kp.Tables {
+			got = append(got, kp.Keyspace+"."+tp.Table)
+		}
+	}
+	expected := []string{
+		"system_auth.roles",
+		"ks2.t3", "ks2.t1", "ks2.t2",
+		"ks1.t2", "ks1.t1", "ks1.t0",
+		"ks0.t1",
+	}
+	if diff := cmp.Diff(expected, got); diff != "" {
+		t.Fatal(diff)
+	}
+}
+
+func TestTombstoneGCDeadlines(t *testing.T) {
+	last := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	gcGrace := 10 * 24 * time.Hour
+
+	p := &plan{
+		Keyspaces: keyspacePlans{
+			{Keyspace: "ks", Tables: []tablePlan{
+				{Table: "repaired"},
+				{Table: "never_repaired"},
+				{Table: "repair_mode"},
+				{Table: "no_gc_grace"},
+			}},
+		},
+	}
+	gcs := map[string]tombstoneGC{
+		"ks.repaired":       {Mode: "timeout", GCGrace: gcGrace},
+		"ks.never_repaired": {Mode: "timeout", GCGrace: gcGrace},
+		"ks.repair_mode":    {Mode: "repair", GCGrace: gcGrace},
+		"ks.no_gc_grace":    {Mode: "timeout"},
+	}
+	lastRepair := map[string]time.Time{
+		"ks.repaired":    last,
+		"ks.repair_mode": last,
+	}
+
+	expected := map[string]time.Time{
+		"ks.repaired":       last.Add(gcGrace),
+		"ks.never_repaired": {},
+	}
+	if diff := cmp.Diff(expected, tombstoneGCDeadlines(p, gcs, lastRepair)); diff != "" {
+		t.Fatal(diff)
+	}
+}
+
+func TestSuccessfullyRepairedTables(t *testing.T) {
+	t1 := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	t2 := t1.Add(time.Hour)
+
+	rps := []*RunProgress{
+		{Host: "h1", Keyspace: "ks", Table: "ok", TokenRanges: 10, Success: 10, CompletedAt: &t1},
+		{Host: "h2", Keyspace: "ks", Table: "ok", TokenRanges: 10, Success: 10, CompletedAt: &t2},
+		{Host: "h1", Keyspace: "ks", Table: "error", TokenRanges: 10, Success: 9, Error: 1, CompletedAt: &t1},
+		{Host: "h2", Keyspace: "ks", Table: "error", TokenRanges: 10, Success: 10, CompletedAt: &t1},
+		{Host: "h1", Keyspace: "ks", Table: "running", TokenRanges: 10, Success: 5},
+		{Host: "h2", Keyspace: "ks", Table: "running", TokenRanges: 10, Success: 10, CompletedAt: &t1},
+	}
+	expected := map[string]time.Time{
+		"ks.ok": t2,
+	}
+	if diff := cmp.Diff(expected, successfullyRepairedTables(rps)); diff != "" {
+		t.Fatal(diff)
+	}
+}
+
+func TestTombstoneGCMode(t *testing.T) {
+	testCases := []struct {
+		ext      map[string]string
+		expected string
+	}{
+		{ext: nil, expected: "timeout"},
+		{ext: map[string]string{"tombstone_gc": "\x00\x00\x00\x01\x00\x00\x00\x04mode\x00\x00\x00\x06repair"}, expected: "repair"},
+		{ext: map[string]string{"tombstone_gc": "\x00\x00\x00\x01\x00\x00\x00\x04mode\x00\x00\x00\x07timeout"}, expected: "timeout"},
+	}
+
+	for _, tc := range testCases {
+		if got := tombstoneGCMode(tc.ext); got != tc.expected {
+			t.Errorf("tombstoneGCMode(%q) = %s, expected %s", tc.ext, got, tc.expected)
+		}
+	}
+}
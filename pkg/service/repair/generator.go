@@ -46,7 +46,10 @@ type generatorTools struct {
 	ringDescriber scyllaclient.RingDescriber
 	stop          *atomic.Bool
 	batching      bool
-	logger        log.Logger
+	// incrementalMode is set if tablet tables are repaired with tablet repair.
+	incrementalMode scyllaclient.IncrementalRepairMode
+	tabletHostIDs   []string
+	logger          log.Logger
 }
 
 type submitter[T, R any] interface {
@@ -63,6 +66,7 @@ const (
 	skipJobType
 	mergeRangesJobType
 	optimizeJobType
+	tabletRepairJobType
 )
 
 type job struct {
@@ -73,6 +77,10 @@ type job struct {
 	ranges     []scyllaclient.TokenRange
 	intensity  int
 	jobType    jobType
+	// Tablet repair params
+	incrementalMode scyllaclient.IncrementalRepairMode
+	dcs             []string
+	hostIDs         []string
 }
 
 type jobResult struct {
@@ -105,6 +113,7 @@ func newGenerator(ctx context.Context, target Target, client *scyllaclient.Clien
 			submitter:     s,
 			ringDescriber: scyllaclient.NewRingDescriber(ctx, client),
 			stop:          &atomic.Bool{},
+			tabletHostIDs: tabletRepairHostIDs(status, target),
 			logger:        logger,
 		},
 		target: target,
@@ -176,6 +185,8 @@ func (g *generator) newTableGenerator(keyspace string, tp tablePlan, ring scylla
 
 	var jt jobType
 	switch {
+	case g.incrementalMode != "" && tabletKs:
+		jt = tabletRepairJobType
 	case g.plan.SmallTableOptSupport && tp.Small && !tabletKs:
 		jt = optimizeJobType
 	case len(ring.ReplicaTokens) == 1 && tp.Small:
@@ -265,13 +276,13 @@ func (tg *tableGenerator) newJob() (job, bool) {
 				continue
 			}
 			jt := tg.JobType
-			// A single optimized job repairs the whole table,
+			// A single optimized or tablet repair job repairs the whole table,
 			// so the remaining job are skipped (and sent only for recording progress).
-			if tg.JobType == optimizeJobType {
+			if tg.JobType == optimizeJobType || tg.JobType == tabletRepairJobType {
 				tg.JobType = skipJobType
 			}
 
-			return job{
+			j := job{
 				keyspace:   tg.Keyspace,
 				table:      tg.Table,
 				master:     tg.ms.Select(filtered),
@@ -279,7 +290,13 @@ func (tg *tableGenerator) newJob() (job, bool) {
 				ranges:     ranges,
 				intensity:  intensity,
 				jobType:    jt,
-			}, true
+			}
+			if jt == tabletRepairJobType {
+				j.incrementalMode = tg.incrementalMode
+				j.dcs = tg.target.DC
+				j.hostIDs = tg.tabletHostIDs
+			}
+			return j, true
 		}
 	}
 
@@ -0,0 +1,121 @@
+// Copyright (C) 2024 ScyllaDB
+
+package repair
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/scylladb/gocqlx/v2/qb"
+	"github.com/scylladb/scylla-manager/v3/pkg/schema/table"
+	"github.com/scylladb/scylla-manager/v3/pkg/scyllaclient"
+	"github.com/scylladb/scylla-manager/v3/pkg/util/slice"
+	"github.com/scylladb/scylla-manager/v3/pkg/util/uuid"
+	"golang.org/x/sync/errgroup"
+)
+
+// defaultFullEvery is the default number of runs of incremental repair task
+// after which full repair is forced.
+const defaultFullEvery = 7
+
+// incrementalRepairMode returns mode of tablet repair used by run of incremental
+// repair task, and sets run.Incremental. Tablet tables are repaired with tablet
+// repair API which marks repaired SSTables, the run is incremental if the task
+// has fewer than fullEvery successful runs since its last successful full repair.
+// Resumed run keeps the mode of the interrupted run. It returns empty mode if not
+// all hosts support incremental repair, then tables are repaired as in full
+// repair task.
+func (s *Service) incrementalRepairMode(ctx context.Context, client *scyllaclient.Client, run *Run, prevID uuid.UUID,
+	hosts []string, fullEvery int,
+) (scyllaclient.IncrementalRepairMode, error) {
+	support, err := isIncrementalRepairSupported(ctx, client, hosts)
+	if err != nil {
+		run.Incremental = false
+		return "", errors.Wrap(err, "check support of incremental repair")
+	}
+	if !support {
+		s.logger.Info(ctx, "Incremental repair is not supported by all hosts, running full repair")
+		run.Incremental = false
+		return "", nil
+	}
+
+	if prevID == uuid.Nil {
+		q := qb.Select(table.RepairRun.Name()).Where(
+			qb.Eq("cluster_id"),
+			qb.Eq("task_id"),
+		).Query(s.session).BindMap(qb.M{
+			"cluster_id": run.ClusterID,
+			"task_id":    run.TaskID,
+		})
+		var runs []*Run
+		if err := q.SelectRelease(&runs); err != nil {
+			return "", errors.Wrap(err, "get runs")
+		}
+		run.Incremental = nextRunIncremental(runs, run.ID, fullEvery)
+	}
+
+	if run.Incremental {
+		return scyllaclient.IncrementalRepairModeIncremental, nil
+	}
+	return scyllaclient.IncrementalRepairModeFull, nil
+}
+
+// nextRunIncremental returns true if there are fewer than fullEvery successful
+// runs since the last successful full run (inclusive).
+// Runs are expected to be sorted from the newest to the oldest.
+func nextRunIncremental(runs []*Run, runID uuid.UUID, fullEvery int) bool {
+	chain := 0
+	for _, r := range runs {
+		if r.ID == runID || r.EndTime.IsZero() {
+			continue
+		}
+		chain++
+		if !r.Incremental {
+			return chain < fullEvery
+		}
+	}
+	// There is no full repair to base incremental repairs on
+	return false
+}
+
+func isIncrementalRepairSupported(ctx context.Context, client *scyllaclient.Client, hosts []string) (bool, error) {
+	support := make([]bool, len(hosts))
+	eg := errgroup.Group{}
+
+	for i := range hosts {
+		i := i
+		eg.Go(func() error {
+			ni, err := client.NodeInfo(ctx, hosts[i])
+			if err != nil {
+				return err
+			}
+			support[i], err = ni.SupportsIncrementalRepair()
+			return err
+		})
+	}
+
+	if err := eg.Wait(); err != nil {
+		return false, err
+	}
+	for _, ok := range support {
+		if !ok {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// tabletRepairHostIDs returns IDs of hosts repaired by tablet repair, it returns
+// nil if all hosts in target DCs are repaired.
+func tabletRepairHostIDs(status scyllaclient.NodeStatusInfoSlice, target Target) []string {
+	if len(target.IgnoreHosts) == 0 {
+		return nil
+	}
+	var out []string
+	for _, n := range status.Datacenter(target.DC) {
+		if !slice.ContainsString(target.IgnoreHosts, n.Addr) {
+			out = append(out, n.HostID)
+		}
+	}
+	return out
+}
@@ -0,0 +1,117 @@
+// Copyright (C) 2024 ScyllaDB
+
+package repair
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/scylladb/scylla-manager/v3/pkg/scyllaclient"
+	"github.com/scylladb/scylla-manager/v3/pkg/util/uuid"
+)
+
+func TestNextRunIncremental(t *testing.T) {
+	var (
+		current = uuid.NewTime()
+		end     = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	)
+	full := func() *Run {
+		return &Run{ID: uuid.NewTime(), EndTime: end}
+	}
+	incremental := func() *Run {
+		return &Run{ID: uuid.NewTime(), EndTime: end, Incremental: true}
+	}
+	failed := func() *Run {
+		return &Run{ID: uuid.NewTime(), Incremental: true}
+	}
+
+	testCases := []struct {
+		Name     string
+		Runs     []*Run
+		Expected bool
+	}{
+		{
+			Name:     "No runs",
+			Expected: false,
+		},
+		{
+			Name:     "Only current run",
+			Runs:     []*Run{{ID: current}},
+			Expected: false,
+		},
+		{
+			Name:     "Failed full run",
+			Runs:     []*Run{{ID: current}, {ID: uuid.NewTime()}},
+			Expected: false,
+		},
+		{
+			Name:     "Full run",
+			Runs:     []*Run{{ID: current}, full()},
+			Expected: true,
+		},
+		{
+			Name:     "Incomplete chain",
+			Runs:     []*Run{{ID: current}, incremental(), failed(), full(), incremental()},
+			Expected: true,
+		},
+		{
+			Name:     "Complete chain",
+			Runs:     []*Run{{ID: current}, incremental(), incremental(), full()},
+			Expected: false,
+		},
+		{
+			Name:     "Incremental runs without full run",
+			Runs:     []*Run{{ID: current}, incremental(), incremental()},
+			Expected: false,
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+		t.Run(tc.Name, func(t *testing.T) {
+			if got := nextRunIncremental(tc.Runs, current, 3); got != tc.Expected {
+				t.Fatalf("nextRunIncremental() = %v, expected %v", got, tc.Expected)
+			}
+		})
+	}
+}
+
+func TestTabletRepairHostIDs(t *testing.T) {
+	status := scyllaclient.NodeStatusInfoSlice{
+		{Datacenter: "dc1", HostID: "id1", Addr: "192.168.100.11"},
+		{Datacenter: "dc1", HostID: "id2", Addr: "192.168.100.12"},
+		{Datacenter: "dc2", HostID: "id3", Addr: "192.168.100.21"},
+	}
+
+	testCases := []struct {
+		Name     string
+		Target   Target
+		Expected []string
+	}{
+		{
+			Name:     "No ignored hosts",
+			Target:   Target{DC: []string{"dc1"}},
+			Expected: nil,
+		},
+		{
+			Name:     "Ignored host",
+			Target:   Target{DC: []string{"dc1", "dc2"}, IgnoreHosts: []string{"192.168.100.12"}},
+			Expected: []string{"id1", "id3"},
+		},
+		{
+			Name:     "Ignored host in filtered DC",
+			Target:   Target{DC: []string{"dc1"}, IgnoreHosts: []string{"192.168.100.12"}},
+			Expected: []string{"id1"},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+		t.Run(tc.Name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.Expected, tabletRepairHostIDs(status, tc.Target)); diff != "" {
+				t.Fatalf("tabletRepairHostIDs() diff\n%s", diff)
+			}
+		})
+	}
+}
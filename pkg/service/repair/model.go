@@ -40,6 +40,9 @@ type Target struct {
 	Intensity           Intensity `json:"intensity"`
 	Parallel            int       `json:"parallel"`
 	SmallTableThreshold int64     `json:"small_table_threshold"`
+	Adaptive            bool      `json:"adaptive,omitempty"`
+	Incremental         bool      `json:"incremental,omitempty"`
+	FullEvery           int       `json:"full_every,omitempty"`
 }
 
 // taskProperties is the main data structure of the runner.Properties blob.
@@ -53,6 +56,9 @@ type taskProperties struct {
 	Intensity           float64  `json:"intensity"`
 	Parallel            int      `json:"parallel"`
 	SmallTableThreshold int64    `json:"small_table_threshold"`
+	Adaptive            bool     `json:"adaptive"`
+	Incremental         bool     `json:"incremental"`
+	FullEvery           int      `json:"full_every"`
 }
 
 func defaultTaskProperties() *taskProperties {
@@ -74,13 +80,14 @@ type Run struct {
 	TaskID    uuid.UUID
 	ID        uuid.UUID
 
-	DC        []string
-	Host      string
-	Parallel  int
-	Intensity Intensity
-	PrevID    uuid.UUID
-	StartTime time.Time
-	EndTime   time.Time
+	DC          []string
+	Host        string
+	Parallel    int
+	Intensity   Intensity
+	Incremental bool
+	PrevID      uuid.UUID
+	StartTime   time.Time
+	EndTime     time.Time
 }
 
 // RunProgress specifies repair progress of a run for a table.
@@ -186,6 +193,7 @@ type Progress struct {
 	Intensity         Intensity       `json:"intensity"`
 	MaxParallel       int             `json:"max_parallel"`
 	Parallel          int             `json:"parallel"`
+	Incremental       bool            `json:"incremental"`
 }
 
 func isTimeSet(t *time.Time) bool {
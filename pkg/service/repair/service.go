@@ -91,6 +91,17 @@ func (s *Service) GetTarget(ctx context.Context, clusterID uuid.UUID, properties
 		Intensity:           NewIntensityFromDeprecated(props.Intensity),
 		Parallel:            props.Parallel,
 		SmallTableThreshold: props.SmallTableThreshold,
+		Adaptive:            props.Adaptive,
+		Incremental:         props.Incremental,
+	}
+	if props.FullEvery < 0 {
+		return t, util.ErrValidate(errors.New("full_every can't be negative"))
+	}
+	if t.Incremental {
+		t.FullEvery = props.FullEvery
+		if t.FullEvery == 0 {
+			t.FullEvery = defaultFullEvery
+		}
 	}
 
 	client, err := s.scyllaClient(ctx, clusterID)
@@ -122,6 +133,10 @@ func (s *Service) GetTarget(ctx context.Context, clusterID uuid.UUID, properties
 	if t.Host != "" && slice.ContainsString(t.IgnoreHosts, t.Host) {
 		return t, errors.New("host can't have status down")
 	}
+	// Tablet repair can't be limited to ranges of a single host
+	if t.Host != "" && t.Incremental {
+		return t, util.ErrValidate(errors.New("incremental repair can't be used with host"))
+	}
 	// Ensure Host belongs to DCs
 	if t.Host != "" && !hostBelongsToDCs(t.Host, t.DC, dcMap) {
 		return t, util.ErrValidate(errors.Errorf("no such host %s in DC %s", t.Host, strings.Join(t.DC, ", ")))
@@ -214,6 +229,12 @@ func (s *Service) Repair(ctx context.Context, clusterID, taskID, runID uuid.UUID
 	if err != nil {
 		return errors.Wrap(err, "create repair plan")
 	}
+	var gcs map[string]tombstoneGC
+	if target.Adaptive {
+		if gcs, err = s.adaptiveSort(ctx, clusterID, taskID, p); err != nil {
+			s.logger.Error(ctx, "Couldn't order tables by tombstone_gc deadlines", "error", err)
+		}
+	}
 	var ord int
 	for _, kp := range p.Keyspaces {
 		for _, tp := range kp.Tables {
@@ -236,8 +257,17 @@ func (s *Service) Repair(ctx context.Context, clusterID, taskID, runID uuid.UUID
 			// Respect parallel/intensity set in resumed run
 			run.Parallel = prev.Parallel
 			run.Intensity = prev.Intensity
+			run.Incremental = prev.Incremental
 		}
 	}
+	var incrementalMode scyllaclient.IncrementalRepairMode
+	if target.Incremental {
+		incrementalMode, err = s.incrementalRepairMode(ctx, client, run, prevID, p.Hosts, target.FullEvery)
+		if err != nil {
+			s.logger.Error(ctx, "Couldn't check if repair can be incremental, running full repair", "error", err)
+		}
+	}
+	s.logger.Info(ctx, "Repair mode", "incremental", run.Incremental, "tablet_repair_mode", incrementalMode)
 
 	if err := pm.Init(p, prevID); err != nil {
 		return err
@@ -282,6 +312,7 @@ func (s *Service) Repair(ctx context.Context, clusterID, taskID, runID uuid.UUID
 		s.logger.Info(ctx, "Checked if batching token ranges is safe", "result", batching)
 	}
 	gen.batching = batching
+	gen.incrementalMode = incrementalMode
 
 	done := make(chan struct{}, 1)
 	go func() {
@@ -311,6 +342,12 @@ func (s *Service) Repair(ctx context.Context, clusterID, taskID, runID uuid.UUID
 		run.EndTime = timeutc.Now()
 		s.putRunLogError(ctx, run)
 	}
+	// Update deadlines of tables repaired by the run, also when it failed
+	if gcs != nil {
+		if _, err := s.updateTombstoneGCDeadlines(clusterID, taskID, p, gcs); err != nil {
+			s.logger.Error(ctx, "Couldn't update tombstone_gc deadlines", "error", err)
+		}
+	}
 	// Ensure that not interrupted repair has 100% progress (invalidate rounding errors).
 	if ctx.Err() == nil && (!target.FailFast || err == nil) {
 		s.metrics.SetProgress(clusterID, 100)
@@ -396,6 +433,7 @@ func (s *Service) GetProgress(ctx context.Context, clusterID, taskID, runID uuid
 	}
 	p.Parallel = run.Parallel
 	p.Intensity = run.Intensity
+	p.Incremental = run.Incremental
 
 	// Set max parallel/intensity only for running tasks
 	s.mu.Lock()
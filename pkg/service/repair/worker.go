@@ -43,6 +43,9 @@ func (w *worker) runRepair(ctx context.Context, j job) (out error) {
 	if j.jobType == skipJobType {
 		return nil
 	}
+	if j.jobType == tabletRepairJobType {
+		return w.runTabletRepair(ctx, j)
+	}
 
 	var (
 		jobID int32
@@ -106,6 +109,28 @@ func (w *worker) runRepair(ctx context.Context, j job) (out error) {
 	}
 }
 
+// runTabletRepair repairs all tablets of the table with tablet repair API,
+// which marks repaired SSTables so that they are skipped by incremental repair.
+func (w *worker) runTabletRepair(ctx context.Context, j job) (out error) {
+	defer func() {
+		w.logger.Info(ctx, "Tablet repair done", "keyspace", j.keyspace, "table", j.table)
+		if out != nil && w.isTableDeleted(ctx, j) {
+			out = errTableDeleted
+		}
+		out = errors.Wrapf(out, "master %s keyspace %s table %s", j.master, j.keyspace, j.table)
+	}()
+
+	w.logger.Info(ctx, "Repairing tablets",
+		"keyspace", j.keyspace,
+		"table", j.table,
+		"master", j.master,
+		"dcs", j.dcs,
+		"host_ids", j.hostIDs,
+		"incremental_mode", j.incrementalMode,
+	)
+	return errors.Wrap(w.client.TabletRepair(ctx, j.keyspace, j.table, j.master, j.dcs, j.hostIDs, j.incrementalMode), "tablet repair")
+}
+
 var errStatusRunning = errors.New("unexpected RUNNING status when synchronously waiting for repair end")
 
 // handleRunningStatus is a workaround for a strange Scylla behaviour.
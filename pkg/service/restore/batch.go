@@ -8,6 +8,7 @@ import (
 	"sync"
 
 	"github.com/pkg/errors"
+	"github.com/scylladb/go-set/strset"
 	. "github.com/scylladb/scylla-manager/v3/pkg/service/backup/backupspec"
 )
 
@@ -57,8 +58,14 @@ type batchDispatcher struct {
 	hostShardCnt map[string]uint
 }
 
-func newBatchDispatcher(workload Workload, batchSize int, hostShardCnt map[string]uint, locationHosts map[Location][]string) *batchDispatcher {
+func newBatchDispatcher(workload Workload, batchSize int, hostShardCnt map[string]uint, locationHosts map[Location][]string,
+	dcHosts map[string][]string,
+) (*batchDispatcher, error) {
 	sortWorkload(workload)
+	wp, err := newWorkloadProgress(workload, locationHosts, dcHosts)
+	if err != nil {
+		return nil, err
+	}
 	var shards uint
 	for _, sh := range hostShardCnt {
 		shards += sh
@@ -70,11 +77,11 @@ func newBatchDispatcher(workload Workload, batchSize int, hostShardCnt map[strin
 		mu:                    sync.Mutex{},
 		wait:                  make(chan struct{}),
 		workload:              workload,
-		workloadProgress:      newWorkloadProgress(workload, locationHosts),
+		workloadProgress:      wp,
 		batchSize:             batchSize,
 		expectedShardWorkload: workload.TotalSize / int64(shards),
 		hostShardCnt:          hostShardCnt,
-	}
+	}, nil
 }
 
 // Describes current state of SSTables that are yet to be batched.
@@ -106,7 +113,9 @@ type remoteSSTableDirProgress struct {
 	RemainingSSTables []RemoteSSTable
 }
 
-func newWorkloadProgress(workload Workload, locationHosts map[Location][]string) workloadProgress {
+// newWorkloadProgress restricts hosts restoring backed up DC to dcHosts
+// when they are specified for this DC (see Target.DCMapping).
+func newWorkloadProgress(workload Workload, locationHosts map[Location][]string, dcHosts map[string][]string) (workloadProgress, error) {
 	dcBytes := make(map[string]int64)
 	locationDC := make(map[string][]string)
 	p := make([]remoteSSTableDirProgress, len(workload.RemoteDir))
@@ -119,9 +128,22 @@ func newWorkloadProgress(workload Workload, locationHosts map[Location][]string)
 		}
 	}
 	hostDCAccess := make(map[string][]string)
+	restoredDCs := strset.New()
 	for loc, hosts := range locationHosts {
 		for _, h := range hosts {
-			hostDCAccess[h] = append(hostDCAccess[h], locationDC[loc.StringWithoutDC()]...)
+			for _, dc := range locationDC[loc.StringWithoutDC()] {
+				if allowed, ok := dcHosts[dc]; ok && !slices.Contains(allowed, h) {
+					continue
+				}
+				hostDCAccess[h] = append(hostDCAccess[h], dc)
+				restoredDCs.Add(dc)
+			}
+		}
+	}
+	for dc := range dcBytes {
+		if !restoredDCs.Has(dc) {
+			return workloadProgress{}, errors.Errorf("no hosts with location access can restore data backed up in DC %s, "+
+				"make sure that its location is accessible from the DC it's mapped to", dc)
 		}
 	}
 	return workloadProgress{
@@ -129,7 +151,7 @@ func newWorkloadProgress(workload Workload, locationHosts map[Location][]string)
 		hostFailedDC:        make(map[string][]string),
 		hostDCAccess:        hostDCAccess,
 		remoteDir:           p,
-	}
+	}, nil
 }
 
 // Checks if given host finished restoring all that it could.
@@ -153,6 +175,9 @@ type batch struct {
 	RemoteSSTableDir string
 	Size             int64
 	SSTables         []RemoteSSTable
+	EncryptionKeyID  string
+	Compression      string
+	Checksums        map[string]string
 }
 
 func (b batch) NotVersionedSSTables() []RemoteSSTable {
@@ -329,6 +354,9 @@ func (bd *batchDispatcher) createBatch(dirIdx int, host string) (batch, bool) {
 		RemoteSSTableDir: rdw.RemoteSSTableDir,
 		Size:             size,
 		SSTables:         sstables,
+		EncryptionKeyID:  rdw.EncryptionKeyID,
+		Compression:      rdw.Compression,
+		Checksums:        rdw.Checksums,
 	}, true
 }
 
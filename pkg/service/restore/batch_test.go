@@ -5,6 +5,7 @@ package restore
 import (
 	"testing"
 
+	"github.com/google/go-cmp/cmp"
 	"github.com/scylladb/scylla-manager/v3/pkg/service/backup/backupspec"
 )
 
@@ -114,7 +115,10 @@ func TestBatchDispatcher(t *testing.T) {
 		"h3": 3,
 	}
 
-	bd := newBatchDispatcher(workload, 1, hostToShard, locationHosts)
+	bd, err := newBatchDispatcher(workload, 1, hostToShard, locationHosts, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
 
 	scenario := []struct {
 		host  string
@@ -166,3 +170,51 @@ func TestBatchDispatcher(t *testing.T) {
 		t.Fatalf("Expected sstables to be batched: %s", err)
 	}
 }
+
+func TestNewWorkloadProgressDCHosts(t *testing.T) {
+	l1 := backupspec.Location{
+		Provider: "s3",
+		Path:     "l1",
+	}
+
+	workload := aggregateWorkload([]RemoteDirWorkload{
+		{
+			ManifestInfo: &backupspec.ManifestInfo{
+				Location: l1,
+				DC:       "dc1",
+			},
+			TableName:        TableName{Keyspace: "ks1", Table: "t1"},
+			RemoteSSTableDir: "a",
+			Size:             10,
+			SSTables:         []RemoteSSTable{{Size: 10}},
+		},
+		{
+			ManifestInfo: &backupspec.ManifestInfo{
+				Location: l1,
+				DC:       "dc2",
+			},
+			TableName:        TableName{Keyspace: "ks1", Table: "t1"},
+			RemoteSSTableDir: "b",
+			Size:             10,
+			SSTables:         []RemoteSSTable{{Size: 10}},
+		},
+	})
+	locationHosts := map[backupspec.Location][]string{
+		l1: {"h1", "h2"},
+	}
+
+	wp, err := newWorkloadProgress(workload, locationHosts, map[string][]string{"dc1": {"h1"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(wp.hostDCAccess["h1"], []string{"dc1", "dc2"}); diff != "" {
+		t.Fatal(diff)
+	}
+	if diff := cmp.Diff(wp.hostDCAccess["h2"], []string{"dc2"}); diff != "" {
+		t.Fatal(diff)
+	}
+
+	if _, err := newWorkloadProgress(workload, locationHosts, map[string][]string{"dc1": {"h3"}}); err == nil {
+		t.Fatal("newWorkloadProgress() expected error")
+	}
+}
@@ -0,0 +1,87 @@
+// Copyright (C) 2024 ScyllaDB
+
+package restore
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/scylladb/scylla-manager/v3/pkg/util/query"
+)
+
+// dcMappingSeparator separates backed up and restored data center in DC mapping.
+const dcMappingSeparator = "=>"
+
+// dcMapping maps data centers of the backed up cluster
+// to data centers of the restored cluster.
+type dcMapping map[string]string
+
+// parseDCMapping parses list of 'src_dc=>dst_dc' mappings.
+// Restored data centers have to exist in dcMap.
+func parseDCMapping(mappings []string, dcMap map[string][]string) (dcMapping, error) {
+	m := make(dcMapping)
+	dst := make(map[string]string)
+
+	for _, v := range mappings {
+		srcDC, dstDC, ok := strings.Cut(v, dcMappingSeparator)
+		srcDC, dstDC = strings.TrimSpace(srcDC), strings.TrimSpace(dstDC)
+		if !ok || srcDC == "" || dstDC == "" {
+			return nil, errors.Errorf("invalid DC mapping %q, expected format is 'src_dc%sdst_dc'", v, dcMappingSeparator)
+		}
+		if _, ok := dcMap[dstDC]; !ok {
+			return nil, errors.Errorf("invalid DC mapping %q, no such datacenter %s", v, dstDC)
+		}
+		if _, ok := m[srcDC]; ok {
+			return nil, errors.Errorf("datacenter %s is mapped multiple times", srcDC)
+		}
+		if prev, ok := dst[dstDC]; ok {
+			return nil, errors.Errorf("invalid DC mapping %q, %s is already mapped to %s", v, prev, dstDC)
+		}
+		m[srcDC] = dstDC
+		dst[dstDC] = srcDC
+	}
+	return m, nil
+}
+
+// dc returns name of the backed up data center in the restored cluster.
+func (m dcMapping) dc(dc string) string {
+	if d, ok := m[dc]; ok {
+		return d
+	}
+	return dc
+}
+
+var (
+	cqlReplication = regexp.MustCompile(`(?i)replication\s*=\s*\{[^}]*\}`)
+	cqlMapKey      = regexp.MustCompile(`'((?:[^']|'')*)'(\s*:)`)
+)
+
+// mapSchema returns described schema with data centers in
+// NetworkTopologyStrategy replication of keyspaces rewritten according to the mapping.
+func (m dcMapping) mapSchema(schema query.DescribedSchema) query.DescribedSchema {
+	if len(m) == 0 {
+		return schema
+	}
+
+	out := make(query.DescribedSchema, 0, len(schema))
+	for _, row := range schema {
+		if row.Type == "keyspace" {
+			row.CQLStmt = cqlReplication.ReplaceAllStringFunc(row.CQLStmt, m.mapReplication)
+		}
+		out = append(out, row)
+	}
+	return out
+}
+
+// mapReplication rewrites data center keys of NetworkTopologyStrategy replication map.
+func (m dcMapping) mapReplication(replication string) string {
+	if !strings.Contains(replication, "NetworkTopologyStrategy") {
+		return replication
+	}
+	return cqlMapKey.ReplaceAllStringFunc(replication, func(s string) string {
+		sm := cqlMapKey.FindStringSubmatch(s)
+		dc := strings.ReplaceAll(sm[1], "''", "'")
+		return "'" + strings.ReplaceAll(m.dc(dc), "'", "''") + "'" + sm[2]
+	})
+}
@@ -0,0 +1,83 @@
+// Copyright (C) 2024 ScyllaDB
+
+package restore
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/scylladb/scylla-manager/v3/pkg/util/query"
+)
+
+func TestParseDCMappingError(t *testing.T) {
+	dcMap := map[string][]string{
+		"eu-west":    {"192.168.100.11"},
+		"eu-central": {"192.168.100.21"},
+	}
+
+	table := []struct {
+		Name    string
+		Mapping []string
+	}{
+		{Name: "no separator", Mapping: []string{"dc1"}},
+		{Name: "empty source", Mapping: []string{"=>eu-west"}},
+		{Name: "unknown destination", Mapping: []string{"dc1=>us-east"}},
+		{Name: "mapped twice", Mapping: []string{"dc1=>eu-west", "dc1=>eu-central"}},
+		{Name: "duplicate destination", Mapping: []string{"dc1=>eu-west", "dc2=>eu-west"}},
+	}
+
+	for i := range table {
+		test := table[i]
+		t.Run(test.Name, func(t *testing.T) {
+			if _, err := parseDCMapping(test.Mapping, dcMap); err == nil {
+				t.Fatalf("parseDCMapping(%v) expected error", test.Mapping)
+			}
+		})
+	}
+}
+
+func TestDCMappingMapSchema(t *testing.T) {
+	dcMap := map[string][]string{
+		"eu-west":    {"192.168.100.11"},
+		"eu-central": {"192.168.100.21"},
+	}
+	m, err := parseDCMapping([]string{"dc1=>eu-west", "dc2 => eu-central"}, dcMap)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	schema := query.DescribedSchema{
+		{
+			Keyspace: "ks1",
+			Type:     "keyspace",
+			Name:     "ks1",
+			CQLStmt:  "CREATE KEYSPACE ks1 WITH replication = {'class': 'org.apache.cassandra.locator.NetworkTopologyStrategy', 'dc1': '3', 'dc2': '2', 'dc3': '1'} AND durable_writes = true;",
+		},
+		{
+			Keyspace: "ks2",
+			Type:     "keyspace",
+			Name:     "ks2",
+			CQLStmt:  "CREATE KEYSPACE ks2 WITH replication = {'class': 'org.apache.cassandra.locator.SimpleStrategy', 'replication_factor': '3'} AND durable_writes = true;",
+		},
+		{
+			Keyspace: "ks1",
+			Type:     "table",
+			Name:     "tab",
+			CQLStmt:  "CREATE TABLE ks1.tab (id int PRIMARY KEY) WITH comment = 'dc1';",
+		},
+	}
+	expected := query.DescribedSchema{
+		{
+			Keyspace: "ks1",
+			Type:     "keyspace",
+			Name:     "ks1",
+			CQLStmt:  "CREATE KEYSPACE ks1 WITH replication = {'class': 'org.apache.cassandra.locator.NetworkTopologyStrategy', 'eu-west': '3', 'eu-central': '2', 'dc3': '1'} AND durable_writes = true;",
+		},
+		schema[1],
+		schema[2],
+	}
+
+	if diff := cmp.Diff(m.mapSchema(schema), expected); diff != "" {
+		t.Fatal(diff)
+	}
+}
@@ -0,0 +1,48 @@
+// Copyright (C) 2024 ScyllaDB
+
+package restore
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/scylladb/scylla-manager/v3/pkg/scyllaclient"
+	"github.com/scylladb/scylla-manager/v3/pkg/secrets"
+	"github.com/scylladb/scylla-manager/v3/pkg/util/uuid"
+)
+
+// encryptionKeyContext returns context with backup encryption key of
+// the backed up cluster so that manifests and schema files can be read
+// regardless of them being encrypted or not.
+// If the cluster has no key ctx is returned unchanged.
+func (w *worker) encryptionKeyContext(ctx context.Context, clusterID uuid.UUID) (context.Context, error) {
+	key, ok, err := secrets.GetBackupEncryptionKey(w.secretsStore, clusterID)
+	if err != nil || !ok {
+		return ctx, err
+	}
+	return scyllaclient.WithBackupEncryptionKey(ctx, key), nil
+}
+
+// backupFilesContext returns context for downloading files of a backup
+// encrypted with the key of the given ID and compressed with the given codec.
+// Encryption key is not set if the backup is not encrypted, since sizes of
+// plain files might be mistaken for sizes of encrypted ones.
+func (w *worker) backupFilesContext(ctx context.Context, clusterID uuid.UUID, encryptionKeyID, compression string) (context.Context, error) {
+	if compression != "" {
+		ctx = scyllaclient.WithBackupCompression(ctx, compression)
+	}
+	if encryptionKeyID == "" {
+		return ctx, nil
+	}
+	key, ok, err := secrets.GetBackupEncryptionKey(w.secretsStore, clusterID)
+	if err != nil {
+		return ctx, err
+	}
+	if !ok {
+		return ctx, errors.Errorf("backup is encrypted but cluster %s has no backup encryption key", clusterID)
+	}
+	if key.ID() != encryptionKeyID {
+		return ctx, errors.Errorf("backup is encrypted with key %s but cluster %s has key %s", encryptionKeyID, clusterID, key.ID())
+	}
+	return scyllaclient.WithBackupEncryptionKey(ctx, key), nil
+}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/pkg/errors"
 	"github.com/scylladb/scylla-manager/v3/pkg/metrics"
+	"github.com/scylladb/scylla-manager/v3/pkg/rclone/crypt"
 	. "github.com/scylladb/scylla-manager/v3/pkg/service/backup/backupspec"
 	"github.com/scylladb/scylla-manager/v3/pkg/sstable"
 )
@@ -29,6 +30,10 @@ type RemoteDirWorkload struct {
 	RemoteSSTableDir string
 	Size             int64
 	SSTables         []RemoteSSTable
+	EncryptionKeyID  string
+	Compression      string
+	// Checksums of files recorded in manifest
+	Checksums map[string]string
 }
 
 // RemoteSSTable represents SSTable updated with size and version info from remote.
@@ -77,7 +82,9 @@ func (w *tablesWorker) createRemoteDirWorkloads(ctx context.Context, location Lo
 	var rawWorkload []RemoteDirWorkload
 	err := w.forEachManifest(ctx, location, func(m ManifestInfoWithContent) error {
 		return m.ForEachIndexIterWithError(nil, func(fm FilesMeta) error {
-			if !unitsContainTable(w.run.Units, fm.Keyspace, fm.Table) {
+			// SSTables are loaded into renamed table
+			t := w.target.renames.tableName(TableName{Keyspace: fm.Keyspace, Table: fm.Table})
+			if !unitsContainTable(w.run.Units, t.Keyspace, t.Table) {
 				return nil
 			}
 
@@ -86,25 +93,34 @@ func (w *tablesWorker) createRemoteDirWorkloads(ctx context.Context, location Lo
 				return errors.Wrapf(err, "convert files meta to sstables")
 			}
 			sstDir := m.LocationSSTableVersionDir(fm.Keyspace, fm.Table, fm.Version)
-			remoteSSTables, err := w.adjustSSTablesWithRemote(ctx, w.randomHostFromLocation(location), sstDir, sstables)
+			remoteSSTables, err := w.adjustSSTablesWithRemote(ctx, w.randomHostFromLocation(location), sstDir, sstables, m.EncryptionKeyID != "")
 			if err != nil {
 				return errors.Wrap(err, "fetch sstables sizes")
 			}
+			// Downloaded bytes are counted after decompression
+			if m.Compression != "" {
+				scaleSSTablesSize(remoteSSTables, fm.Size)
+			}
+			if len(w.target.TokenRanges) > 0 {
+				remoteSSTables, err = w.filterSSTablesByTokenRanges(ctx, w.randomHostFromLocation(location), sstDir, m, remoteSSTables)
+				if err != nil {
+					return errors.Wrap(err, "filter sstables by token ranges")
+				}
+			}
 
 			var size int64
 			for _, sst := range remoteSSTables {
 				size += sst.Size
 			}
-			t := TableName{
-				Keyspace: fm.Keyspace,
-				Table:    fm.Table,
-			}
 			workload := RemoteDirWorkload{
 				TableName:        t,
 				ManifestInfo:     m.ManifestInfo,
 				RemoteSSTableDir: sstDir,
 				Size:             size,
 				SSTables:         remoteSSTables,
+				EncryptionKeyID:  m.EncryptionKeyID,
+				Compression:      m.Compression,
+				Checksums:        fm.Checksums,
 			}
 			if size > 0 {
 				rawWorkload = append(rawWorkload, workload)
@@ -158,6 +174,9 @@ func (w *tablesWorker) filterPreviouslyRestoredSStables(ctx context.Context, raw
 				RemoteSSTableDir: rw.RemoteSSTableDir,
 				Size:             size,
 				SSTables:         filteredSSTables,
+				EncryptionKeyID:  rw.EncryptionKeyID,
+				Compression:      rw.Compression,
+				Checksums:        rw.Checksums,
 			})
 		} else {
 			w.logger.Info(ctx, "Completely filtered out remote sstable dir", "remote dir", rw.RemoteSSTableDir)
@@ -247,7 +266,7 @@ func aggregateWorkload(rawWorkload []RemoteDirWorkload) Workload {
 	}
 }
 
-func (w *tablesWorker) adjustSSTablesWithRemote(ctx context.Context, host, remoteDir string, sstables map[string]SSTable) ([]RemoteSSTable, error) {
+func (w *tablesWorker) adjustSSTablesWithRemote(ctx context.Context, host, remoteDir string, sstables map[string]SSTable, encrypted bool) ([]RemoteSSTable, error) {
 	versioned, err := ListVersionedFiles(ctx, w.client, w.run.SnapshotTag, host, remoteDir)
 	if err != nil {
 		return nil, errors.Wrap(err, "list versioned files")
@@ -262,8 +281,16 @@ func (w *tablesWorker) adjustSSTablesWithRemote(ctx context.Context, host, remot
 				return nil, errors.Errorf("file %s is not present in listed versioned files", f)
 			}
 
+			size := v.Size
+			// Downloaded bytes are counted after decryption
+			if encrypted {
+				if size, err = crypt.DecryptedSize(size); err != nil {
+					return nil, errors.Wrapf(err, "file %s", f)
+				}
+			}
+
 			rsst.Files = append(rsst.Files, v.FullName())
-			rsst.Size += v.Size
+			rsst.Size += size
 			rsst.Versioned = rsst.Versioned || v.Version != ""
 		}
 		remoteSSTables = append(remoteSSTables, rsst)
@@ -272,6 +299,29 @@ func (w *tablesWorker) adjustSSTablesWithRemote(ctx context.Context, host, remot
 	return remoteSSTables, nil
 }
 
+// scaleSSTablesSize distributes size of the data among sstables proportionally
+// to their remote sizes. It's used when remote files are compressed, and only
+// the total size of the data is known from the manifest.
+func scaleSSTablesSize(sstables []RemoteSSTable, size int64) {
+	var remoteSize int64
+	for _, sst := range sstables {
+		remoteSize += sst.Size
+	}
+	if remoteSize == 0 {
+		return
+	}
+	left := size
+	for i := range sstables {
+		if i == len(sstables)-1 {
+			sstables[i].Size = left
+			break
+		}
+		s := int64(float64(sstables[i].Size) / float64(remoteSize) * float64(size))
+		sstables[i].Size = s
+		left -= s
+	}
+}
+
 func filesMetaToSSTables(fm FilesMeta) (map[string]SSTable, error) {
 	const expectedSSTableFileCnt = 9
 	sstables := make(map[string]SSTable, len(fm.Files)/expectedSSTableFileCnt)
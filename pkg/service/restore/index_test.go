@@ -0,0 +1,27 @@
+// Copyright (C) 2024 ScyllaDB
+
+package restore
+
+import (
+	"testing"
+)
+
+func TestScaleSSTablesSize(t *testing.T) {
+	sstables := []RemoteSSTable{
+		{Size: 10},
+		{Size: 30},
+		{Size: 60},
+	}
+	scaleSSTablesSize(sstables, 333)
+
+	var total int64
+	for _, sst := range sstables {
+		total += sst.Size
+	}
+	if total != 333 {
+		t.Fatalf("scaleSSTablesSize() total %d, expected %d", total, 333)
+	}
+	if sstables[0].Size != 33 || sstables[1].Size != 99 {
+		t.Fatalf("scaleSSTablesSize() = %v", sstables)
+	}
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/pkg/errors"
 	"github.com/scylladb/gocqlx/v2"
 	"github.com/scylladb/gocqlx/v2/qb"
+	"github.com/scylladb/scylla-manager/v3/pkg/dht"
 	"github.com/scylladb/scylla-manager/v3/pkg/schema/table"
 	"github.com/scylladb/scylla-manager/v3/pkg/scyllaclient"
 	"github.com/scylladb/scylla-manager/v3/pkg/service/repair"
@@ -26,6 +27,7 @@ type Target struct {
 	Location        []Location `json:"location"`
 	Keyspace        []string   `json:"keyspace,omitempty"`
 	SnapshotTag     string     `json:"snapshot_tag"`
+	PointInTime     time.Time  `json:"point_in_time,omitempty"`
 	BatchSize       int        `json:"batch_size,omitempty"`
 	Parallel        int        `json:"parallel,omitempty"`
 	Transfers       int        `json:"transfers"`
@@ -35,9 +37,19 @@ type Target struct {
 	RestoreSchema   bool       `json:"restore_schema,omitempty"`
 	RestoreTables   bool       `json:"restore_tables,omitempty"`
 	Continue        bool       `json:"continue"`
+	Rename          []string   `json:"rename,omitempty"`
+	DCMapping       []string   `json:"dc_mapping,omitempty"`
+	// TokenRanges limit restored sstables to the ones containing partitions from the ranges
+	TokenRanges []dht.TokenRange `json:"token_ranges,omitempty"`
 
 	// Cache for host with access to remote location
 	locationHosts map[Location][]string `json:"-"`
+	// Parsed rename mapping
+	renames renameMapping `json:"-"`
+	// Parsed DC mapping
+	dcMappings dcMapping `json:"-"`
+	// Cache for hosts restoring data of mapped backed up DC
+	dcHosts map[string][]string `json:"-"`
 }
 
 const (
@@ -62,8 +74,15 @@ func (t Target) validateProperties(dcMap map[string][]string) error {
 	if len(t.Location) == 0 {
 		return errors.New("missing location")
 	}
-	if _, err := SnapshotTagTime(t.SnapshotTag); err != nil {
-		return err
+	switch {
+	case t.PointInTime.IsZero():
+		if _, err := SnapshotTagTime(t.SnapshotTag); err != nil {
+			return err
+		}
+	case t.SnapshotTag != "":
+		return errors.New("choose EXACTLY ONE of snapshot tag ('--snapshot-tag' flag) or point in time ('--point-in-time' flag)")
+	case t.PointInTime.After(timeutc.Now()):
+		return errors.New("point in time can't be in the future")
 	}
 	if t.BatchSize < 0 {
 		return errors.New("batch size param has to be greater or equal to zero")
@@ -84,6 +103,31 @@ func (t Target) validateProperties(dcMap map[string][]string) error {
 	if t.RestoreSchema && t.Keyspace != nil {
 		return errors.New("restore schema always restores 'system_schema.*' tables only, no need to specify '--keyspace' flag")
 	}
+	if _, err := parseRenameMapping(t.Rename); err != nil {
+		return err
+	}
+	if len(t.Rename) > 0 && !t.PointInTime.IsZero() {
+		return errors.New("renaming keyspaces and tables is not supported with point in time restore")
+	}
+	// Archived commitlog segments are replayed as a whole, so they would
+	// also restore mutations of tables excluded by the filters.
+	if t.Keyspace != nil && !t.PointInTime.IsZero() {
+		return errors.New("filtering keyspaces and tables ('--keyspace' flag) is not supported with point in time restore")
+	}
+	if len(t.TokenRanges) > 0 && !t.PointInTime.IsZero() {
+		return errors.New("token ranges are not supported with point in time restore")
+	}
+	// Restored schema recreates tables with new IDs, and commitlog mutations
+	// of the original tables would be dropped on replay.
+	if t.RestoreSchema && !t.PointInTime.IsZero() {
+		return errors.New("point in time restore can only be used when restoring tables ('--restore-tables' flag)")
+	}
+	if _, err := parseDCMapping(t.DCMapping, dcMap); err != nil {
+		return err
+	}
+	if len(t.TokenRanges) > 0 && t.RestoreSchema {
+		return errors.New("token ranges can only be used when restoring tables ('--restore-tables' flag)")
+	}
 	return nil
 }
 
@@ -0,0 +1,308 @@
+// Copyright (C) 2024 ScyllaDB
+
+package restore
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/pkg/errors"
+	. "github.com/scylladb/scylla-manager/v3/pkg/service/backup/backupspec"
+	"github.com/scylladb/scylla-manager/v3/pkg/service/repair"
+	"github.com/scylladb/scylla-manager/v3/pkg/util/duration"
+	"github.com/scylladb/scylla-manager/v3/pkg/util/uuid"
+)
+
+// planShardThroughput is the assumed download and load&stream throughput
+// of a single shard in bytes per second. It's used for estimating duration
+// of the data stage when host is not rate limited.
+const planShardThroughput = 20 * 1024 * 1024
+
+// Plan describes how restore would be executed. It's the result of running
+// the INIT stage logic without modifying the cluster.
+type Plan struct {
+	Target Target `json:"-"`
+	Units  []Unit `json:"-"`
+	// Views are dropped before and recreated after restoring tables.
+	Views []View `json:"-"`
+
+	Manifests []PlanManifest `json:"manifests"`
+	Tables    []PlanTable    `json:"tables,omitempty"`
+	Hosts     []PlanHost     `json:"hosts,omitempty"`
+	// DownloadSize is the total size of sstables to be downloaded,
+	// it may be lower than units size when restoring token ranges.
+	DownloadSize int64 `json:"download_size"`
+	BatchCount   int   `json:"batch_count"`
+	MaxBatchSize int64 `json:"max_batch_size"`
+	// EstimatedDuration is a rough estimate of the data stage duration,
+	// it does not include repair and rebuilding views.
+	EstimatedDuration duration.Duration `json:"estimated_duration"`
+	// Repair lists tables repaired after restore.
+	Repair []string `json:"repair,omitempty"`
+}
+
+// PlanManifest describes backup manifest used for restore.
+type PlanManifest struct {
+	Location Location `json:"location"`
+	DC       string   `json:"dc"`
+	NodeID   string   `json:"node_id"`
+	Path     string   `json:"path"`
+}
+
+// PlanTable describes restored table and its tombstone_gc mode that is
+// disabled for the time of restore.
+type PlanTable struct {
+	Keyspace    string          `json:"keyspace"`
+	Table       string          `json:"table"`
+	Size        int64           `json:"size"`
+	TombstoneGC tombstoneGCMode `json:"tombstone_gc"`
+}
+
+// PlanHost describes batches restored by a host.
+type PlanHost struct {
+	Host     string     `json:"host"`
+	DC       string     `json:"dc"`
+	Shards   uint       `json:"shards"`
+	Location []Location `json:"location"`
+	Batches  int        `json:"batches"`
+	Size     int64      `json:"size"`
+}
+
+// GetPlan returns restore execution plan for given properties.
+func (s *Service) GetPlan(ctx context.Context, clusterID uuid.UUID, properties json.RawMessage) (Plan, error) {
+	w, err := s.newWorker(ctx, clusterID)
+	if err != nil {
+		return Plan{}, errors.Wrap(err, "create worker")
+	}
+	defer w.clusterSession.Close()
+
+	if err := w.init(ctx, properties); err != nil {
+		return Plan{}, err
+	}
+
+	p := Plan{
+		Target: w.target,
+		Units:  w.run.Units,
+		Views:  w.run.Views,
+	}
+	if p.Manifests, err = w.planManifests(ctx); err != nil {
+		return Plan{}, err
+	}
+	if !w.target.RestoreTables {
+		return p, nil
+	}
+
+	tw, err := newTablesWorker(w, s.repairSvc, 0)
+	if err != nil {
+		return Plan{}, err
+	}
+	if err := tw.planData(ctx, &p); err != nil {
+		return Plan{}, err
+	}
+	if p.Repair, err = tw.planRepair(ctx); err != nil {
+		return Plan{}, err
+	}
+	return p, nil
+}
+
+func (w *worker) planManifests(ctx context.Context) ([]PlanManifest, error) {
+	var out []PlanManifest
+	for _, l := range w.target.Location {
+		host, err := w.closestHostFromLocation(l)
+		if err != nil {
+			return nil, err
+		}
+		manifests, err := w.getManifestInfo(ctx, host, l)
+		if err != nil {
+			return nil, errors.Wrapf(err, "list manifests in %s", l)
+		}
+		for _, m := range manifests {
+			out = append(out, PlanManifest{
+				Location: l,
+				DC:       m.DC,
+				NodeID:   m.NodeID,
+				Path:     m.Location.RemotePath(m.Path()),
+			})
+		}
+	}
+	return out, nil
+}
+
+// planData indexes workload and dispatches batches the same way
+// as the data stage does, but without restoring them.
+func (w *tablesWorker) planData(ctx context.Context, p *Plan) error {
+	workload, err := w.IndexWorkload(ctx, w.target.Location)
+	if err != nil {
+		return err
+	}
+
+	p.DownloadSize = workload.TotalSize
+	for _, u := range w.run.Units {
+		for _, t := range u.Tables {
+			p.Tables = append(p.Tables, PlanTable{
+				Keyspace:    u.Keyspace,
+				Table:       t.Table,
+				Size:        workload.TableSize[TableName{Keyspace: u.Keyspace, Table: t.Table}],
+				TombstoneGC: t.TombstoneGC,
+			})
+		}
+	}
+
+	hostLocation := make(map[string][]Location)
+	for l, hosts := range w.target.locationHosts {
+		for _, h := range hosts {
+			hostLocation[h] = append(hostLocation[h], l)
+		}
+	}
+	hosts := make([]string, 0, len(hostLocation))
+	for h := range hostLocation {
+		hosts = append(hosts, h)
+	}
+	sort.Strings(hosts)
+
+	hostToShard, err := w.client.HostsShardCount(ctx, hosts)
+	if err != nil {
+		return errors.Wrap(err, "get hosts shard count")
+	}
+	status, err := w.client.Status(ctx)
+	if err != nil {
+		return errors.Wrap(err, "get status")
+	}
+	hostDC := status.HostDC()
+
+	bd, err := newBatchDispatcher(workload, w.target.BatchSize, hostToShard, w.target.locationHosts, w.target.dcHosts)
+	if err != nil {
+		return errors.Wrap(err, "create batch dispatcher")
+	}
+
+	throughput := make([]int64, len(hosts))
+	for i, h := range hosts {
+		throughput[i] = hostThroughput(hostToShard[h], dcRateLimit(w.target.RateLimit, hostDC[h]))
+	}
+	batches, d := simulateDispatch(bd, hosts, throughput, w.target.Parallel)
+
+	for i, h := range hosts {
+		ph := PlanHost{
+			Host:     h,
+			DC:       hostDC[h],
+			Shards:   hostToShard[h],
+			Location: hostLocation[h],
+		}
+		sort.Slice(ph.Location, func(i, j int) bool {
+			return ph.Location[i].String() < ph.Location[j].String()
+		})
+		for _, b := range batches[i] {
+			ph.Batches++
+			ph.Size += b.Size
+			p.BatchCount++
+			p.MaxBatchSize = max(p.MaxBatchSize, b.Size)
+		}
+		p.Hosts = append(p.Hosts, ph)
+	}
+	p.EstimatedDuration = duration.Duration(d)
+
+	return nil
+}
+
+// hostThroughput returns assumed host throughput in bytes per second,
+// rate limit is in MiB/s.
+func hostThroughput(shards uint, rateLimit int) int64 {
+	t := int64(max(shards, 1)) * planShardThroughput
+	if rateLimit > 0 {
+		t = min(t, int64(rateLimit)*1024*1024)
+	}
+	return t
+}
+
+// simulateDispatch dispatches all batches to hosts as if each of them
+// was restored successfully in time proportional to its size.
+// Like in the data stage, at most parallel hosts restore batches at
+// the same time. It returns batches dispatched to each host and
+// the estimated time of restoring all of them.
+func simulateDispatch(bd *batchDispatcher, hosts []string, throughput []int64, parallel int) ([][]batch, time.Duration) {
+	if parallel <= 0 || parallel > len(hosts) {
+		parallel = len(hosts)
+	}
+
+	var (
+		out    = make([][]batch, len(hosts))
+		freeAt = make([]time.Duration, len(hosts))
+		active []int
+		next   int
+		end    time.Duration
+	)
+	for ; next < parallel; next++ {
+		active = append(active, next)
+	}
+
+	for len(active) > 0 {
+		// Pick host that is the first to finish its batch
+		k := 0
+		for i := range active {
+			if freeAt[active[i]] < freeAt[active[k]] {
+				k = i
+			}
+		}
+		n := active[k]
+
+		b, ok := bd.dispatchBatch(hosts[n])
+		if !ok {
+			end = max(end, freeAt[n])
+			active = append(active[:k], active[k+1:]...)
+			if next < len(hosts) {
+				freeAt[next] = freeAt[n]
+				active = append(active, next)
+				next++
+			}
+			continue
+		}
+		bd.ReportSuccess(b)
+		out[n] = append(out[n], b)
+		freeAt[n] += time.Duration(float64(b.Size) / float64(throughput[n]) * float64(time.Second))
+	}
+
+	return out, end
+}
+
+// planRepair returns tables that would be repaired after restore.
+func (w *tablesWorker) planRepair(ctx context.Context) ([]string, error) {
+	props, err := repairProperties(w.run.Units)
+	if err != nil {
+		return nil, err
+	}
+	t, err := w.repairSvc.GetTarget(ctx, w.run.ClusterID, props)
+	if err != nil {
+		if errors.Is(err, repair.ErrEmptyRepair) {
+			return nil, nil
+		}
+		return nil, errors.Wrap(err, "get repair target")
+	}
+
+	var out []string
+	for _, u := range t.Units {
+		for _, tab := range u.Tables {
+			out = append(out, fmt.Sprintf("%s.%s", u.Keyspace, tab))
+		}
+	}
+	sort.Strings(out)
+	return out, nil
+}
+
+// repairProperties returns properties of the post-restore repair of units.
+func repairProperties(units []Unit) (json.RawMessage, error) {
+	var keyspace []string
+	for _, u := range units {
+		for _, t := range u.Tables {
+			keyspace = append(keyspace, fmt.Sprintf("%s.%s", u.Keyspace, t.Table))
+		}
+	}
+	props, err := json.Marshal(map[string]any{
+		"keyspace":  keyspace,
+		"intensity": 0,
+		"parallel":  0,
+	})
+	return props, errors.Wrap(err, "parse repair properties")
+}
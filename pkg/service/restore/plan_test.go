@@ -0,0 +1,91 @@
+// Copyright (C) 2024 ScyllaDB
+
+package restore
+
+import (
+	"testing"
+	"time"
+
+	"github.com/scylladb/scylla-manager/v3/pkg/service/backup/backupspec"
+)
+
+func TestSimulateDispatch(t *testing.T) {
+	l1 := backupspec.Location{
+		Provider: "s3",
+		Path:     "l1",
+	}
+
+	rawWorkload := []RemoteDirWorkload{
+		{
+			ManifestInfo: &backupspec.ManifestInfo{
+				Location: l1,
+				DC:       "dc1",
+			},
+			TableName: TableName{
+				Keyspace: "ks1",
+				Table:    "t1",
+			},
+			RemoteSSTableDir: "a",
+			Size:             40,
+			SSTables: []RemoteSSTable{
+				{Size: 10},
+				{Size: 10},
+				{Size: 10},
+				{Size: 10},
+			},
+		},
+	}
+	locationHosts := map[backupspec.Location][]string{
+		l1: {"h1", "h2"},
+	}
+	hostToShard := map[string]uint{
+		"h1": 1,
+		"h2": 1,
+	}
+	hosts := []string{"h1", "h2"}
+	throughput := []int64{10, 10}
+
+	testCases := []struct {
+		name     string
+		parallel int
+		batches  []int
+		duration time.Duration
+	}{
+		{
+			name:     "no parallel limit",
+			parallel: 0,
+			batches:  []int{2, 2},
+			duration: 2 * time.Second,
+		},
+		{
+			name:     "parallel 1",
+			parallel: 1,
+			batches:  []int{4, 0},
+			duration: 4 * time.Second,
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+		t.Run(tc.name, func(t *testing.T) {
+			workload := aggregateWorkload(rawWorkload)
+			bd, err := newBatchDispatcher(workload, 1, hostToShard, locationHosts, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			batches, d := simulateDispatch(bd, hosts, throughput, tc.parallel)
+			for i := range hosts {
+				if len(batches[i]) != tc.batches[i] {
+					t.Errorf("Host %s got %d batches, expected %d", hosts[i], len(batches[i]), tc.batches[i])
+				}
+			}
+			if d != tc.duration {
+				t.Errorf("Duration = %s, expected %s", d, tc.duration)
+			}
+			if err := bd.ValidateAllDispatched(); err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+}
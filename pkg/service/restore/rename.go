@@ -0,0 +1,184 @@
+// Copyright (C) 2024 ScyllaDB
+
+package restore
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/scylladb/scylla-manager/v3/pkg/util/query"
+)
+
+// renameSeparator separates source and destination name in rename mapping.
+const renameSeparator = "->"
+
+// renameMapping maps keyspaces and tables from backup to keyspaces and tables
+// in the restored cluster. Table mapping takes precedence over keyspace mapping.
+type renameMapping struct {
+	keyspace map[string]string
+	table    map[TableName]TableName
+}
+
+// parseRenameMapping parses list of 'src_ks->dst_ks' and 'src_ks.tab->dst_ks.tab2' mappings.
+func parseRenameMapping(rename []string) (renameMapping, error) {
+	m := renameMapping{
+		keyspace: make(map[string]string),
+		table:    make(map[TableName]TableName),
+	}
+	dst := make(map[string]string)
+
+	for _, r := range rename {
+		srcName, dstName, ok := strings.Cut(r, renameSeparator)
+		if !ok {
+			return m, errors.Errorf("invalid rename %q, expected format is 'src_ks%sdst_ks' or 'src_ks.tab%sdst_ks.tab'", r, renameSeparator, renameSeparator)
+		}
+		srcName, dstName = strings.TrimSpace(srcName), strings.TrimSpace(dstName)
+
+		src, srcIsTable, err := parseRenameName(srcName)
+		if err != nil {
+			return m, errors.Wrapf(err, "invalid rename %q", r)
+		}
+		d, dstIsTable, err := parseRenameName(dstName)
+		if err != nil {
+			return m, errors.Wrapf(err, "invalid rename %q", r)
+		}
+		if srcIsTable != dstIsTable {
+			return m, errors.Errorf("invalid rename %q, keyspace can only be renamed to keyspace and table to table", r)
+		}
+		if prev, ok := dst[dstName]; ok {
+			return m, errors.Errorf("invalid rename %q, %s is already the destination of %s", r, dstName, prev)
+		}
+		dst[dstName] = srcName
+
+		if srcIsTable {
+			if _, ok := m.table[src]; ok {
+				return m, errors.Errorf("table %s is renamed multiple times", srcName)
+			}
+			m.table[src] = d
+		} else {
+			if _, ok := m.keyspace[src.Keyspace]; ok {
+				return m, errors.Errorf("keyspace %s is renamed multiple times", srcName)
+			}
+			m.keyspace[src.Keyspace] = d.Keyspace
+		}
+	}
+	return m, nil
+}
+
+func parseRenameName(name string) (t TableName, isTable bool, err error) {
+	ks, tab, isTable := strings.Cut(name, ".")
+	if ks == "" || (isTable && tab == "") || strings.Contains(tab, ".") {
+		return t, false, errors.Errorf("invalid name %q", name)
+	}
+	if strings.HasPrefix(ks, "system") {
+		return t, false, errors.Errorf("system keyspace %s can't be renamed", ks)
+	}
+	return TableName{Keyspace: ks, Table: tab}, isTable, nil
+}
+
+func (m renameMapping) empty() bool {
+	return len(m.keyspace) == 0 && len(m.table) == 0
+}
+
+// tableName returns name of the backed up table in the restored cluster.
+func (m renameMapping) tableName(t TableName) TableName {
+	if d, ok := m.table[t]; ok {
+		return d
+	}
+	if ks, ok := m.keyspace[t.Keyspace]; ok {
+		return TableName{Keyspace: ks, Table: t.Table}
+	}
+	return t
+}
+
+// keyspaceName returns name of the backed up keyspace in the restored cluster.
+func (m renameMapping) keyspaceName(ks string) string {
+	if d, ok := m.keyspace[ks]; ok {
+		return d
+	}
+	return ks
+}
+
+var (
+	cqlIdent          = `"(?:[^"]|"")+"|[A-Za-z0-9_]+`
+	cqlQualifiedName  = regexp.MustCompile(`(` + cqlIdent + `)\.(` + cqlIdent + `)`)
+	cqlCreateKeyspace = regexp.MustCompile(`(?i)^(\s*CREATE\s+KEYSPACE\s+(?:IF\s+NOT\s+EXISTS\s+)?)(` + cqlIdent + `)`)
+)
+
+// renameSchema returns described schema with keyspace and table names
+// rewritten according to the mapping.
+// Keyspaces being destination of renamed tables are not created,
+// they need to be a part of the restored schema or exist in the cluster.
+func (m renameMapping) renameSchema(schema query.DescribedSchema) query.DescribedSchema {
+	if m.empty() {
+		return schema
+	}
+
+	out := make(query.DescribedSchema, 0, len(schema))
+	for _, row := range schema {
+		stmt := row.CQLStmt
+		if row.Type == "keyspace" {
+			row.Name = m.keyspaceName(row.Name)
+			stmt = cqlCreateKeyspace.ReplaceAllStringFunc(stmt, func(s string) string {
+				sm := cqlCreateKeyspace.FindStringSubmatch(s)
+				return sm[1] + quoteCQLIdent(m.keyspaceName(unquoteCQLIdent(sm[2])))
+			})
+		}
+		if row.Type == "table" {
+			t := m.tableName(TableName{Keyspace: row.Keyspace, Table: row.Name})
+			row.Keyspace, row.Name = t.Keyspace, t.Table
+		} else {
+			row.Keyspace = m.keyspaceName(row.Keyspace)
+		}
+		row.CQLStmt = m.renameQualifiedNames(stmt)
+		out = append(out, row)
+	}
+	return out
+}
+
+// renameQualifiedNames rewrites all keyspace qualified names in CQL statement.
+func (m renameMapping) renameQualifiedNames(stmt string) string {
+	var (
+		b    strings.Builder
+		last int
+	)
+	for _, loc := range cqlQualifiedName.FindAllStringSubmatchIndex(stmt, -1) {
+		// Skip parts of longer names and numbers
+		if loc[0] > 0 && strings.ContainsAny(stmt[loc[0]-1:loc[0]], `."_`) {
+			continue
+		}
+		// Skip string literals, e.g. replication strategy class
+		if strings.Count(stmt[:loc[0]], "'")%2 == 1 {
+			continue
+		}
+		src := TableName{
+			Keyspace: unquoteCQLIdent(stmt[loc[2]:loc[3]]),
+			Table:    unquoteCQLIdent(stmt[loc[4]:loc[5]]),
+		}
+		dst := m.tableName(src)
+		if dst == src {
+			continue
+		}
+		b.WriteString(stmt[last:loc[0]])
+		b.WriteString(quoteCQLIdent(dst.Keyspace) + "." + quoteCQLIdent(dst.Table))
+		last = loc[1]
+	}
+	b.WriteString(stmt[last:])
+	return b.String()
+}
+
+// unquoteCQLIdent returns name of CQL identifier,
+// names of unquoted identifiers are case-insensitive.
+func unquoteCQLIdent(s string) string {
+	if len(s) >= 2 && s[0] == '"' && s[len(s)-1] == '"' {
+		return strings.ReplaceAll(s[1:len(s)-1], `""`, `"`)
+	}
+	return strings.ToLower(s)
+}
+
+// quoteCQLIdent returns quoted CQL identifier, quoting preserves case
+// and works for names being reserved words.
+func quoteCQLIdent(s string) string {
+	return `"` + strings.ReplaceAll(s, `"`, `""`) + `"`
+}
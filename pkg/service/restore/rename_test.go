@@ -0,0 +1,133 @@
+// Copyright (C) 2024 ScyllaDB
+
+package restore
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/scylladb/scylla-manager/v3/pkg/util/query"
+)
+
+func TestParseRenameMappingError(t *testing.T) {
+	table := []struct {
+		Name   string
+		Rename []string
+	}{
+		{Name: "no separator", Rename: []string{"ks1"}},
+		{Name: "keyspace to table", Rename: []string{"ks1->ks2.tab"}},
+		{Name: "table to keyspace", Rename: []string{"ks1.tab->ks2"}},
+		{Name: "empty table", Rename: []string{"ks1.->ks2.tab"}},
+		{Name: "system keyspace", Rename: []string{"system_auth->auth"}},
+		{Name: "duplicate destination", Rename: []string{"ks1->ks3", "ks2->ks3"}},
+		{Name: "renamed twice", Rename: []string{"ks1.tab->ks2.tab", "ks1.tab->ks3.tab"}},
+	}
+
+	for i := range table {
+		test := table[i]
+		t.Run(test.Name, func(t *testing.T) {
+			if _, err := parseRenameMapping(test.Rename); err == nil {
+				t.Fatalf("parseRenameMapping(%v) expected error", test.Rename)
+			}
+		})
+	}
+}
+
+func TestRenameMappingTableName(t *testing.T) {
+	m, err := parseRenameMapping([]string{"ks1->ks2", "ks1.tab->ks3.tab3", "ks4.tab -> ks4.tab4"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	table := []struct {
+		In       TableName
+		Expected TableName
+	}{
+		{In: TableName{"ks1", "tab"}, Expected: TableName{"ks3", "tab3"}},
+		{In: TableName{"ks1", "other"}, Expected: TableName{"ks2", "other"}},
+		{In: TableName{"ks4", "tab"}, Expected: TableName{"ks4", "tab4"}},
+		{In: TableName{"ks4", "other"}, Expected: TableName{"ks4", "other"}},
+		{In: TableName{"ks5", "tab"}, Expected: TableName{"ks5", "tab"}},
+	}
+	for _, test := range table {
+		if got := m.tableName(test.In); got != test.Expected {
+			t.Errorf("tableName(%s) = %s, expected %s", test.In, got, test.Expected)
+		}
+	}
+}
+
+func TestRenameMappingRenameSchema(t *testing.T) {
+	m, err := parseRenameMapping([]string{"ks1->ks2", "ks1.tab->ks1.tab2"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	schema := query.DescribedSchema{
+		{
+			Keyspace: "ks1",
+			Type:     "keyspace",
+			Name:     "ks1",
+			CQLStmt:  "CREATE KEYSPACE ks1 WITH replication = {'class': 'org.apache.cassandra.locator.NetworkTopologyStrategy', 'dc1': '3'} AND durable_writes = true;",
+		},
+		{
+			Keyspace: "ks1",
+			Type:     "type",
+			Name:     "address",
+			CQLStmt:  "CREATE TYPE ks1.address (street text);",
+		},
+		{
+			Keyspace: "ks1",
+			Type:     "table",
+			Name:     "tab",
+			CQLStmt:  "CREATE TABLE ks1.tab (id int PRIMARY KEY, a frozen<ks1.address>) WITH bloom_filter_fp_chance = 0.01;",
+		},
+		{
+			Keyspace: "ks1",
+			Type:     "table",
+			Name:     "Other",
+			CQLStmt:  `CREATE TABLE ks1."Other" (id int PRIMARY KEY) WITH crc_check_chance = 1.0;`,
+		},
+		{
+			Keyspace: "ks3",
+			Type:     "table",
+			Name:     "tab",
+			CQLStmt:  "CREATE TABLE ks3.tab (id int PRIMARY KEY);",
+		},
+	}
+	expected := query.DescribedSchema{
+		{
+			Keyspace: "ks2",
+			Type:     "keyspace",
+			Name:     "ks2",
+			CQLStmt:  `CREATE KEYSPACE "ks2" WITH replication = {'class': 'org.apache.cassandra.locator.NetworkTopologyStrategy', 'dc1': '3'} AND durable_writes = true;`,
+		},
+		{
+			Keyspace: "ks2",
+			Type:     "type",
+			Name:     "address",
+			CQLStmt:  `CREATE TYPE "ks2"."address" (street text);`,
+		},
+		{
+			Keyspace: "ks1",
+			Type:     "table",
+			Name:     "tab2",
+			CQLStmt:  `CREATE TABLE "ks1"."tab2" (id int PRIMARY KEY, a frozen<"ks2"."address">) WITH bloom_filter_fp_chance = 0.01;`,
+		},
+		{
+			Keyspace: "ks2",
+			Type:     "table",
+			Name:     "Other",
+			CQLStmt:  `CREATE TABLE "ks2"."Other" (id int PRIMARY KEY) WITH crc_check_chance = 1.0;`,
+		},
+		{
+			Keyspace: "ks3",
+			Type:     "table",
+			Name:     "tab",
+			CQLStmt:  "CREATE TABLE ks3.tab (id int PRIMARY KEY);",
+		},
+	}
+
+	if diff := cmp.Diff(m.renameSchema(schema), expected); diff != "" {
+		t.Fatal(diff)
+	}
+}
@@ -22,6 +22,7 @@ import (
 	"github.com/scylladb/scylla-manager/v3/pkg/sstable"
 	"github.com/scylladb/scylla-manager/v3/pkg/util/parallel"
 	"github.com/scylladb/scylla-manager/v3/pkg/util/timeutc"
+	"github.com/scylladb/scylla-manager/v3/pkg/util/uuid"
 )
 
 type schemaWorker struct {
@@ -140,7 +141,9 @@ func (w *schemaWorker) restoreFromSchemaFile(ctx context.Context) error {
 	start := timeutc.Now()
 
 	var createdKs []string
-	for _, row := range *w.describedSchema {
+	schema := w.target.renames.renameSchema(*w.describedSchema)
+	schema = w.target.dcMappings.mapSchema(schema)
+	for _, row := range schema {
 		if row.Keyspace == "" {
 			// Scylla 6.3 added roles and service levels to the output of
 			// DESC SCHEMA WITH INTERNALS (https://github.com/scylladb/scylladb/pull/20168).
@@ -237,6 +240,12 @@ func (w *schemaWorker) workFunc(ctx context.Context, fm FilesMeta) error {
 		"files", fm.Files,
 	)
 
+	// Context used for downloading files of encrypted or compressed backup
+	dctx, err := w.backupFilesContext(ctx, w.miwc.ClusterID, w.miwc.EncryptionKeyID, w.miwc.Compression)
+	if err != nil {
+		return err
+	}
+
 	hosts := w.target.locationHosts[w.miwc.Location]
 	w.versionedFiles, err = ListVersionedFiles(ctx, w.client, w.run.SnapshotTag, hosts[0], srcDir)
 	if err != nil {
@@ -274,7 +283,7 @@ func (w *schemaWorker) workFunc(ctx context.Context, fm FilesMeta) error {
 			srcPath := path.Join(srcDir, srcFile)
 			dstPath := path.Join(dstDir, dstFile)
 
-			return w.client.RcloneCopyFile(ctx, host, dstPath, srcPath)
+			return w.client.RcloneCopyFile(dctx, host, dstPath, srcPath)
 		}
 
 		notifyHost := func(j int, err error) {
@@ -330,7 +339,9 @@ func (w *schemaWorker) getFileNamesMapping(sstables []string, sstableUUIDFormat
 	return sstable.RenameToIDs(sstables, &w.generationCnt)
 }
 
-func getDescribedSchema(ctx context.Context, client *scyllaclient.Client, snapshotTag string, locHost map[Location][]string) (schema *query.DescribedSchema, err error) {
+func getDescribedSchema(ctx context.Context, client *scyllaclient.Client, snapshotTag string, locHost map[Location][]string,
+	encryptionKeyContext func(context.Context, uuid.UUID) (context.Context, error),
+) (schema *query.DescribedSchema, err error) {
 	baseDir := path.Join("backup", string(SchemaDirKind))
 	// It's enough to get a single schema file, but it's important to validate
 	// that each location contains exactly one or none of them.
@@ -356,6 +367,17 @@ func getDescribedSchema(ctx context.Context, client *scyllaclient.Client, snapsh
 		return nil, errors.New("only a subset of provided locations has schema files")
 	}
 
+	// Schema file of encrypted backup is encrypted with the key of backed up cluster,
+	// the file is stored in the cluster directory.
+	clusterID, err := uuid.Parse(path.Base(path.Dir(*schemaPath)))
+	if err != nil {
+		return nil, errors.Wrapf(err, "parse cluster ID of schema file %s", *schemaPath)
+	}
+	ctx, err = encryptionKeyContext(ctx, clusterID)
+	if err != nil {
+		return nil, err
+	}
+
 	r, err := client.RcloneOpen(ctx, host, *schemaPath)
 	if err != nil {
 		return nil, errors.Wrap(err, "open schema file")
@@ -16,6 +16,7 @@ import (
 	"github.com/scylladb/scylla-manager/v3/pkg/scyllaclient"
 	"github.com/scylladb/scylla-manager/v3/pkg/service/cluster"
 	"github.com/scylladb/scylla-manager/v3/pkg/service/repair"
+	"github.com/scylladb/scylla-manager/v3/pkg/store"
 	"github.com/scylladb/scylla-manager/v3/pkg/util/uuid"
 )
 
@@ -29,11 +30,12 @@ type Service struct {
 
 	scyllaClient   scyllaclient.ProviderFunc
 	clusterSession cluster.SessionFunc
+	secretsStore   store.Store
 	logger         log.Logger
 }
 
 func NewService(repairSvc *repair.Service, session gocqlx.Session, config Config, metrics metrics.RestoreMetrics,
-	scyllaClient scyllaclient.ProviderFunc, clusterSession cluster.SessionFunc, logger log.Logger,
+	scyllaClient scyllaclient.ProviderFunc, clusterSession cluster.SessionFunc, secretsStore store.Store, logger log.Logger,
 ) (*Service, error) {
 	if session.Session == nil || session.Closed() {
 		return nil, errors.New("invalid session")
@@ -53,6 +55,7 @@ func NewService(repairSvc *repair.Service, session gocqlx.Session, config Config
 		metrics:        metrics,
 		scyllaClient:   scyllaClient,
 		clusterSession: clusterSession,
+		secretsStore:   secretsStore,
 		logger:         logger,
 	}, nil
 }
@@ -209,6 +212,7 @@ func (s *Service) newWorker(ctx context.Context, clusterID uuid.UUID) (worker, e
 		client:         client,
 		session:        s.session,
 		clusterSession: clusterSession,
+		secretsStore:   s.secretsStore,
 	}, nil
 }
 
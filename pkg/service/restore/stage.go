@@ -9,14 +9,15 @@ type Stage string
 
 // Stage enumeration.
 const (
-	StageInit          Stage = "INIT"
-	StageDropViews     Stage = "DROP_VIEWS"
-	StageDisableTGC    Stage = "DISABLE_TGC"
-	StageData          Stage = "DATA"
-	StageRepair        Stage = "REPAIR"
-	StageEnableTGC     Stage = "ENABLE_TGC"
-	StageRecreateViews Stage = "RECREATE_VIEWS"
-	StageDone          Stage = "DONE"
+	StageInit            Stage = "INIT"
+	StageDropViews       Stage = "DROP_VIEWS"
+	StageDisableTGC      Stage = "DISABLE_TGC"
+	StageData            Stage = "DATA"
+	StageReplayCommitlog Stage = "REPLAY_COMMITLOG"
+	StageRepair          Stage = "REPAIR"
+	StageEnableTGC       Stage = "ENABLE_TGC"
+	StageRecreateViews   Stage = "RECREATE_VIEWS"
+	StageDone            Stage = "DONE"
 )
 
 // StageOrder lists all restore stages in the order of their execution.
@@ -26,6 +27,7 @@ func StageOrder() []Stage {
 		StageDropViews,
 		StageDisableTGC,
 		StageData,
+		StageReplayCommitlog,
 		StageRepair,
 		StageEnableTGC,
 		StageRecreateViews,
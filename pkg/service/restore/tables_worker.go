@@ -4,8 +4,6 @@ package restore
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 	"sync"
 
 	"github.com/pkg/errors"
@@ -113,6 +111,12 @@ func (w *tablesWorker) restore(ctx context.Context) error {
 		StageData: func() error {
 			return w.stageRestoreData(ctx)
 		},
+		StageReplayCommitlog: func() error {
+			if w.target.PointInTime.IsZero() {
+				return nil
+			}
+			return w.stageReplayCommitlog(ctx)
+		},
 		StageRepair: func() error {
 			return w.stageRepair(ctx)
 		},
@@ -209,7 +213,10 @@ func (w *tablesWorker) stageRestoreData(ctx context.Context) error {
 		}
 	}
 
-	bd := newBatchDispatcher(workload, w.target.BatchSize, hostToShard, w.target.locationHosts)
+	bd, err := newBatchDispatcher(workload, w.target.BatchSize, hostToShard, w.target.locationHosts, w.target.dcHosts)
+	if err != nil {
+		return errors.Wrap(err, "create batch dispatcher")
+	}
 
 	f := func(n int) error {
 		host := hosts[n]
@@ -268,19 +275,9 @@ func (w *tablesWorker) stageRestoreData(ctx context.Context) error {
 }
 
 func (w *tablesWorker) stageRepair(ctx context.Context) error {
-	var keyspace []string
-	for _, u := range w.run.Units {
-		for _, t := range u.Tables {
-			keyspace = append(keyspace, fmt.Sprintf("%s.%s", u.Keyspace, t.Table))
-		}
-	}
-	repairProps, err := json.Marshal(map[string]any{
-		"keyspace":  keyspace,
-		"intensity": 0,
-		"parallel":  0,
-	})
+	repairProps, err := repairProperties(w.run.Units)
 	if err != nil {
-		return errors.Wrap(err, "parse repair properties")
+		return err
 	}
 
 	repairTarget, err := w.repairSvc.GetTarget(ctx, w.run.ClusterID, repairProps)
@@ -5,6 +5,7 @@ package restore
 import (
 	"context"
 	"path"
+	"strings"
 	"time"
 
 	"github.com/pkg/errors"
@@ -32,6 +33,9 @@ func (w *tablesWorker) restoreBatch(ctx context.Context, b batch, pr *RunProgres
 	}
 
 	if !validateTimeIsSet(pr.RestoreCompletedAt) {
+		if err := w.verifyChecksums(ctx, b, pr); err != nil {
+			return errors.Wrap(err, "verify checksums")
+		}
 		if err := w.restoreSSTables(ctx, b, pr); err != nil {
 			return errors.Wrap(err, "call load and stream")
 		}
@@ -77,6 +81,43 @@ func (w *tablesWorker) waitJob(ctx context.Context, b batch, pr *RunProgress) (e
 	}
 }
 
+// verifyChecksums compares checksums of downloaded files with checksums
+// recorded in manifest, so that corrupted files are never loaded.
+// Files without recorded checksums are not verified.
+func (w *tablesWorker) verifyChecksums(ctx context.Context, b batch, pr *RunProgress) error {
+	var files []string
+	for _, sst := range b.SSTables {
+		for _, f := range sst.Files {
+			// Versioned files are downloaded without version extension
+			name, _ := SplitNameAndVersion(f)
+			if _, ok := b.Checksums[name]; ok {
+				files = append(files, name)
+			}
+		}
+	}
+	if len(files) == 0 {
+		return nil
+	}
+
+	uploadDir := UploadTableDir(b.Keyspace, b.Table, w.tableVersion[b.TableName])
+	checksums, err := w.client.RcloneChecksums(ctx, pr.Host, uploadDir, files, false)
+	if err != nil {
+		return err
+	}
+	var corrupted []string
+	for _, f := range files {
+		if checksums[f] != b.Checksums[f] {
+			corrupted = append(corrupted, f)
+		}
+	}
+	if len(corrupted) > 0 {
+		return errors.Errorf("checksum mismatch of files downloaded from %s: %s", b.RemoteSSTableDir, strings.Join(corrupted, ", "))
+	}
+
+	w.logger.Info(ctx, "Verified checksums of downloaded files", "host", pr.Host, "count", len(files))
+	return nil
+}
+
 func (w *tablesWorker) restoreSSTables(ctx context.Context, b batch, pr *RunProgress) error {
 	w.onLasStart(ctx, b, pr)
 	err := w.worker.restoreSSTables(ctx, pr.Host, pr.Keyspace, pr.Table, true, true)
@@ -123,6 +164,10 @@ func (w *tablesWorker) newRunProgress(ctx context.Context, hi HostInfo, b batch)
 // It returns jobID for asynchronous download of the newest versions of files
 // alongside with the size of the already downloaded versioned files.
 func (w *tablesWorker) startDownload(ctx context.Context, hi HostInfo, b batch) (jobID, versionedPr int64, err error) {
+	ctx, err = w.backupFilesContext(ctx, b.ClusterID, b.EncryptionKeyID, b.Compression)
+	if err != nil {
+		return 0, 0, err
+	}
 	uploadDir := UploadTableDir(b.Keyspace, b.Table, w.tableVersion[b.TableName])
 	sstables := b.NotVersionedSSTables()
 	versioned := b.VersionedSSTables()
@@ -0,0 +1,105 @@
+// Copyright (C) 2024 ScyllaDB
+
+package restore
+
+import (
+	"context"
+	"path"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/scylladb/scylla-manager/v3/pkg/dht"
+	. "github.com/scylladb/scylla-manager/v3/pkg/service/backup/backupspec"
+	"github.com/scylladb/scylla-manager/v3/pkg/sstable"
+	"github.com/scylladb/scylla-manager/v3/pkg/util/parallel"
+)
+
+// maxParallelSummaryReads limits number of Summary.db files read in parallel.
+const maxParallelSummaryReads = 16
+
+// filterSSTablesByTokenRanges returns sstables containing partitions from
+// restored token ranges. Token range of sstable is based on its first and
+// last partition key read from Summary.db. SSTables are restored as a whole,
+// so restored data might still contain partitions from outside of restored
+// token ranges.
+func (w *tablesWorker) filterSSTablesByTokenRanges(ctx context.Context, host, remoteDir string, m ManifestInfoWithContent,
+	sstables []RemoteSSTable,
+) ([]RemoteSSTable, error) {
+	ctx, err := w.backupFilesContext(ctx, m.ClusterID, m.EncryptionKeyID, m.Compression)
+	if err != nil {
+		return nil, err
+	}
+
+	overlaps := make([]bool, len(sstables))
+	f := func(i int) error {
+		summary, ok := summaryFile(sstables[i])
+		if !ok {
+			// Token range of sstable is unknown, it has to be restored
+			overlaps[i] = true
+			return nil
+		}
+		first, last, err := w.sstableTokenRange(ctx, host, path.Join(remoteDir, summary))
+		if err != nil {
+			return errors.Wrapf(err, "read token range of sstable %s", sstables[i].ID)
+		}
+		overlaps[i] = overlapsTokenRanges(w.target.TokenRanges, first, last)
+		return nil
+	}
+	notify := func(i int, err error) {
+		w.logger.Error(ctx, "Failed to read token range of sstable",
+			"dir", remoteDir,
+			"sstable", sstables[i].ID,
+			"error", err,
+		)
+	}
+	if err := parallel.Run(len(sstables), maxParallelSummaryReads, f, notify); err != nil {
+		return nil, err
+	}
+
+	var out []RemoteSSTable
+	for i := range sstables {
+		if overlaps[i] {
+			out = append(out, sstables[i])
+		}
+	}
+	w.logger.Info(ctx, "Filtered sstables by token ranges",
+		"dir", remoteDir,
+		"all", len(sstables),
+		"restored", len(out),
+	)
+	return out, nil
+}
+
+// sstableTokenRange returns tokens of the first and last partition of sstable.
+func (w *tablesWorker) sstableTokenRange(ctx context.Context, host, summaryPath string) (first, last int64, err error) {
+	r, err := w.client.RcloneOpen(ctx, host, summaryPath)
+	if err != nil {
+		return 0, 0, err
+	}
+	defer r.Close()
+
+	firstKey, lastKey, err := sstable.ReadSummaryKeys(r)
+	if err != nil {
+		return 0, 0, errors.Wrapf(err, "parse %s", summaryPath)
+	}
+	return dht.Murmur3Token(firstKey), dht.Murmur3Token(lastKey), nil
+}
+
+func summaryFile(sst RemoteSSTable) (string, bool) {
+	for _, f := range sst.Files {
+		// File name might contain versioned snapshot tag extension
+		if strings.Contains(f, "-Summary.db") {
+			return f, true
+		}
+	}
+	return "", false
+}
+
+func overlapsTokenRanges(ranges []dht.TokenRange, first, last int64) bool {
+	for _, tr := range ranges {
+		if tr.Overlaps(first, last) {
+			return true
+		}
+	}
+	return false
+}
@@ -22,6 +22,7 @@ import (
 	"github.com/scylladb/scylla-manager/v3/pkg/schema/table"
 	"github.com/scylladb/scylla-manager/v3/pkg/scyllaclient"
 	. "github.com/scylladb/scylla-manager/v3/pkg/service/backup/backupspec"
+	"github.com/scylladb/scylla-manager/v3/pkg/store"
 	"github.com/scylladb/scylla-manager/v3/pkg/util/query"
 	"github.com/scylladb/scylla-manager/v3/pkg/util/retry"
 	"github.com/scylladb/scylla-manager/v3/pkg/util/timeutc"
@@ -42,6 +43,7 @@ type worker struct {
 	client         *scyllaclient.Client
 	session        gocqlx.Session
 	clusterSession gocqlx.Session
+	secretsStore   store.Store
 }
 
 func (w *worker) randomHostFromLocation(loc Location) string {
@@ -76,6 +78,12 @@ func (w *worker) initTarget(ctx context.Context, properties json.RawMessage) err
 		return err
 	}
 
+	if t.renames, err = parseRenameMapping(t.Rename); err != nil {
+		return err
+	}
+	if t.dcMappings, err = parseDCMapping(t.DCMapping, dcMap); err != nil {
+		return err
+	}
 	if t.Keyspace == nil {
 		t.Keyspace = []string{"*"}
 	}
@@ -101,6 +109,12 @@ func (w *worker) initTarget(ctx context.Context, properties json.RawMessage) err
 		return errors.Wrap(err, "verify all nodes availability")
 	}
 
+	// Data of mapped DC is restored by hosts from the DC it's mapped to
+	t.dcHosts = make(map[string][]string)
+	for srcDC, dstDC := range t.dcMappings {
+		t.dcHosts[srcDC] = status.Datacenter([]string{dstDC}).Hosts()
+	}
+
 	allLocations := strset.New()
 	locationHosts := make(map[Location][]string)
 	for _, l := range t.Location {
@@ -144,16 +158,34 @@ func (w *worker) initTarget(ctx context.Context, properties json.RawMessage) err
 	t.sortLocations()
 
 	w.target = t
+	if !t.PointInTime.IsZero() {
+		tag, err := w.snapshotTagAt(ctx, t.PointInTime)
+		if err != nil {
+			return errors.Wrap(err, "find snapshot preceding point in time")
+		}
+		w.logger.Info(ctx, "Found snapshot preceding point in time",
+			"point_in_time", t.PointInTime,
+			"snapshot_tag", tag,
+		)
+		t.SnapshotTag = tag
+		w.target = t
+	}
 	w.run.SnapshotTag = t.SnapshotTag
 
 	if t.RestoreSchema {
 		w.logger.Info(ctx, "Look for schema file")
-		w.describedSchema, err = getDescribedSchema(ctx, w.client, t.SnapshotTag, locationHosts)
+		w.describedSchema, err = getDescribedSchema(ctx, w.client, t.SnapshotTag, locationHosts, w.encryptionKeyContext)
 		if err != nil {
 			return errors.Wrap(err, "look for schema file")
 		}
 
 		if w.describedSchema == nil {
+			if !t.renames.empty() {
+				return errors.New("renaming keyspaces and tables requires backup with schema file, it's not supported when restoring schema from sstables")
+			}
+			if len(t.dcMappings) > 0 {
+				return errors.New("DC mapping requires backup with schema file, it's not supported when restoring schema from sstables")
+			}
 			w.logger.Info(ctx, "Couldn't find schema file. Proceeding with schema restoration using sstables")
 			if err := IsRestoreSchemaFromSSTablesSupported(ctx, w.client); err != nil {
 				return errors.Wrap(err, "check safety of restoring schema from sstables")
@@ -339,35 +371,49 @@ func (w *worker) initUnits(ctx context.Context) error {
 	var (
 		units   []Unit
 		unitMap = make(map[string]Unit)
+		// Maps restored table to backed up table
+		srcTable = make(map[TableName]TableName)
+		// Backed up DCs
+		backupDCs = strset.New()
 	)
 
 	var foundManifest bool
 	for _, l := range w.target.Location {
 		manifestHandler := func(miwc ManifestInfoWithContent) error {
 			foundManifest = true
+			backupDCs.Add(miwc.DC)
+
+			filesHandler := func(fm FilesMeta) error {
+				// Units describe tables in the restored cluster
+				src := TableName{Keyspace: fm.Keyspace, Table: fm.Table}
+				dst := w.target.renames.tableName(src)
+				if prev, ok := srcTable[dst]; ok && prev != src {
+					return errors.Errorf("tables %s and %s are both restored to %s", prev, src, dst)
+				}
+				srcTable[dst] = src
 
-			filesHandler := func(fm FilesMeta) {
-				ru := unitMap[fm.Keyspace]
-				ru.Keyspace = fm.Keyspace
+				ru := unitMap[dst.Keyspace]
+				ru.Keyspace = dst.Keyspace
 				ru.Size += fm.Size
 
 				for i, t := range ru.Tables {
-					if t.Table == fm.Table {
+					if t.Table == dst.Table {
 						ru.Tables[i].Size += fm.Size
-						unitMap[fm.Keyspace] = ru
+						unitMap[dst.Keyspace] = ru
 
-						return
+						return nil
 					}
 				}
 
 				ru.Tables = append(ru.Tables, Table{
-					Table: fm.Table,
+					Table: dst.Table,
 					Size:  fm.Size,
 				})
-				unitMap[fm.Keyspace] = ru
+				unitMap[dst.Keyspace] = ru
+				return nil
 			}
 
-			return miwc.ForEachIndexIter(w.target.Keyspace, filesHandler)
+			return miwc.ForEachIndexIterWithError(w.target.Keyspace, filesHandler)
 		}
 
 		if err := w.forEachManifest(ctx, l, manifestHandler); err != nil {
@@ -378,6 +424,11 @@ func (w *worker) initUnits(ctx context.Context) error {
 	if !foundManifest {
 		return errors.Errorf("no snapshot with tag %s", w.run.SnapshotTag)
 	}
+	for dc := range w.target.dcMappings {
+		if !backupDCs.Has(dc) {
+			return errors.Errorf("mapped datacenter %s is not a part of restored backup", dc)
+		}
+	}
 
 	for _, u := range unitMap {
 		units = append(units, u)
@@ -395,10 +446,11 @@ func (w *worker) initUnits(ctx context.Context) error {
 		for i, t := range u.Tables {
 			// Verify that table exists
 			if !slices.Contains(tables[u.Keyspace], t.Table) {
+				src := srcTable[TableName{Keyspace: u.Keyspace, Table: t.Table}]
 				return errors.Errorf(
 					"table %s.%s, which is a part of restored backup, is missing in the restored cluster. "+
-						"Please either exclude it from the restore (--keyspace '*,!%s.%s'), or first restore its schema (--restore-schema)",
-					u.Keyspace, t.Table, u.Keyspace, t.Table,
+						"Please either exclude it from the restore (--keyspace '*,!%s'), or first restore its schema (--restore-schema)",
+					u.Keyspace, t.Table, src,
 				)
 			}
 			// Collect table tombstone_gc
@@ -556,6 +608,12 @@ func (w *worker) cleanUploadDir(ctx context.Context, host, dir string, excluded
 }
 
 func (w *worker) restoreSSTables(ctx context.Context, host, keyspace, table string, loadAndStream, primaryReplicaOnly bool) error {
+	if loadAndStream {
+		if err := w.waitNotOverloaded(ctx, host); err != nil {
+			return err
+		}
+	}
+
 	w.logger.Info(ctx, "Load SSTables for the first time",
 		"host", host,
 		"load_and_stream", loadAndStream,
@@ -581,6 +639,35 @@ func (w *worker) restoreSSTables(ctx context.Context, host, keyspace, table stri
 	return indefiniteHangingRetryWrapper(ctx, op, notify)
 }
 
+// waitNotOverloaded pauses load&stream while host reports that it exceeds
+// adaptive throttling thresholds configured in agent.
+// Hosts with adaptive throttling disabled or not supported are never waited for.
+func (w *worker) waitNotOverloaded(ctx context.Context, host string) error {
+	op := func() error {
+		s, err := w.client.ThrottleStatus(ctx, host)
+		if err != nil {
+			w.logger.Debug(ctx, "Failed to get throttle status", "host", host, "error", err)
+			return nil
+		}
+		if s.Overloaded {
+			return errors.New(s.Reason)
+		}
+		return nil
+	}
+	if op() == nil {
+		return nil
+	}
+
+	notify := func(err error) {
+		w.logger.Info(ctx, "Waiting for host load to go down before load&stream",
+			"host", host,
+			"reason", err,
+		)
+	}
+
+	return indefiniteHangingRetryWrapper(ctx, op, notify)
+}
+
 // indefiniteHangingRetryWrapper is useful when waiting on
 // Scylla operation that might take a really long (and difficult to estimate) time.
 // This wrapper exits ONLY on: success, context cancel, op returned retry.IsPermanent error.
@@ -0,0 +1,216 @@
+// Copyright (C) 2024 ScyllaDB
+
+package restore
+
+import (
+	"context"
+	"path"
+	"sort"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/scylladb/go-set/strset"
+	"github.com/scylladb/scylla-manager/v3/pkg/scyllaclient"
+	. "github.com/scylladb/scylla-manager/v3/pkg/service/backup/backupspec"
+	"github.com/scylladb/scylla-manager/v3/pkg/util/uuid"
+)
+
+// commitlogReplayDir is the directory (relative to Scylla data directory)
+// to which archived commitlog segments are downloaded before replay.
+const commitlogReplayDir = "commitlog_replay"
+
+// snapshotTagAt returns tag of the latest snapshot available in target locations
+// that was taken not later than the point in time.
+func (w *worker) snapshotTagAt(ctx context.Context, pointInTime time.Time) (string, error) {
+	tags := strset.New()
+	for _, l := range w.target.Location {
+		host, err := w.closestHostFromLocation(l)
+		if err != nil {
+			return "", err
+		}
+		err = w.forEachManifestInfo(ctx, host, l, func(m *ManifestInfo) {
+			if !m.Temporary {
+				tags.Add(m.SnapshotTag)
+			}
+		})
+		if err != nil {
+			return "", errors.Wrapf(err, "list manifests in location %s", l)
+		}
+	}
+
+	tag := closestSnapshotTag(tags.List(), pointInTime)
+	if tag == "" {
+		return "", errors.Errorf("no snapshot taken before %s", pointInTime)
+	}
+	return tag, nil
+}
+
+// closestSnapshotTag returns the latest of the tags that was taken
+// not later than the point in time, or empty string if there is no such tag.
+func closestSnapshotTag(tags []string, pointInTime time.Time) string {
+	var (
+		out     string
+		outTime time.Time
+	)
+	for _, tag := range tags {
+		t, err := SnapshotTagTime(tag)
+		if err != nil || t.After(pointInTime) {
+			continue
+		}
+		if out == "" || t.After(outTime) {
+			out = tag
+			outTime = t
+		}
+	}
+	return out
+}
+
+// stageReplayCommitlog replays commitlog segments archived between
+// the restored snapshot and the point in time.
+func (w *tablesWorker) stageReplayCommitlog(ctx context.Context) error {
+	snapshotTime, err := SnapshotTagTime(w.run.SnapshotTag)
+	if err != nil {
+		return err
+	}
+
+	w.logger.Info(ctx, "Started replaying commitlog",
+		"snapshot_tag", w.run.SnapshotTag,
+		"point_in_time", w.target.PointInTime,
+	)
+	defer w.logger.Info(ctx, "Replaying commitlog finished")
+
+	for _, l := range w.target.Location {
+		host, err := w.closestHostFromLocation(l)
+		if err != nil {
+			return err
+		}
+
+		// Commitlog is archived per source cluster
+		manifests, err := w.getManifestInfo(ctx, host, l)
+		if err != nil {
+			return errors.Wrapf(err, "list manifests in location %s", l)
+		}
+		clusterIDs := make(map[uuid.UUID]struct{})
+		for _, m := range manifests {
+			clusterIDs[m.ClusterID] = struct{}{}
+		}
+
+		for clusterID := range clusterIDs {
+			segments, err := w.listCommitlogSegments(ctx, host, l, clusterID)
+			if err != nil {
+				return errors.Wrapf(err, "list commitlog segments in location %s", l)
+			}
+			segments = filterCommitlogSegments(segments, snapshotTime, w.target.PointInTime)
+			if len(segments) == 0 {
+				w.logger.Info(ctx, "No archived commitlog segments to replay",
+					"location", l,
+					"cluster_id", clusterID,
+				)
+				continue
+			}
+
+			for _, nodeSegments := range groupCommitlogSegmentsByNode(segments) {
+				if err := w.replayNodeCommitlog(ctx, l, nodeSegments); err != nil {
+					return errors.Wrapf(err, "replay commitlog of node %s", nodeSegments[0].NodeID)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// replayNodeCommitlog downloads segments archived by a single node
+// to a random host with access to the location and replays them there.
+func (w *tablesWorker) replayNodeCommitlog(ctx context.Context, location Location, segments []*CommitlogSegmentInfo) error {
+	host := w.randomHostFromLocation(location)
+	if err := w.checkAvailableDiskSpace(ctx, host); err != nil {
+		return errors.Wrapf(err, "validate free disk space on host %s", host)
+	}
+
+	dir := path.Join(commitlogReplayDir, segments[0].NodeID)
+	defer func() {
+		if err := w.client.RcloneDeleteDir(context.Background(), host, DataDir+dir); err != nil {
+			w.logger.Error(ctx, "Failed to clean commitlog replay directory",
+				"host", host,
+				"dir", dir,
+				"error", err,
+			)
+		}
+	}()
+
+	w.logger.Info(ctx, "Download commitlog segments",
+		"host", host,
+		"node_id", segments[0].NodeID,
+		"segments", len(segments),
+	)
+	for _, s := range segments {
+		if err := w.client.RcloneCopyFile(ctx, host, path.Join(DataDir+dir, s.Segment), location.RemotePath(s.Path())); err != nil {
+			return errors.Wrapf(err, "download %s", s.Path())
+		}
+	}
+
+	w.logger.Info(ctx, "Replay commitlog segments", "host", host, "dir", dir)
+	return errors.Wrapf(w.client.ReplayCommitlog(ctx, host, dir), "replay on host %s", host)
+}
+
+// listCommitlogSegments returns all commitlog segments archived by the cluster in the location.
+func (w *worker) listCommitlogSegments(ctx context.Context, host string, location Location, clusterID uuid.UUID) ([]*CommitlogSegmentInfo, error) {
+	baseDir := RemoteCommitlogClusterDir(clusterID)
+	opts := scyllaclient.RcloneListDirOpts{
+		FilesOnly: true,
+		Recurse:   true,
+	}
+
+	var segments []*CommitlogSegmentInfo
+	err := w.client.RcloneListDirIter(ctx, host, location.RemotePath(baseDir), &opts, func(item *scyllaclient.RcloneListDirItem) {
+		s := new(CommitlogSegmentInfo)
+		if err := s.ParsePath(path.Join(baseDir, item.Path)); err != nil {
+			return
+		}
+		s.Location = location
+		segments = append(segments, s)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return segments, nil
+}
+
+// filterCommitlogSegments returns segments sealed after the snapshot was taken
+// and not later than the point in time. Segment which was active at the point in time
+// is not included, so mutations written after the last seal preceding the point in time
+// are not restored and the recovery point objective is the segment seal interval.
+func filterCommitlogSegments(segments []*CommitlogSegmentInfo, snapshotTime, pointInTime time.Time) []*CommitlogSegmentInfo {
+	var out []*CommitlogSegmentInfo
+	for _, s := range segments {
+		if s.SealedAt.After(snapshotTime) && !s.SealedAt.After(pointInTime) {
+			out = append(out, s)
+		}
+	}
+	return out
+}
+
+// groupCommitlogSegmentsByNode groups segments by the node that archived them.
+// Groups and segments within them are sorted for deterministic replay order.
+func groupCommitlogSegmentsByNode(segments []*CommitlogSegmentInfo) [][]*CommitlogSegmentInfo {
+	m := make(map[string][]*CommitlogSegmentInfo)
+	for _, s := range segments {
+		m[s.NodeID] = append(m[s.NodeID], s)
+	}
+
+	nodes := make([]string, 0, len(m))
+	for n := range m {
+		nodes = append(nodes, n)
+	}
+	sort.Strings(nodes)
+
+	out := make([][]*CommitlogSegmentInfo, 0, len(nodes))
+	for _, n := range nodes {
+		g := m[n]
+		sort.Slice(g, func(i, j int) bool {
+			return g[i].SealedAt.Before(g[j].SealedAt)
+		})
+		out = append(out, g)
+	}
+	return out
+}
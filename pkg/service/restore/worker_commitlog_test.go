@@ -0,0 +1,92 @@
+// Copyright (C) 2024 ScyllaDB
+
+package restore
+
+import (
+	"testing"
+	"time"
+
+	"github.com/scylladb/scylla-manager/v3/pkg/service/backup/backupspec"
+)
+
+func TestClosestSnapshotTag(t *testing.T) {
+	t0 := time.Date(2024, 5, 20, 8, 0, 0, 0, time.UTC)
+	tags := []string{
+		backupspec.SnapshotTagAt(t0.Add(2 * time.Hour)),
+		backupspec.SnapshotTagAt(t0),
+		backupspec.SnapshotTagAt(t0.Add(time.Hour)),
+		"not_a_tag",
+	}
+
+	testCases := []struct {
+		name        string
+		pointInTime time.Time
+		expected    string
+	}{
+		{
+			name:        "before all snapshots",
+			pointInTime: t0.Add(-time.Second),
+			expected:    "",
+		},
+		{
+			name:        "exactly at snapshot",
+			pointInTime: t0.Add(time.Hour),
+			expected:    backupspec.SnapshotTagAt(t0.Add(time.Hour)),
+		},
+		{
+			name:        "between snapshots",
+			pointInTime: t0.Add(90 * time.Minute),
+			expected:    backupspec.SnapshotTagAt(t0.Add(time.Hour)),
+		},
+		{
+			name:        "after all snapshots",
+			pointInTime: t0.Add(24 * time.Hour),
+			expected:    backupspec.SnapshotTagAt(t0.Add(2 * time.Hour)),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := closestSnapshotTag(tags, tc.pointInTime); got != tc.expected {
+				t.Fatalf("closestSnapshotTag() = %q, expected %q", got, tc.expected)
+			}
+		})
+	}
+}
+
+func TestFilterAndGroupCommitlogSegments(t *testing.T) {
+	t0 := time.Date(2024, 5, 20, 8, 0, 0, 0, time.UTC)
+	segment := func(node string, sealedAt time.Time) *backupspec.CommitlogSegmentInfo {
+		return &backupspec.CommitlogSegmentInfo{
+			NodeID:   node,
+			Segment:  "CommitLog-2-1.log",
+			SealedAt: sealedAt,
+		}
+	}
+
+	segments := []*backupspec.CommitlogSegmentInfo{
+		segment("n2", t0.Add(20*time.Minute)),
+		segment("n1", t0),
+		segment("n1", t0.Add(30*time.Minute)),
+		segment("n1", t0.Add(10*time.Minute)),
+		segment("n2", t0.Add(2*time.Hour)),
+	}
+
+	groups := groupCommitlogSegmentsByNode(filterCommitlogSegments(segments, t0, t0.Add(time.Hour)))
+	if len(groups) != 2 {
+		t.Fatalf("Expected 2 groups, got %d", len(groups))
+	}
+
+	n1 := groups[0]
+	if len(n1) != 2 || n1[0].NodeID != "n1" {
+		t.Fatalf("Expected 2 segments of n1, got %v", n1)
+	}
+	if !n1[0].SealedAt.Equal(t0.Add(10*time.Minute)) || !n1[1].SealedAt.Equal(t0.Add(30*time.Minute)) {
+		t.Fatalf("Expected n1 segments sorted by seal time, got %v, %v", n1[0].SealedAt, n1[1].SealedAt)
+	}
+
+	n2 := groups[1]
+	if len(n2) != 1 || n2[0].NodeID != "n2" || !n2[0].SealedAt.Equal(t0.Add(20*time.Minute)) {
+		t.Fatalf("Expected single n2 segment sealed at %v, got %v", t0.Add(20*time.Minute), n2)
+	}
+}
@@ -16,21 +16,9 @@ import (
 )
 
 func (w *worker) forEachManifest(ctx context.Context, location Location, f func(ManifestInfoWithContent) error) error {
-	closest := w.client.Config().Hosts
-	hosts, ok := w.target.locationHosts[location]
-	if !ok {
-		return fmt.Errorf("no hosts for location %s", location)
-	}
-
-	var host string
-	for _, h := range closest {
-		if slice.ContainsString(hosts, h) {
-			host = h
-			break
-		}
-	}
-	if host == "" {
-		host = hosts[0]
+	host, err := w.closestHostFromLocation(location)
+	if err != nil {
+		return err
 	}
 
 	manifests, err := w.getManifestInfo(ctx, host, location)
@@ -40,7 +28,12 @@ func (w *worker) forEachManifest(ctx context.Context, location Location, f func(
 
 	// Load manifest content
 	load := func(c *ManifestContentWithIndex, m *ManifestInfo) error {
-		r, err := w.client.RcloneOpen(ctx, host, m.Location.RemotePath(m.Path()))
+		// Manifests of encrypted backups are encrypted as well
+		mctx, err := w.encryptionKeyContext(ctx, m.ClusterID)
+		if err != nil {
+			return err
+		}
+		r, err := w.client.RcloneOpen(mctx, host, m.Location.RemotePath(m.Path()))
 		if err != nil {
 			return err
 		}
@@ -64,21 +57,27 @@ func (w *worker) forEachManifest(ctx context.Context, location Location, f func(
 	return nil
 }
 
-// getManifestInfo returns manifests with receiver's snapshot tag for all nodes in the location.
-func (w *worker) getManifestInfo(ctx context.Context, host string, location Location) ([]*ManifestInfo, error) {
-	baseDir := path.Join("backup", string(MetaDirKind))
-	opts := scyllaclient.RcloneListDirOpts{
-		FilesOnly: true,
-		Recurse:   true,
+// closestHostFromLocation returns host with access to the location
+// that is the closest one to Scylla Manager.
+func (w *worker) closestHostFromLocation(location Location) (string, error) {
+	closest := w.client.Config().Hosts
+	hosts, ok := w.target.locationHosts[location]
+	if !ok {
+		return "", fmt.Errorf("no hosts for location %s", location)
 	}
 
-	var manifests []*ManifestInfo
-	err := w.client.RcloneListDirIter(ctx, host, location.RemotePath(baseDir), &opts, func(f *scyllaclient.RcloneListDirItem) {
-		m := new(ManifestInfo)
-		if err := m.ParsePath(path.Join(baseDir, f.Path)); err != nil {
-			return
+	for _, h := range closest {
+		if slice.ContainsString(hosts, h) {
+			return h, nil
 		}
-		m.Location = location
+	}
+	return hosts[0], nil
+}
+
+// getManifestInfo returns manifests with receiver's snapshot tag for all nodes in the location.
+func (w *worker) getManifestInfo(ctx context.Context, host string, location Location) ([]*ManifestInfo, error) {
+	var manifests []*ManifestInfo
+	err := w.forEachManifestInfo(ctx, host, location, func(m *ManifestInfo) {
 		if m.SnapshotTag == w.run.SnapshotTag {
 			manifests = append(manifests, m)
 		}
@@ -93,3 +92,21 @@ func (w *worker) getManifestInfo(ctx context.Context, host string, location Loca
 	})
 	return manifests, nil
 }
+
+// forEachManifestInfo calls f for all manifests (regardless of snapshot tag) in the location.
+func (w *worker) forEachManifestInfo(ctx context.Context, host string, location Location, f func(m *ManifestInfo)) error {
+	baseDir := path.Join("backup", string(MetaDirKind))
+	opts := scyllaclient.RcloneListDirOpts{
+		FilesOnly: true,
+		Recurse:   true,
+	}
+
+	return w.client.RcloneListDirIter(ctx, host, location.RemotePath(baseDir), &opts, func(item *scyllaclient.RcloneListDirItem) {
+		m := new(ManifestInfo)
+		if err := m.ParsePath(path.Join(baseDir, item.Path)); err != nil {
+			return
+		}
+		m.Location = location
+		f(m)
+	})
+}
@@ -13,5 +13,6 @@ func details(t *Task) scheduler.Details {
 		Backoff:    t.Sched.backoff(),
 		Window:     t.Sched.Window.Window(),
 		Location:   t.Sched.Timezone.Location(),
+		NoBlackout: t.Type == HealthCheckTask || t.Type == SuspendTask,
 	}
 }
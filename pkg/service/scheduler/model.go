@@ -228,6 +228,12 @@ type Task struct {
 	Properties json.RawMessage   `json:"properties,omitempty"`
 	Tags       []string
 
+	RunAfter          *uuid.UUID        `json:"run_after,omitempty"`
+	RunAfterCondition RunAfterCondition `json:"run_after_condition,omitempty"`
+
+	Notify           []string          `json:"notify,omitempty"`
+	NotifyStaleAfter duration.Duration `json:"notify_stale_after,omitempty"`
+
 	Status       Status     `json:"status"`
 	SuccessCount int        `json:"success_count"`
 	ErrorCount   int        `json:"error_count"`
@@ -261,10 +267,70 @@ func (t *Task) Validate() error {
 		var tp TaskType
 		errs = multierr.Append(errs, tp.UnmarshalText([]byte(t.Type)))
 	}
+	if t.RunAfter != nil && *t.RunAfter == t.ID {
+		errs = multierr.Append(errs, errors.New("task cannot run after itself"))
+	}
+	if t.RunAfter == nil && t.RunAfterCondition != "" {
+		errs = multierr.Append(errs, errors.New("run after condition requires run after task"))
+	}
+	if t.NotifyStaleAfter < 0 {
+		errs = multierr.Append(errs, errors.New("notify stale after cannot be negative"))
+	}
 
 	return util.ErrValidate(errors.Wrap(errs, "invalid task"))
 }
 
+// RunAfterCondition specifies which run outcomes of the preceding task
+// trigger the dependent task.
+type RunAfterCondition string
+
+// RunAfterCondition enumeration.
+const (
+	RunAfterOnSuccess RunAfterCondition = "on_success"
+	RunAfterOnError   RunAfterCondition = "on_error"
+	RunAfterAlways    RunAfterCondition = "always"
+)
+
+func (c RunAfterCondition) String() string {
+	return string(c)
+}
+
+func (c RunAfterCondition) MarshalText() (text []byte, err error) {
+	return []byte(c.String()), nil
+}
+
+func (c *RunAfterCondition) UnmarshalText(text []byte) error {
+	switch RunAfterCondition(text) {
+	case "":
+		*c = ""
+	case RunAfterOnSuccess:
+		*c = RunAfterOnSuccess
+	case RunAfterOnError:
+		*c = RunAfterOnError
+	case RunAfterAlways:
+		*c = RunAfterAlways
+	default:
+		return fmt.Errorf("unrecognized RunAfterCondition %q", text)
+	}
+	return nil
+}
+
+// Satisfied returns true if preceding task run ending with status s
+// should trigger the dependent task.
+// Stopped, aborted and out of window runs never trigger dependent tasks.
+func (c RunAfterCondition) Satisfied(s Status) bool {
+	switch c {
+	case RunAfterOnSuccess:
+		return s == StatusDone
+	case RunAfterOnError:
+		return s == StatusError
+	case RunAfterAlways:
+		return s == StatusDone || s == StatusError
+	default:
+		return false
+	}
+}
+
 // Status specifies the status of a Task.
 type Status string
 
@@ -355,3 +421,36 @@ func newRunFromTaskInfo(ti taskInfo) *Run {
 		Status:    StatusRunning,
 	}
 }
+
+// Blackout is a period of time when tasks of a cluster are not run.
+// Tasks running when blackout begins are paused and continued after it ends,
+// activations falling into blackout are deferred until it ends.
+type Blackout struct {
+	ClusterID uuid.UUID `json:"cluster_id"`
+	ID        uuid.UUID `json:"id"`
+	StartTime time.Time `json:"start_time"`
+	EndTime   time.Time `json:"end_time"`
+	Reason    string    `json:"reason,omitempty"`
+}
+
+func (b *Blackout) Validate() error {
+	if b == nil {
+		return util.ErrNilPtr
+	}
+
+	var errs error
+	if b.ID == uuid.Nil {
+		errs = multierr.Append(errs, errors.New("missing ID"))
+	}
+	if b.ClusterID == uuid.Nil {
+		errs = multierr.Append(errs, errors.New("missing ClusterID"))
+	}
+	if b.StartTime.IsZero() {
+		errs = multierr.Append(errs, errors.New("missing start time"))
+	}
+	if !b.EndTime.After(b.StartTime) {
+		errs = multierr.Append(errs, errors.New("end time must be after start time"))
+	}
+
+	return util.ErrValidate(errors.Wrap(errs, "invalid blackout"))
+}
@@ -208,3 +208,45 @@ func TestScheduleBackoff(t *testing.T) {
 		}
 	}
 }
+
+func TestRunAfterConditionSatisfied(t *testing.T) {
+	table := []struct {
+		Condition RunAfterCondition
+		Status    Status
+		Satisfied bool
+	}{
+		{Condition: RunAfterOnSuccess, Status: StatusDone, Satisfied: true},
+		{Condition: RunAfterOnSuccess, Status: StatusError},
+		{Condition: RunAfterOnError, Status: StatusDone},
+		{Condition: RunAfterOnError, Status: StatusError, Satisfied: true},
+		{Condition: RunAfterAlways, Status: StatusDone, Satisfied: true},
+		{Condition: RunAfterAlways, Status: StatusError, Satisfied: true},
+		{Condition: RunAfterAlways, Status: StatusStopped},
+		{Condition: RunAfterAlways, Status: StatusWaiting},
+		{Condition: RunAfterAlways, Status: StatusAborted},
+		{Condition: "", Status: StatusDone},
+	}
+
+	for _, test := range table {
+		if s := test.Condition.Satisfied(test.Status); s != test.Satisfied {
+			t.Errorf("%q.Satisfied(%s) = %v, expected %v", test.Condition, test.Status, s, test.Satisfied)
+		}
+	}
+}
+
+func TestRunAfterConditionUnmarshalText(t *testing.T) {
+	for _, golden := range []RunAfterCondition{RunAfterOnSuccess, RunAfterOnError, RunAfterAlways} {
+		var v RunAfterCondition
+		if err := v.UnmarshalText([]byte(golden)); err != nil {
+			t.Fatal("UnmarshalText() error", err)
+		}
+		if v != golden {
+			t.Fatal(v)
+		}
+	}
+
+	var v RunAfterCondition
+	if err := v.UnmarshalText([]byte("on_stop")); err == nil {
+		t.Fatal("UnmarshalText() expected error")
+	}
+}
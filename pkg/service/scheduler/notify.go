@@ -0,0 +1,193 @@
+// Copyright (C) 2024 ScyllaDB
+
+package scheduler
+
+import (
+	"context"
+	"time"
+
+	"github.com/scylladb/scylla-manager/v3/pkg/schema/table"
+	"github.com/scylladb/scylla-manager/v3/pkg/service/notify"
+	"github.com/scylladb/scylla-manager/v3/pkg/util/uuid"
+)
+
+// Notifier is informed about task run outcomes that require attention.
+type Notifier interface {
+	Notify(ctx context.Context, e notify.Event)
+}
+
+// SetNotifier sets notifier informed about failed, aborted and recovered
+// task runs. Stale tasks are reported by WatchStaleTasks, staleAfter is used
+// for scheduled tasks that do not specify their own stale time,
+// zero disables the check for such tasks.
+func (s *Service) SetNotifier(n Notifier, staleAfter time.Duration) {
+	s.mu.Lock()
+	s.notifier = n
+	s.staleAfter = staleAfter
+	s.mu.Unlock()
+}
+
+func (s *Service) notifierAndStaleAfter() (Notifier, time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.notifier, s.staleAfter
+}
+
+func notifyTask(tt TaskType) bool {
+	return tt != HealthCheckTask && tt != SuspendTask
+}
+
+// prevStatusForNotify returns the status of the last task run, it must be
+// called before the new run is recorded. It returns empty status if task run
+// outcomes are not reported.
+func (s *Service) prevStatusForNotify(ctx context.Context, ti taskInfo) Status {
+	if n, _ := s.notifierAndStaleAfter(); n == nil || !notifyTask(ti.TaskType) {
+		return ""
+	}
+
+	t := Task{
+		ClusterID: ti.ClusterID,
+		Type:      ti.TaskType,
+		ID:        ti.TaskID,
+	}
+	q := table.SchedulerTask.GetQuery(s.session, "status").BindStruct(&t)
+	if err := q.GetRelease(&t); err != nil {
+		s.logger.Error(ctx, "Cannot get task status", "task", ti, "error", err)
+	}
+	return t.Status
+}
+
+// notifyRunEnded informs notifier about run that ended with error or
+// abort, or that succeeded after such run.
+func (s *Service) notifyRunEnded(ctx context.Context, ti taskInfo, r *Run, prevStatus Status) {
+	n, _ := s.notifierAndStaleAfter()
+	if n == nil || !notifyTask(ti.TaskType) {
+		return
+	}
+
+	var kind notify.EventKind
+	switch {
+	case r.Status == StatusError:
+		kind = notify.EventError
+	case r.Status == StatusAborted:
+		kind = notify.EventAborted
+	case r.Status == StatusDone && (prevStatus == StatusError || prevStatus == StatusAborted):
+		kind = notify.EventRecovered
+	default:
+		return
+	}
+
+	t, err := s.GetTaskByID(ctx, ti.ClusterID, ti.TaskType, ti.TaskID)
+	if err != nil {
+		s.logger.Error(ctx, "Cannot get task", "task", ti, "error", err)
+		return
+	}
+	e := newEvent(kind, t)
+	e.RunID = r.ID
+	e.Status = r.Status.String()
+	e.Cause = r.Cause
+	e.StartTime = &r.StartTime
+	e.EndTime = r.EndTime
+	n.Notify(ctx, e)
+}
+
+func newEvent(kind notify.EventKind, t *Task) notify.Event {
+	return notify.Event{
+		Kind:        kind,
+		Time:        now(),
+		ClusterID:   t.ClusterID,
+		TaskType:    t.Type.String(),
+		TaskID:      t.ID,
+		TaskName:    t.Name,
+		LastSuccess: t.LastSuccess,
+		Sinks:       t.Notify,
+	}
+}
+
+// WatchStaleTasks periodically checks if enabled tasks succeeded within
+// their stale time and informs notifier if they did not.
+// Each stale task is reported once until it succeeds.
+// It blocks until context is canceled.
+func (s *Service) WatchStaleTasks(ctx context.Context, interval time.Duration) {
+	firstSeen := make(map[uuid.UUID]time.Time)
+	reported := make(map[uuid.UUID]time.Time)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.checkStaleTasks(ctx, firstSeen, reported)
+		}
+	}
+}
+
+func (s *Service) checkStaleTasks(ctx context.Context, firstSeen, reported map[uuid.UUID]time.Time) {
+	n, defaultStaleAfter := s.notifierAndStaleAfter()
+	if n == nil {
+		return
+	}
+
+	s.mu.Lock()
+	clusters := make([]uuid.UUID, 0, len(s.scheduler))
+	for id := range s.scheduler {
+		if !s.isSuspendedLocked(id) {
+			clusters = append(clusters, id)
+		}
+	}
+	s.mu.Unlock()
+
+	seen := make(map[uuid.UUID]struct{})
+	for _, c := range clusters {
+		tasks, err := s.ListTasks(ctx, c, ListFilter{})
+		if err != nil {
+			s.logger.Error(ctx, "Cannot list tasks", "cluster_id", c, "error", err)
+			continue
+		}
+		for i := range tasks {
+			t := &tasks[i].Task
+			seen[t.ID] = struct{}{}
+			if _, ok := firstSeen[t.ID]; !ok {
+				firstSeen[t.ID] = now()
+			}
+			if !notifyTask(t.Type) {
+				continue
+			}
+
+			staleAfter := t.NotifyStaleAfter.Duration()
+			if staleAfter == 0 && (!t.Sched.Cron.IsZero() || t.Sched.Interval != 0) {
+				staleAfter = defaultStaleAfter
+			}
+			if staleAfter == 0 {
+				continue
+			}
+
+			// Tasks that never succeeded are checked since they were first seen
+			ref := firstSeen[t.ID]
+			if t.LastSuccess != nil {
+				ref = *t.LastSuccess
+			}
+			if now().Sub(ref) < staleAfter || reported[t.ID].Equal(ref) {
+				continue
+			}
+
+			reported[t.ID] = ref
+			s.logger.Info(ctx, "Task has not succeeded recently",
+				"task", t,
+				"last_success", t.LastSuccess,
+				"stale_after", staleAfter,
+			)
+			n.Notify(ctx, newEvent(notify.EventStale, t))
+		}
+	}
+
+	// Forget deleted and disabled tasks
+	for id := range firstSeen {
+		if _, ok := seen[id]; !ok {
+			delete(firstSeen, id)
+			delete(reported, id)
+		}
+	}
+}
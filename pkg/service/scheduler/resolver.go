@@ -13,19 +13,29 @@ type taskInfo struct {
 	TaskType  TaskType
 	TaskID    uuid.UUID
 	TaskName  string
+
+	RunAfter          uuid.UUID
+	RunAfterCondition RunAfterCondition
 }
 
 func newTaskInfoFromTask(t *Task) taskInfo {
-	return taskInfo{
+	ti := taskInfo{
 		ClusterID: t.ClusterID,
 		TaskType:  t.Type,
 		TaskID:    t.ID,
 		TaskName:  t.Name,
 	}
+	if t.RunAfter != nil {
+		ti.RunAfter = *t.RunAfter
+		ti.RunAfterCondition = t.RunAfterCondition
+	}
+	return ti
 }
 
 func (ti taskInfo) idKey() taskInfo {
 	ti.TaskID = uuid.Nil
+	ti.RunAfter = uuid.Nil
+	ti.RunAfterCondition = ""
 	return ti
 }
 
@@ -79,3 +89,30 @@ func (r resolver) FillTaskID(ti *taskInfo) bool {
 	}
 	return ok
 }
+
+// FindDependents returns tasks that run after the task with a given ID.
+func (r resolver) FindDependents(taskID uuid.UUID) []taskInfo {
+	var out []taskInfo
+	for _, ti := range r.taskInfo {
+		if ti.RunAfter == taskID {
+			out = append(out, ti)
+		}
+	}
+	return out
+}
+
+// HasRunAfterCycle returns true if running task with a given ID after
+// the runAfter task would create a cycle of task dependencies.
+func (r resolver) HasRunAfterCycle(taskID, runAfter uuid.UUID) bool {
+	visited := make(map[uuid.UUID]struct{})
+	for id := runAfter; id != uuid.Nil; id = r.taskInfo[id].RunAfter {
+		if id == taskID {
+			return true
+		}
+		if _, ok := visited[id]; ok {
+			return true
+		}
+		visited[id] = struct{}{}
+	}
+	return false
+}
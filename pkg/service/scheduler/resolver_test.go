@@ -48,3 +48,40 @@ func TestResolverNameUUIDHijacking(t *testing.T) {
 		t.Fatalf("FindByID() = %v, expected %v", eid, ti0)
 	}
 }
+
+func TestResolverRunAfter(t *testing.T) {
+	clusterID := uuid.MustRandom()
+	backup := taskInfo{
+		ClusterID: clusterID,
+		TaskID:    uuid.MustRandom(),
+	}
+	validate := taskInfo{
+		ClusterID:         clusterID,
+		TaskID:            uuid.MustRandom(),
+		RunAfter:          backup.TaskID,
+		RunAfterCondition: RunAfterOnSuccess,
+	}
+	restore := taskInfo{
+		ClusterID:         clusterID,
+		TaskID:            uuid.MustRandom(),
+		RunAfter:          validate.TaskID,
+		RunAfterCondition: RunAfterAlways,
+	}
+	r := newResolver()
+	r.Put(backup)
+	r.Put(validate)
+	r.Put(restore)
+
+	if d := r.FindDependents(backup.TaskID); len(d) != 1 || d[0] != validate {
+		t.Fatalf("FindDependents() = %v, expected %v", d, validate)
+	}
+	if d := r.FindDependents(restore.TaskID); len(d) != 0 {
+		t.Fatalf("FindDependents() = %v, expected none", d)
+	}
+	if !r.HasRunAfterCycle(backup.TaskID, restore.TaskID) {
+		t.Fatal("HasRunAfterCycle() = false, expected true")
+	}
+	if r.HasRunAfterCycle(uuid.MustRandom(), restore.TaskID) {
+		t.Fatal("HasRunAfterCycle() = true, expected false")
+	}
+}
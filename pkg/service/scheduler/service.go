@@ -21,6 +21,7 @@ import (
 	"github.com/scylladb/scylla-manager/v3/pkg/util"
 	"github.com/scylladb/scylla-manager/v3/pkg/util/jsonutil"
 	"github.com/scylladb/scylla-manager/v3/pkg/util/pointer"
+	"github.com/scylladb/scylla-manager/v3/pkg/util/retry"
 	"github.com/scylladb/scylla-manager/v3/pkg/util/schedules"
 	"github.com/scylladb/scylla-manager/v3/pkg/util/uuid"
 )
@@ -55,8 +56,13 @@ type Service struct {
 	resolver   resolver
 	scheduler  map[uuid.UUID]*Scheduler
 	suspended  *b16set.Set
+	blackouts  map[uuid.UUID][]*Blackout
 	noContinue map[uuid.UUID]time.Time
+	notifier   Notifier
+	staleAfter time.Duration
 	closed     bool
+	unloading  bool
+	unloaded   bool
 	mu         sync.Mutex
 }
 
@@ -73,6 +79,7 @@ func NewService(session gocqlx.Session, metrics metrics.SchedulerMetrics, drawer
 		resolver:   newResolver(),
 		scheduler:  make(map[uuid.UUID]*Scheduler),
 		suspended:  b16set.New(),
+		blackouts:  make(map[uuid.UUID][]*Blackout),
 		noContinue: make(map[uuid.UUID]time.Time),
 	}
 	s.runners[SuspendTask] = suspendRunner{service: s}
@@ -80,6 +87,9 @@ func NewService(session gocqlx.Session, metrics metrics.SchedulerMetrics, drawer
 	if err := s.initSuspended(); err != nil {
 		return nil, errors.Wrap(err, "init suspended")
 	}
+	if err := s.initBlackouts(); err != nil {
+		return nil, errors.Wrap(err, "init blackouts")
+	}
 
 	return s, nil
 }
@@ -123,6 +133,20 @@ func (s *Service) mustRunner(tp TaskType) Runner {
 func (s *Service) LoadTasks(ctx context.Context) error {
 	s.logger.Info(ctx, "Loading tasks from database")
 
+	s.mu.Lock()
+	unloaded := s.unloaded
+	s.unloaded = false
+	s.mu.Unlock()
+	// Tasks could have been suspended by other instance since tasks were unloaded
+	if unloaded {
+		if err := s.initSuspended(); err != nil {
+			return errors.Wrap(err, "init suspended")
+		}
+		if err := s.initBlackouts(); err != nil {
+			return errors.Wrap(err, "init blackouts")
+		}
+	}
+
 	endTime := now()
 	err := s.forEachTask(func(t *Task) error {
 		s.initMetrics(t)
@@ -268,6 +292,9 @@ func (s *Service) PutTask(ctx context.Context, t *Task) error {
 		t.Status = StatusNew
 		create = true
 	}
+	if t != nil && t.RunAfter != nil && t.RunAfterCondition == "" {
+		t.RunAfterCondition = RunAfterOnSuccess
+	}
 	s.logger.Info(ctx, "PutTask", "task", t, "schedule", t.Sched, "properties", t.Properties, "create", create)
 
 	if err := t.Validate(); err != nil {
@@ -280,10 +307,11 @@ func (s *Service) PutTask(ctx context.Context, t *Task) error {
 	if create { // nolint: nestif
 		// Force run if there is no start date and cron.
 		// Note that tasks with '--start-date now' have StartDate set to zero value.
+		// Tasks running after other tasks are not forced to run.
 		run := false
 		if t.Sched.StartDate.IsZero() {
 			t.Sched.StartDate = now()
-			if t.Sched.Cron.IsZero() {
+			if t.Sched.Cron.IsZero() && t.RunAfter == nil {
 				run = true
 			}
 		} else if t.Sched.StartDate.Before(now()) && t.Sched.Interval != 0 {
@@ -321,6 +349,16 @@ func (s *Service) shouldPutTask(create bool, t *Task) error {
 		}
 	}
 
+	if t.RunAfter != nil {
+		ti, ok := s.resolver.FindByID(*t.RunAfter)
+		if !ok || ti.ClusterID != t.ClusterID {
+			return util.ErrValidate(errors.Errorf("run after task %s not found", *t.RunAfter))
+		}
+		if s.resolver.HasRunAfterCycle(t.ID, *t.RunAfter) {
+			return util.ErrValidate(errors.Errorf("running after task %s would create a cycle", ti))
+		}
+	}
+
 	return nil
 }
 
@@ -356,6 +394,7 @@ func (s *Service) schedule(ctx context.Context, t *Task, run bool) {
 
 func (s *Service) newScheduler(clusterID uuid.UUID) *Scheduler {
 	l := scheduler.NewScheduler[Key](now, s.run, newSchedulerListener(s.findTaskByID, s.logger.Named(clusterID.String()[0:8])))
+	l.SetBlackouts(context.Background(), s.schedulerBlackoutsLocked(clusterID))
 	go l.Start(context.Background())
 	return l
 }
@@ -409,6 +448,7 @@ func (s *Service) run(ctx RunContext) (runErr error) {
 	if !ok {
 		return util.ErrNotFound
 	}
+	prevStatus := s.prevStatusForNotify(runCtx, ti)
 	if err := s.putRunAndUpdateTask(r); err != nil {
 		return errors.Wrap(err, "put run")
 	}
@@ -430,6 +470,8 @@ func (s *Service) run(ctx RunContext) (runErr error) {
 			logger.Error(runCtx, "Cannot update the run", "task", ti, "run", r, "error", err)
 		}
 		s.metrics.EndRun(ti.ClusterID, ti.TaskType.String(), ti.TaskID, r.Status.String(), r.StartTime.Unix())
+		s.notifyRunEnded(runCtx, ti, r, prevStatus)
+		s.startDependents(runCtx, ti, r.Status, ctx.Retry, retry.IsPermanent(runErr))
 	}()
 
 	if ctx.Properties.(Properties) == nil {
@@ -448,6 +490,53 @@ func (s *Service) run(ctx RunContext) (runErr error) {
 	return s.mustRunner(ti.TaskType).Run(runCtx, ti.ClusterID, ti.TaskID, r.ID, ctx.Properties.(Properties))
 }
 
+// startDependents starts tasks running after the task whose run ended
+// with a given status. Dependents are not started when the failed run
+// is going to be retried.
+func (s *Service) startDependents(ctx context.Context, ti taskInfo, status Status, retryNo int8, permanent bool) {
+	s.mu.Lock()
+	var dependents []taskInfo
+	for _, d := range s.resolver.FindDependents(ti.TaskID) {
+		if d.ClusterID == ti.ClusterID && d.RunAfterCondition.Satisfied(status) {
+			dependents = append(dependents, d)
+		}
+	}
+	s.mu.Unlock()
+	if len(dependents) == 0 {
+		return
+	}
+
+	if status == StatusError && !permanent {
+		t, err := s.GetTaskByID(ctx, ti.ClusterID, ti.TaskType, ti.TaskID)
+		if err != nil {
+			s.logger.Error(ctx, "Cannot get task", "task", ti, "error", err)
+			return
+		}
+		if t.Enabled && int(retryNo) < t.Sched.NumRetries {
+			return
+		}
+	}
+
+	for _, d := range dependents {
+		t, err := s.GetTaskByID(ctx, d.ClusterID, d.TaskType, d.TaskID)
+		if err != nil {
+			s.logger.Error(ctx, "Cannot get dependent task", "task", d, "error", err)
+			continue
+		}
+		if !t.Enabled {
+			continue
+		}
+		s.logger.Info(ctx, "Starting dependent task",
+			"task", d,
+			"run_after", ti,
+			"status", status,
+		)
+		if err := s.startTask(ctx, t, false); err != nil {
+			s.logger.Error(ctx, "Cannot start dependent task", "task", d, "error", err)
+		}
+	}
+}
+
 func (s *Service) putRunAndUpdateTask(r *Run) error {
 	if err := s.putRun(r); err != nil {
 		return err
@@ -503,6 +592,8 @@ func statusAndCauseFromCtxAndErr(ctx context.Context, err error) (taskStatus Sta
 		return StatusStopped, scheduler.ErrStoppedTask.Error()
 	case errors.Is(context.Cause(ctx), scheduler.ErrOutOfWindowTask):
 		return StatusWaiting, scheduler.ErrOutOfWindowTask.Error()
+	case errors.Is(context.Cause(ctx), scheduler.ErrBlackoutTask):
+		return StatusWaiting, scheduler.ErrBlackoutTask.Error()
 	case errors.Is(context.Cause(ctx), scheduler.ErrStoppedScheduler):
 		return StatusStopped, scheduler.ErrStoppedScheduler.Error()
 	default:
@@ -534,6 +625,13 @@ func (s *Service) findTaskByID(key Key) (taskInfo, bool) {
 func (s *Service) DeleteTask(ctx context.Context, t *Task) error {
 	s.logger.Debug(ctx, "DeleteTask", "task", t)
 
+	s.mu.Lock()
+	dependents := s.resolver.FindDependents(t.ID)
+	s.mu.Unlock()
+	if len(dependents) > 0 {
+		return util.ErrValidate(errors.Errorf("task is a run after dependency of tasks %s, delete them or change their run after first", dependents))
+	}
+
 	t.Deleted = true
 	t.Enabled = false
 
@@ -582,6 +680,10 @@ func (s *Service) startTask(ctx context.Context, t *Task, noContinue bool) error
 		s.mu.Unlock()
 		return util.ErrValidate(errors.New("cluster is suspended"))
 	}
+	if b, ok := s.activeBlackoutLocked(t.ClusterID); ok && t.Type != HealthCheckTask && t.Type != SuspendTask {
+		s.mu.Unlock()
+		return util.ErrValidate(errors.Errorf("cluster is in blackout period until %s", b.End))
+	}
 	l, lok := s.scheduler[t.ClusterID]
 	if !lok {
 		l = s.newScheduler(t.ClusterID)
@@ -636,10 +738,42 @@ func (s *Service) updateRunStatus(r *Run) error {
 		ExecRelease()
 }
 
+// UnloadTasks stops all schedulers and waits for running tasks to terminate,
+// the stopped runs are marked as aborted so that they can be continued.
+// It's used when the instance loses leadership, tasks can be scheduled
+// again with LoadTasks.
+func (s *Service) UnloadTasks(ctx context.Context) {
+	s.logger.Info(ctx, "Unloading tasks")
+
+	s.mu.Lock()
+	s.unloading = true
+	v := make([]*Scheduler, 0, len(s.scheduler))
+	for _, l := range s.scheduler {
+		v = append(v, l)
+		l.Close()
+	}
+	s.scheduler = make(map[uuid.UUID]*Scheduler)
+	s.mu.Unlock()
+
+	for _, l := range v {
+		l.Wait()
+	}
+
+	s.mu.Lock()
+	s.resolver = newResolver()
+	s.unloading = false
+	s.unloaded = true
+	s.mu.Unlock()
+
+	s.logger.Info(ctx, "All tasks unloaded")
+}
+
+// isClosed returns true if service is closed or tasks are being unloaded,
+// runs stopped then are marked as aborted.
 func (s *Service) isClosed() bool {
 	s.mu.Lock()
 	defer s.mu.Unlock()
-	return s.closed
+	return s.closed || s.unloading
 }
 
 // Close cancels all tasks and waits for them to terminate.
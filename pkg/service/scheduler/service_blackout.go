@@ -0,0 +1,128 @@
+// Copyright (C) 2024 ScyllaDB
+
+package scheduler
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/scylladb/gocqlx/v2/qb"
+	"github.com/scylladb/scylla-manager/v3/pkg/scheduler"
+	"github.com/scylladb/scylla-manager/v3/pkg/schema/table"
+	"github.com/scylladb/scylla-manager/v3/pkg/util"
+	"github.com/scylladb/scylla-manager/v3/pkg/util/timeutc"
+	"github.com/scylladb/scylla-manager/v3/pkg/util/uuid"
+)
+
+func (s *Service) initBlackouts() error {
+	q := qb.Select(table.SchedulerBlackout.Name()).Query(s.session)
+	var v []*Blackout
+	if err := q.SelectRelease(&v); err != nil {
+		return errors.Wrap(err, "list blackouts")
+	}
+
+	blackouts := make(map[uuid.UUID][]*Blackout)
+	for _, b := range v {
+		blackouts[b.ClusterID] = append(blackouts[b.ClusterID], b)
+	}
+
+	s.mu.Lock()
+	s.blackouts = blackouts
+	s.mu.Unlock()
+
+	return nil
+}
+
+// schedulerBlackoutsLocked returns blackouts of a cluster in the form
+// accepted by scheduler.
+func (s *Service) schedulerBlackoutsLocked(clusterID uuid.UUID) scheduler.Blackouts {
+	v := s.blackouts[clusterID]
+	b := make([]scheduler.Blackout, len(v))
+	for i := range v {
+		b[i] = scheduler.Blackout{Begin: v[i].StartTime, End: v[i].EndTime}
+	}
+	return scheduler.NewBlackouts(now(), b...)
+}
+
+// activeBlackoutLocked returns blackout period of a cluster that is in
+// progress.
+func (s *Service) activeBlackoutLocked(clusterID uuid.UUID) (scheduler.Blackout, bool) {
+	return s.schedulerBlackoutsLocked(clusterID).Find(now())
+}
+
+// ListBlackouts returns blackout periods of a cluster ordered by start time.
+func (s *Service) ListBlackouts(ctx context.Context, clusterID uuid.UUID) ([]*Blackout, error) {
+	s.logger.Debug(ctx, "ListBlackouts", "cluster_id", clusterID)
+
+	q := table.SchedulerBlackout.SelectQuery(s.session).BindMap(qb.M{
+		"cluster_id": clusterID,
+	})
+	var v []*Blackout
+	if err := q.SelectRelease(&v); err != nil {
+		return nil, err
+	}
+	sort.Slice(v, func(i, j int) bool {
+		return v[i].StartTime.Before(v[j].StartTime)
+	})
+	return v, nil
+}
+
+// PutBlackout upserts a blackout period, tasks of the cluster are paused or
+// deferred accordingly. Blackout period is removed when it ends.
+func (s *Service) PutBlackout(ctx context.Context, b *Blackout) error {
+	if b != nil && b.ID == uuid.Nil {
+		b.ID = uuid.NewTime()
+	}
+	s.logger.Info(ctx, "PutBlackout", "blackout", b)
+
+	if err := b.Validate(); err != nil {
+		return err
+	}
+	ttl := b.EndTime.Sub(timeutc.Now())
+	if ttl <= 0 {
+		return util.ErrValidate(errors.New("blackout end time cannot be in the past"))
+	}
+	// Round up as TTL is set in seconds
+	ttl = ttl.Truncate(time.Second) + time.Second
+
+	q := table.SchedulerBlackout.InsertBuilder().TTL(ttl).Query(s.session).BindStruct(b)
+	if err := q.ExecRelease(); err != nil {
+		return err
+	}
+	return s.updateBlackouts(ctx, b.ClusterID)
+}
+
+// DeleteBlackout removes a blackout period, if it's in progress deferred
+// tasks are scheduled again.
+func (s *Service) DeleteBlackout(ctx context.Context, clusterID, id uuid.UUID) error {
+	s.logger.Info(ctx, "DeleteBlackout", "cluster_id", clusterID, "id", id)
+
+	b := &Blackout{ClusterID: clusterID, ID: id}
+	if err := table.SchedulerBlackout.GetQuery(s.session).BindStruct(b).GetRelease(b); err != nil {
+		return err
+	}
+	if err := table.SchedulerBlackout.DeleteQuery(s.session).BindStruct(b).ExecRelease(); err != nil {
+		return err
+	}
+	return s.updateBlackouts(ctx, clusterID)
+}
+
+func (s *Service) updateBlackouts(ctx context.Context, clusterID uuid.UUID) error {
+	v, err := s.ListBlackouts(ctx, clusterID)
+	if err != nil {
+		return errors.Wrap(err, "list blackouts")
+	}
+
+	s.mu.Lock()
+	s.blackouts[clusterID] = v
+	b := s.schedulerBlackoutsLocked(clusterID)
+	l, lok := s.scheduler[clusterID]
+	s.mu.Unlock()
+
+	if lok {
+		l.SetBlackouts(ctx, b)
+	}
+	return nil
+}
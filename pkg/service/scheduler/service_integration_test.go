@@ -28,6 +28,7 @@ import (
 	"github.com/scylladb/scylla-manager/v3/pkg/metrics"
 	"github.com/scylladb/scylla-manager/v3/pkg/schema/table"
 	"github.com/scylladb/scylla-manager/v3/pkg/service/backup"
+	"github.com/scylladb/scylla-manager/v3/pkg/service/notify"
 	"github.com/scylladb/scylla-manager/v3/pkg/service/scheduler"
 	"github.com/scylladb/scylla-manager/v3/pkg/store"
 	. "github.com/scylladb/scylla-manager/v3/pkg/testutils"
@@ -100,6 +101,27 @@ func (r *mockRunner) Error() {
 	}
 }
 
+type mockNotifier struct {
+	mu     sync.Mutex
+	events []notify.Event
+}
+
+func (n *mockNotifier) Notify(_ context.Context, e notify.Event) {
+	n.mu.Lock()
+	n.events = append(n.events, e)
+	n.mu.Unlock()
+}
+
+func (n *mockNotifier) Kinds() []notify.EventKind {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	var out []notify.EventKind
+	for _, e := range n.events {
+		out = append(out, e.Kind)
+	}
+	return out
+}
+
 type schedulerTestHelper struct {
 	session gocqlx.Session
 	service *scheduler.Service
@@ -113,6 +135,7 @@ type schedulerTestHelper struct {
 
 func newSchedTestHelper(t *testing.T, session gocqlx.Session) *schedulerTestHelper {
 	ExecStmt(t, session, "TRUNCATE TABLE drawer")
+	ExecStmt(t, session, "TRUNCATE TABLE scheduler_blackout")
 	ExecStmt(t, session, "TRUNCATE TABLE scheduler_task")
 	ExecStmt(t, session, "TRUNCATE TABLE scheduler_task_run")
 
@@ -360,6 +383,33 @@ func TestServiceScheduleIntegration(t *testing.T) {
 		h.assertStatus(task1, scheduler.StatusAborted)
 	})
 
+	t.Run("unload tasks aborts tasks and load tasks continues them", func(t *testing.T) {
+		h := newSchedTestHelper(t, session)
+		defer h.close()
+		ctx := context.Background()
+
+		Print("Given: task is running")
+		task := h.makeTaskWithStartDate(now())
+		if err := h.service.PutTask(ctx, task); err != nil {
+			t.Fatal(err)
+		}
+		h.assertStatus(task, scheduler.StatusRunning)
+
+		Print("When: tasks are unloaded")
+		h.service.UnloadTasks(ctx)
+
+		Print("Then: task is aborted")
+		h.assertStatus(task, scheduler.StatusAborted)
+
+		Print("When: tasks are loaded")
+		if err := h.service.LoadTasks(ctx); err != nil {
+			t.Fatal(err)
+		}
+
+		Print("Then: task runs again")
+		h.assertStatus(task, scheduler.StatusRunning)
+	})
+
 	t.Run("task status", func(t *testing.T) {
 		h := newSchedTestHelper(t, session)
 		defer h.close()
@@ -535,6 +585,152 @@ func TestServiceScheduleIntegration(t *testing.T) {
 		h.assertNotStatus(task, scheduler.StatusRunning)
 	})
 
+	t.Run("run after", func(t *testing.T) {
+		h := newSchedTestHelper(t, session)
+		defer h.close()
+		ctx := context.Background()
+
+		Print("Given: task scheduled in future")
+		task0 := h.makeTaskWithStartDate(future)
+		if err := h.service.PutTask(ctx, task0); err != nil {
+			t.Fatal(err)
+		}
+		Print("And: task running after it on success")
+		task1 := h.makeTaskWithStartDate(never)
+		task1.RunAfter = &task0.ID
+		if err := h.service.PutTask(ctx, task1); err != nil {
+			t.Fatal(err)
+		}
+		Print("And: task running after it on error")
+		task2 := h.makeTaskWithStartDate(never)
+		task2.RunAfter = &task0.ID
+		task2.RunAfterCondition = scheduler.RunAfterOnError
+		if err := h.service.PutTask(ctx, task2); err != nil {
+			t.Fatal(err)
+		}
+
+		Print("Then: dependent tasks are not executed")
+		h.assertNotStatus(task1, scheduler.StatusRunning)
+		h.assertNotStatus(task2, scheduler.StatusRunning)
+
+		Print("When: task is started and finishes")
+		h.service.StartTask(ctx, task0)
+		h.assertStatus(task0, scheduler.StatusRunning)
+		h.runner.Done()
+		h.assertStatus(task0, scheduler.StatusDone)
+
+		Print("Then: task running after it on success is executed")
+		h.assertStatus(task1, scheduler.StatusRunning)
+		h.runner.Done()
+		h.assertStatus(task1, scheduler.StatusDone)
+
+		Print("And: task running after it on error is not executed")
+		h.assertNotStatus(task2, scheduler.StatusRunning)
+	})
+
+	t.Run("notify", func(t *testing.T) {
+		h := newSchedTestHelper(t, session)
+		defer h.close()
+		ctx := context.Background()
+
+		n := &mockNotifier{}
+		h.service.SetNotifier(n, 0)
+
+		Print("Given: task selecting notification sink")
+		task := h.makeTaskWithStartDate(future)
+		task.Notify = []string{"oncall"}
+		if err := h.service.PutTask(ctx, task); err != nil {
+			t.Fatal(err)
+		}
+
+		Print("When: task runs successfully")
+		h.service.StartTask(ctx, task)
+		h.assertStatus(task, scheduler.StatusRunning)
+		h.runner.Done()
+		h.assertStatus(task, scheduler.StatusDone)
+
+		Print("And: task fails")
+		h.service.StartTask(ctx, task)
+		h.assertStatus(task, scheduler.StatusRunning)
+		h.runner.Error()
+		h.assertStatus(task, scheduler.StatusError)
+
+		Print("And: task succeeds")
+		h.service.StartTask(ctx, task)
+		h.assertStatus(task, scheduler.StatusRunning)
+		h.runner.Done()
+		h.assertStatus(task, scheduler.StatusDone)
+
+		Print("Then: error and recovery are notified")
+		WaitCond(t, func() bool {
+			return len(n.Kinds()) == 2
+		}, _interval, _wait)
+		if diff := cmp.Diff([]notify.EventKind{notify.EventError, notify.EventRecovered}, n.Kinds()); diff != "" {
+			t.Fatal(diff)
+		}
+		e := n.events[0]
+		if e.TaskID != task.ID || e.Cause != "failed" || !cmp.Equal(e.Sinks, task.Notify) {
+			t.Fatalf("Unexpected event %+v", e)
+		}
+	})
+
+	t.Run("run after cycle", func(t *testing.T) {
+		h := newSchedTestHelper(t, session)
+		defer h.close()
+		ctx := context.Background()
+
+		Print("Given: two tasks running one after another")
+		task0 := h.makeTaskWithStartDate(future)
+		if err := h.service.PutTask(ctx, task0); err != nil {
+			t.Fatal(err)
+		}
+		task1 := h.makeTaskWithStartDate(future)
+		task1.RunAfter = &task0.ID
+		if err := h.service.PutTask(ctx, task1); err != nil {
+			t.Fatal(err)
+		}
+
+		Print("When: first task is updated to run after the second one")
+		task0.RunAfter = &task1.ID
+		err := h.service.PutTask(ctx, task0)
+
+		Print("Then: update fails")
+		h.assertError(err, "cycle")
+	})
+
+	t.Run("delete run after dependency", func(t *testing.T) {
+		h := newSchedTestHelper(t, session)
+		defer h.close()
+		ctx := context.Background()
+
+		Print("Given: task running after another task")
+		task0 := h.makeTaskWithStartDate(future)
+		if err := h.service.PutTask(ctx, task0); err != nil {
+			t.Fatal(err)
+		}
+		task1 := h.makeTaskWithStartDate(future)
+		task1.RunAfter = &task0.ID
+		if err := h.service.PutTask(ctx, task1); err != nil {
+			t.Fatal(err)
+		}
+
+		Print("When: first task is deleted")
+		err := h.service.DeleteTask(ctx, task0)
+
+		Print("Then: delete fails")
+		h.assertError(err, "run after dependency")
+
+		Print("When: dependent task is deleted first")
+		if err := h.service.DeleteTask(ctx, task1); err != nil {
+			t.Fatal(err)
+		}
+
+		Print("Then: first task can be deleted")
+		if err := h.service.DeleteTask(ctx, task0); err != nil {
+			t.Fatal(err)
+		}
+	})
+
 	t.Run("stop and disable task", func(t *testing.T) {
 		h := newSchedTestHelper(t, session)
 		defer h.close()
@@ -1048,6 +1244,54 @@ func TestServiceScheduleIntegration(t *testing.T) {
 		h.assertStatus(task, scheduler.StatusDone)
 	})
 
+	t.Run("task in blackout", func(t *testing.T) {
+		h := newSchedTestHelper(t, session)
+		defer h.close()
+		ctx := context.Background()
+
+		Print("When: task is scheduled")
+		task := h.makeTaskWithStartDate(now())
+		if err := h.service.PutTask(ctx, task); err != nil {
+			t.Fatal(err)
+		}
+
+		Print("Then: task runs")
+		h.assertStatus(task, scheduler.StatusRunning)
+
+		Print("When: blackout begins")
+		b := &scheduler.Blackout{
+			ClusterID: h.clusterID,
+			StartTime: now(),
+			EndTime:   now().Add(3 * time.Second),
+			Reason:    "test",
+		}
+		if err := h.service.PutBlackout(ctx, b); err != nil {
+			t.Fatal(err)
+		}
+
+		Print("Then: task is paused")
+		h.assertStatus(task, scheduler.StatusWaiting)
+
+		Print("And: task can't be started")
+		h.assertError(h.service.StartTask(ctx, task), "blackout")
+
+		Print("Then: task continues after blackout")
+		h.assertStatus(task, scheduler.StatusRunning)
+
+		h.runner.Done()
+		Print("Then: task stops with the status done")
+		h.assertStatus(task, scheduler.StatusDone)
+
+		Print("Then: ended blackout is not listed")
+		v, err := h.service.ListBlackouts(ctx, h.clusterID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(v) != 0 {
+			t.Fatalf("ListBlackouts() = %v, expected none", v)
+		}
+	})
+
 	t.Run("task ends with context error", func(t *testing.T) {
 		h := newSchedTestHelper(t, session)
 		defer h.close()
@@ -69,6 +69,7 @@ func (s *Service) initSuspended() error {
 		return errors.Wrap(err, "list clusters")
 	}
 
+	suspended := b16set.New()
 	for _, c := range clusters {
 		si := &suspendInfo{ClusterID: c}
 		if err := s.drawer.Get(si); err != nil {
@@ -76,11 +77,15 @@ func (s *Service) initSuspended() error {
 				return err
 			}
 		} else {
-			s.suspended.Add(c.Bytes16())
+			suspended.Add(c.Bytes16())
 			s.metrics.Suspend(c)
 		}
 	}
 
+	s.mu.Lock()
+	s.suspended = suspended
+	s.mu.Unlock()
+
 	return nil
 }
 
@@ -0,0 +1,58 @@
+// Copyright (C) 2024 ScyllaDB
+
+package sstable
+
+import (
+	"encoding/binary"
+	"io"
+
+	"github.com/pkg/errors"
+)
+
+// summaryHeaderSize is the size of Summary.db header:
+// min_index_interval (4), size (4), memory_size (8), sampling_level (4) and size_at_full_sampling (4).
+const summaryHeaderSize = 24
+
+// maxSummaryKeySize limits size of partition keys read from Summary.db.
+const maxSummaryKeySize = 64 * 1024
+
+// ReadSummaryKeys returns serialized first and last partition key of the sstable
+// from its Summary.db component. Keys are stored after the header and
+// the sampled index entries of the size specified in the header, see
+// https://github.com/scylladb/scylladb/blob/master/sstables/sstables.cc parse(summary).
+func ReadSummaryKeys(r io.Reader) (first, last []byte, err error) {
+	header := make([]byte, summaryHeaderSize)
+	if _, err := io.ReadFull(r, header); err != nil {
+		return nil, nil, errors.Wrap(err, "read header")
+	}
+	memorySize := int64(binary.BigEndian.Uint64(header[8:16]))
+	if memorySize < 0 {
+		return nil, nil, errors.Errorf("invalid memory size %d", memorySize)
+	}
+	if _, err := io.CopyN(io.Discard, r, memorySize); err != nil {
+		return nil, nil, errors.Wrap(err, "skip entries")
+	}
+
+	if first, err = readSummaryKey(r); err != nil {
+		return nil, nil, errors.Wrap(err, "read first key")
+	}
+	if last, err = readSummaryKey(r); err != nil {
+		return nil, nil, errors.Wrap(err, "read last key")
+	}
+	return first, last, nil
+}
+
+func readSummaryKey(r io.Reader) ([]byte, error) {
+	var size uint32
+	if err := binary.Read(r, binary.BigEndian, &size); err != nil {
+		return nil, err
+	}
+	if size > maxSummaryKeySize {
+		return nil, errors.Errorf("invalid key size %d", size)
+	}
+	key := make([]byte, size)
+	if _, err := io.ReadFull(r, key); err != nil {
+		return nil, err
+	}
+	return key, nil
+}
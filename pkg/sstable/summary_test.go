@@ -0,0 +1,45 @@
+// Copyright (C) 2024 ScyllaDB
+
+package sstable
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+)
+
+func TestReadSummaryKeys(t *testing.T) {
+	var (
+		b       bytes.Buffer
+		entries = []byte("positions and entries")
+		first   = []byte{0, 0, 0, 1}
+		last    = []byte("last key")
+	)
+	write := func(v any) {
+		if err := binary.Write(&b, binary.BigEndian, v); err != nil {
+			t.Fatal(err)
+		}
+	}
+	write(uint32(128))          // min_index_interval
+	write(uint32(1))            // size
+	write(uint64(len(entries))) // memory_size
+	write(uint32(128))          // sampling_level
+	write(uint32(1))            // size_at_full_sampling
+	b.Write(entries)
+	write(uint32(len(first)))
+	b.Write(first)
+	write(uint32(len(last)))
+	b.Write(last)
+
+	f, l, err := ReadSummaryKeys(&b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(f, first) || !bytes.Equal(l, last) {
+		t.Fatalf("ReadSummaryKeys() = %q, %q, expected %q, %q", f, l, first, last)
+	}
+
+	if _, _, err := ReadSummaryKeys(bytes.NewReader(entries)); err == nil {
+		t.Fatal("ReadSummaryKeys() expected error")
+	}
+}
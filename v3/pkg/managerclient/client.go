@@ -31,6 +31,8 @@ var disableOpenAPIDebugOnce sync.Once
 // Client provides means to interact with Scylla Manager.
 type Client struct {
 	operations operations.ClientService
+	httpClient *http.Client
+	baseURL    *url.URL
 }
 
 // DefaultTransport specifies default HTTP transport to be used in NewClient if
@@ -83,7 +85,11 @@ func NewClient(rawURL string, opts ...Option) (Client, error) {
 	// we change that to SCTOOL_DUMP_HTTP
 	r.Debug, _ = strconv.ParseBool(os.Getenv("SCTOOL_DUMP_HTTP"))
 
-	return Client{operations: operations.New(r, strfmt.Default)}, nil
+	return Client{
+		operations: operations.New(r, strfmt.Default),
+		httpClient: httpClient,
+		baseURL:    u,
+	}, nil
 }
 
 // CreateCluster creates a new cluster.
@@ -172,6 +178,85 @@ func (c *Client) ListClusters(ctx context.Context) (ClusterSlice, error) {
 	return resp.Payload, nil
 }
 
+// Overview returns summary of health and tasks of all clusters with
+// the given labels, labels are in key=value format.
+func (c *Client) Overview(ctx context.Context, labels []string) (ClusterOverviewSlice, error) {
+	resp, err := c.operations.GetOverview(&operations.GetOverviewParams{
+		Context: ctx,
+		Label:   labels,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Payload, nil
+}
+
+// ListAuditEntries returns audit log entries from the newest to the oldest.
+// Empty clusterID selects entries of all clusters, zero since and until are ignored.
+func (c *Client) ListAuditEntries(ctx context.Context, clusterID string, since, until time.Time, limit int) (AuditEntrySlice, error) {
+	p := &operations.GetAuditParams{
+		Context: ctx,
+	}
+	if clusterID != "" {
+		p.ClusterID = &clusterID
+	}
+	if !since.IsZero() {
+		v := strfmt.DateTime(since)
+		p.Since = &v
+	}
+	if !until.IsZero() {
+		v := strfmt.DateTime(until)
+		p.Until = &v
+	}
+	if limit > 0 {
+		v := int64(limit)
+		p.Limit = &v
+	}
+
+	resp, err := c.operations.GetAudit(p)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Payload, nil
+}
+
+// ListTokens returns API tokens.
+func (c *Client) ListTokens(ctx context.Context) (TokenSlice, error) {
+	resp, err := c.operations.GetTokens(&operations.GetTokensParams{
+		Context: ctx,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Payload, nil
+}
+
+// CreateToken creates a new API token, the returned token contains secret
+// that can't be retrieved later.
+func (c *Client) CreateToken(ctx context.Context, token *Token) (*Token, error) {
+	resp, err := c.operations.PostTokens(&operations.PostTokensParams{
+		Context: ctx,
+		Token:   token,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Payload, nil
+}
+
+// DeleteToken removes API token.
+func (c *Client) DeleteToken(ctx context.Context, tokenID string) error {
+	_, err := c.operations.DeleteTokenTokenID(&operations.DeleteTokenTokenIDParams{ // nolint: errcheck
+		Context: ctx,
+		TokenID: tokenID,
+	})
+	return err
+}
+
 // ClusterStatus returns health check progress.
 func (c *Client) ClusterStatus(ctx context.Context, clusterID string) (ClusterStatus, error) {
 	resp, err := c.operations.GetClusterClusterIDStatus(&operations.GetClusterClusterIDStatusParams{
@@ -227,8 +312,25 @@ func (c *Client) GetRestoreTarget(ctx context.Context, clusterID string, t *Task
 	return &RestoreTarget{RestoreTarget: *resp.Payload}, nil
 }
 
+// GetRestorePlan fetches restore execution plan.
+func (c *Client) GetRestorePlan(ctx context.Context, clusterID string, t *Task) (*RestorePlan, error) {
+	resp, err := c.operations.GetClusterClusterIDTasksRestorePlan(&operations.GetClusterClusterIDTasksRestorePlanParams{
+		Context:    ctx,
+		ClusterID:  clusterID,
+		TaskFields: makeTaskUpdate(t),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &RestorePlan{RestorePlan: *resp.Payload}, nil
+}
+
 // CreateTask creates a new task.
 func (c *Client) CreateTask(ctx context.Context, clusterID string, t *Task) (uuid.UUID, error) {
+	if err := c.resolveRunAfter(ctx, clusterID, t); err != nil {
+		return uuid.Nil, err
+	}
 	params := &operations.PostClusterClusterIDTasksParams{
 		Context:    ctx,
 		ClusterID:  clusterID,
@@ -321,6 +423,9 @@ func (c *Client) DeleteTask(ctx context.Context, clusterID, taskType string, tas
 
 // UpdateTask updates an existing task unit.
 func (c *Client) UpdateTask(ctx context.Context, clusterID string, t *Task) error {
+	if err := c.resolveRunAfter(ctx, clusterID, t); err != nil {
+		return err
+	}
 	_, err := c.operations.PutClusterClusterIDTaskTaskTypeTaskID(&operations.PutClusterClusterIDTaskTaskTypeTaskIDParams{ // nolint: errcheck
 		Context:   ctx,
 		ClusterID: clusterID,
@@ -333,11 +438,34 @@ func (c *Client) UpdateTask(ctx context.Context, clusterID string, t *Task) erro
 			Schedule:   t.Schedule,
 			Tags:       t.Tags,
 			Properties: t.Properties,
+
+			RunAfter:          t.RunAfter,
+			RunAfterCondition: t.RunAfterCondition,
+
+			Notify:           t.Notify,
+			NotifyStaleAfter: t.NotifyStaleAfter,
 		},
 	})
 	return err
 }
 
+// resolveRunAfter replaces task reference in the form of <type>/<task-id|name>
+// set as RunAfter with the referenced task ID.
+func (c *Client) resolveRunAfter(ctx context.Context, clusterID string, t *Task) error {
+	if t.RunAfter == "" {
+		return nil
+	}
+	if _, err := uuid.Parse(t.RunAfter); err == nil {
+		return nil
+	}
+	_, taskID, err := c.TaskSplit(ctx, clusterID, t.RunAfter)
+	if err != nil {
+		return errors.Wrap(err, "run after")
+	}
+	t.RunAfter = taskID.String()
+	return nil
+}
+
 // ListTasks returns tasks within a clusterID, optionally filtered by task type tp.
 func (c *Client) ListTasks(ctx context.Context, clusterID, taskType string, all bool, status, taskID string) (TaskListItems, error) {
 	resp, err := c.operations.GetClusterClusterIDTasks(&operations.GetClusterClusterIDTasksParams{
@@ -684,3 +812,126 @@ func (c *Client) Resume(ctx context.Context, clusterID string, startTasks bool)
 	_, err := c.operations.PutClusterClusterIDSuspended(p) // nolint: errcheck
 	return err
 }
+
+// ListBlackouts returns cluster blackout periods.
+func (c *Client) ListBlackouts(ctx context.Context, clusterID string) (BlackoutSlice, error) {
+	resp, err := c.operations.GetClusterClusterIDBlackouts(&operations.GetClusterClusterIDBlackoutsParams{
+		Context:   ctx,
+		ClusterID: clusterID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Payload, nil
+}
+
+// CreateBlackout adds a cluster blackout period, tasks are not run during
+// blackout.
+func (c *Client) CreateBlackout(ctx context.Context, clusterID string, b *Blackout) (uuid.UUID, error) {
+	resp, err := c.operations.PostClusterClusterIDBlackouts(&operations.PostClusterClusterIDBlackoutsParams{
+		Context:   ctx,
+		ClusterID: clusterID,
+		Blackout:  b,
+	})
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	id, err := uuidFromLocation(resp.Location)
+	if err != nil {
+		return uuid.Nil, errors.Wrap(err, "cannot parse response")
+	}
+
+	return id, nil
+}
+
+// DeleteBlackout removes a cluster blackout period.
+func (c *Client) DeleteBlackout(ctx context.Context, clusterID, blackoutID string) error {
+	_, err := c.operations.DeleteClusterClusterIDBlackoutsBlackoutID(&operations.DeleteClusterClusterIDBlackoutsBlackoutIDParams{ // nolint: errcheck
+		Context:    ctx,
+		ClusterID:  clusterID,
+		BlackoutID: blackoutID,
+	})
+	return err
+}
+
+// BackupSLA evaluates backup SLA policy of a cluster and returns compliance
+// report.
+func (c *Client) BackupSLA(ctx context.Context, clusterID string) (BackupSLAReport, error) {
+	resp, err := c.operations.GetClusterClusterIDSLA(&operations.GetClusterClusterIDSLAParams{
+		Context:   ctx,
+		ClusterID: clusterID,
+	})
+	if err != nil {
+		return BackupSLAReport{}, err
+	}
+
+	return BackupSLAReport{BackupSLAReport: resp.Payload}, nil
+}
+
+// GetBackupSLAPolicy returns backup SLA policy of a cluster.
+func (c *Client) GetBackupSLAPolicy(ctx context.Context, clusterID string) (*BackupSLAPolicy, error) {
+	resp, err := c.operations.GetClusterClusterIDSLAPolicy(&operations.GetClusterClusterIDSLAPolicyParams{
+		Context:   ctx,
+		ClusterID: clusterID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Payload, nil
+}
+
+// SetBackupSLAPolicy sets backup SLA policy of a cluster.
+func (c *Client) SetBackupSLAPolicy(ctx context.Context, clusterID string, p *BackupSLAPolicy) error {
+	_, err := c.operations.PutClusterClusterIDSLAPolicy(&operations.PutClusterClusterIDSLAPolicyParams{ // nolint: errcheck
+		Context:   ctx,
+		ClusterID: clusterID,
+		Policy:    p,
+	})
+	return err
+}
+
+// DeleteBackupSLAPolicy removes backup SLA policy of a cluster.
+func (c *Client) DeleteBackupSLAPolicy(ctx context.Context, clusterID string) error {
+	_, err := c.operations.DeleteClusterClusterIDSLAPolicy(&operations.DeleteClusterClusterIDSLAPolicyParams{ // nolint: errcheck
+		Context:   ctx,
+		ClusterID: clusterID,
+	})
+	return err
+}
+
+// GetBackupEncryptionKey returns backup encryption key of a cluster,
+// clusterID may refer to a cluster that is no longer registered.
+func (c *Client) GetBackupEncryptionKey(ctx context.Context, clusterID string) (*BackupEncryptionKey, error) {
+	resp, err := c.operations.GetBackupEncryptionKeyClusterID(&operations.GetBackupEncryptionKeyClusterIDParams{
+		Context:   ctx,
+		ClusterID: clusterID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Payload, nil
+}
+
+// PutBackupEncryptionKey imports backup encryption key of a cluster,
+// clusterID may refer to a cluster that is not registered.
+func (c *Client) PutBackupEncryptionKey(ctx context.Context, clusterID string, k *BackupEncryptionKey) error {
+	_, err := c.operations.PutBackupEncryptionKeyClusterID(&operations.PutBackupEncryptionKeyClusterIDParams{ // nolint: errcheck
+		Context:   ctx,
+		ClusterID: clusterID,
+		Key:       k,
+	})
+	return err
+}
+
+// DeleteBackupEncryptionKey removes backup encryption key of a cluster.
+func (c *Client) DeleteBackupEncryptionKey(ctx context.Context, clusterID string) error {
+	_, err := c.operations.DeleteBackupEncryptionKeyClusterID(&operations.DeleteBackupEncryptionKeyClusterIDParams{ // nolint: errcheck
+		Context:   ctx,
+		ClusterID: clusterID,
+	})
+	return err
+}
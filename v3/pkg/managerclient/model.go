@@ -18,6 +18,7 @@ import (
 	"github.com/scylladb/scylla-manager/v3/pkg/util/inexlist"
 	"github.com/scylladb/scylla-manager/v3/pkg/util/schedules"
 	"github.com/scylladb/scylla-manager/v3/pkg/util/timeutc"
+	"github.com/scylladb/scylla-manager/v3/pkg/util/uuid"
 	"github.com/scylladb/scylla-manager/v3/pkg/util/version"
 	"github.com/scylladb/scylla-manager/v3/swagger/gen/scylla-manager/models"
 	"github.com/scylladb/termtables"
@@ -59,6 +60,141 @@ func (cs ClusterSlice) Render(w io.Writer) error {
 	return nil
 }
 
+// Token is access.Token representation.
+type Token = models.Token
+
+// TokenSlice is []*access.Token representation.
+type TokenSlice []*models.Token
+
+// Render renders TokenSlice in a tabular format.
+func (ts TokenSlice) Render(w io.Writer) error {
+	t := table.New("ID", "Name", "Role", "Cluster", "Created")
+	for _, tk := range ts {
+		c := "all"
+		if tk.ClusterID != "" {
+			c = tk.ClusterID
+		}
+		t.AddRow(tk.ID, tk.Name, tk.Role, c, FormatTime(tk.CreatedAt))
+	}
+	if _, err := w.Write([]byte(t.String())); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Blackout is scheduler.Blackout representation.
+type Blackout = models.Blackout
+
+// BlackoutSlice is []*scheduler.Blackout representation.
+type BlackoutSlice []*models.Blackout
+
+// Render renders BlackoutSlice in a tabular format.
+func (bs BlackoutSlice) Render(w io.Writer) error {
+	t := table.New("ID", "Start", "End", "Reason")
+	for _, b := range bs {
+		t.AddRow(b.ID, FormatTime(b.StartTime), FormatTime(b.EndTime), b.Reason)
+	}
+	if _, err := w.Write([]byte(t.String())); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// BackupEncryptionKey is backup.EncryptionKey representation.
+type BackupEncryptionKey = models.BackupEncryptionKey
+
+// BackupSLAPolicy is backup.SLAPolicy representation.
+type BackupSLAPolicy = models.BackupSLAPolicy
+
+// BackupSLAReport is backup.SLAReport representation.
+type BackupSLAReport struct {
+	*models.BackupSLAReport
+}
+
+// Render renders BackupSLAReport in a tabular format.
+func (r BackupSLAReport) Render(w io.Writer) error {
+	if p := r.Policy; p != nil {
+		ks := "*"
+		if len(p.Keyspace) > 0 {
+			ks = strings.Join(p.Keyspace, ",")
+		}
+		fmt.Fprintf(w, "Keyspace:\t%s\n", ks)
+		fmt.Fprintf(w, "Max age:\t%s\n", p.MaxAge)
+	}
+	if r.Compliant {
+		fmt.Fprintln(w, "Status:\t\tCOMPLIANT")
+	} else {
+		fmt.Fprintln(w, "Status:\t\tVIOLATED")
+	}
+	fmt.Fprintln(w)
+
+	t := table.New("Keyspace", "Location", "Snapshot", "Age", "Status")
+	for _, s := range r.Status {
+		var age string
+		if !isZero(s.BackupTime) {
+			age = FormatDuration(s.BackupTime, r.Time)
+		}
+		status := "OK"
+		switch {
+		case s.Error != "":
+			status = "ERROR: " + s.Error
+		case s.SnapshotTag == "":
+			status = "NO BACKUP"
+		case !s.Compliant:
+			status = "TOO OLD"
+		}
+		t.AddRow(s.Keyspace, s.Location, s.SnapshotTag, age, status)
+	}
+	if _, err := w.Write([]byte(t.String())); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// AuditEntry is audit.Entry representation.
+type AuditEntry = models.AuditEntry
+
+// AuditEntrySlice is []*audit.Entry representation.
+type AuditEntrySlice []*models.AuditEntry
+
+// AuditEntries is a renderable list of audit log entries.
+type AuditEntries struct {
+	AuditEntrySlice
+	ShowDiff bool
+}
+
+// Render renders AuditEntries in a tabular format.
+func (ae AuditEntries) Render(w io.Writer) error {
+	columns := []any{"Time", "Identity", "Client", "Operation", "Cluster", "Resource", "Status"}
+	if ae.ShowDiff {
+		columns = append(columns, "Diff")
+	}
+	t := table.New(columns...)
+	for _, e := range ae.AuditEntrySlice {
+		c := e.ClusterID
+		if c == uuid.Nil.String() {
+			c = ""
+		}
+		r := e.Resource
+		if e.ResourceID != "" {
+			r += " " + e.ResourceID
+		}
+		row := []any{FormatTime(e.Time), e.Identity, e.ClientAddr, e.Method + " " + e.Path, c, r, e.StatusCode}
+		if ae.ShowDiff {
+			row = append(row, formatAuditDiff(e.Diff))
+		}
+		t.AddRow(row...)
+	}
+	if _, err := w.Write([]byte(t.String())); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // ClusterStatus contains cluster status info.
 type ClusterStatus models.ClusterStatus
 
@@ -190,6 +326,96 @@ func (cs ClusterStatus) Render(w io.Writer) error {
 	return nil
 }
 
+// ClusterOverview is a summary of cluster health and tasks.
+type ClusterOverview = models.ClusterOverview
+
+// ClusterOverviewSlice is []*ClusterOverview representation.
+type ClusterOverviewSlice []*models.ClusterOverview
+
+// Render renders ClusterOverviewSlice in a tabular format.
+func (ov ClusterOverviewSlice) Render(w io.Writer) error {
+	t := table.New("Cluster", "Labels", "Health", "Last Backup", "Last Repair", "Next", "Running")
+	var errs []string
+	for _, o := range ov {
+		name := o.Name
+		if name == "" {
+			name = o.ClusterID
+		}
+
+		var health string
+		if h := o.Health; h != nil {
+			if h.Error != "" {
+				health = "ERROR"
+				errs = append(errs, name+": "+h.Error)
+			} else {
+				health = fmt.Sprintf("%d/%d UP", h.Up, h.Nodes)
+				if h.CqlUp < h.Up || h.RestUp < h.Up {
+					health += fmt.Sprintf(" (CQL %d, REST %d)", h.CqlUp, h.RestUp)
+				}
+			}
+		}
+		if o.Error != "" {
+			errs = append(errs, name+": "+o.Error)
+		}
+
+		var next string
+		if o.Suspended {
+			next = "[SUSPENDED]"
+		} else if o.NextTask != nil {
+			next = FormatTimePointer(o.NextActivation) + " " + formatOverviewTaskName(o.NextTask)
+		}
+
+		running := make([]string, 0, len(o.RunningTasks))
+		for _, rt := range o.RunningTasks {
+			running = append(running, formatOverviewTaskName(rt))
+		}
+
+		t.AddRow(name, formatLabels(o.Labels), health, formatOverviewTaskResult(o.LastBackup),
+			formatOverviewTaskResult(o.LastRepair), next, strings.Join(running, ", "))
+	}
+	fmt.Fprint(w, t)
+	if len(errs) > 0 {
+		fmt.Fprintf(w, "Errors:\n- %s\n", strings.Join(errs, "\n- "))
+	}
+	return nil
+}
+
+func formatOverviewTaskName(t *models.ClusterOverviewTask) string {
+	if t.Name != "" {
+		return taskJoin(t.Type, t.Name)
+	}
+	return taskJoin(t.Type, t.ID)
+}
+
+// formatOverviewTaskResult returns result of the last task run and the time
+// elapsed since then. If the last run failed, the time elapsed since
+// the last success is also reported.
+func formatOverviewTaskResult(t *models.ClusterOverviewTask) string {
+	if t == nil {
+		return ""
+	}
+	var success, failure strfmt.DateTime
+	if t.LastSuccess != nil {
+		success = *t.LastSuccess
+	}
+	if t.LastError != nil {
+		failure = *t.LastError
+	}
+
+	switch {
+	case isZero(success) && isZero(failure):
+		return t.Status
+	case time.Time(failure).After(time.Time(success)):
+		out := fmt.Sprintf("%s %s ago", TaskStatusError, FormatDuration(failure, strfmt.DateTime{}))
+		if !isZero(success) {
+			out += fmt.Sprintf(" (%s %s ago)", TaskStatusDone, FormatDuration(success, strfmt.DateTime{}))
+		}
+		return out
+	default:
+		return fmt.Sprintf("%s %s ago", TaskStatusDone, FormatDuration(success, strfmt.DateTime{}))
+	}
+}
+
 // Task is a scheduler.Task representation.
 type Task = models.Task
 
@@ -201,6 +427,12 @@ func makeTaskUpdate(t *Task) *models.TaskUpdate {
 		Labels:     t.Labels,
 		Schedule:   t.Schedule,
 		Properties: t.Properties,
+
+		RunAfter:          t.RunAfter,
+		RunAfterCondition: t.RunAfterCondition,
+
+		Notify:           t.Notify,
+		NotifyStaleAfter: t.NotifyStaleAfter,
 	}
 }
 
@@ -217,6 +449,15 @@ Window:	{{ WindowDesc .Schedule.Window }}
 {{ if .Schedule.Timezone -}}
 Tz:	{{ .Schedule.Timezone }}
 {{ end -}}
+{{ if .RunAfter -}}
+Run after:	{{ .RunAfter }} ({{ .RunAfterCondition }})
+{{ end -}}
+{{ if .Notify -}}
+Notify:	{{ StringsJoin .Notify ", " }}
+{{ end -}}
+{{ if .NotifyStaleAfter -}}
+Notify stale after:	{{ .NotifyStaleAfter }}
+{{ end -}}
 {{ if .Schedule.NumRetries -}}
 Retry:	{{ .Schedule.NumRetries }} {{ if .Schedule.RetryWait }}(initial backoff {{ .Schedule.RetryWait }}){{ end }}{{ end -}}
 {{ if .Labels }}
@@ -274,6 +515,7 @@ func (t TaskInfo) Render(w io.Writer) error {
 		},
 		"FormatKey":   formatKey,
 		"FormatValue": formatValue,
+		"StringsJoin": strings.Join,
 	}).Parse(taskInfoTemplate))
 	return temp.Execute(w, t)
 }
@@ -321,6 +563,16 @@ Ignore Hosts:
 {{ range .IgnoreHosts -}}
   - {{ . }}
 {{ end }}
+{{ end -}}
+{{ if .Adaptive -}}
+
+Adaptive: tables are ordered by tombstone_gc deadlines when repair starts
+
+{{ end -}}
+{{ if .Incremental -}}
+
+Incremental: full repair every {{ .FullEvery }} runs
+
 {{ end -}}
 
 Data Centers:
@@ -386,6 +638,13 @@ Bandwidth Limits:
 {{- else }}
   - Unlimited
 {{- end }}
+{{- if .RateLimitProfile }}
+
+Bandwidth Limit Profiles:
+{{- range .RateLimitProfile }}
+  - {{ . }} MiB/s
+{{- end }}
+{{- end }}
 
 Snapshot Parallel Limits:
 {{- if .SnapshotParallel -}}
@@ -407,6 +666,22 @@ Upload Parallel Limits:
 
 Retention Policy:
 {{ FormatRetentionPolicy .Retention .RetentionDays }}
+{{- if .Encrypt }}
+
+Encryption: enabled
+{{- end }}
+{{- if .Compression }}
+
+Compression: {{ .Compression }}
+{{- end }}
+{{- if .Incremental }}
+
+Incremental: synthetic full every {{ .FullEvery }} backups
+{{- end }}
+{{- if .ObjectLockMode }}
+
+Object Lock: {{ .ObjectLockMode }} mode, files retained for {{ .RetentionDays }} days
+{{- end }}
 `
 
 // Render implements Renderer interface.
@@ -459,6 +734,27 @@ Locations:
 {{- end }}
 
 Snapshot Tag:	{{ .SnapshotTag }}
+{{- with FormatTime .PointInTime }}
+Point in Time:  {{ . }}
+{{- end }}
+{{- if .Rename }}
+Rename:
+{{- range .Rename }}
+  - {{ . }}
+{{- end }}
+{{- end }}
+{{- if .DcMapping }}
+DC Mapping:
+{{- range .DcMapping }}
+  - {{ . }}
+{{- end }}
+{{- end }}
+{{- if .TokenRanges }}
+Token Ranges:
+{{- range .TokenRanges }}
+  - {{ . }}
+{{- end }}
+{{- end }}
 Batch Size:     {{ .BatchSize }}
 Parallel:       {{ .Parallel }}
 Transfers:      {{ .Transfers }}
@@ -478,6 +774,7 @@ Download Rate Limits:
 func (t RestoreTarget) Render(w io.Writer) error {
 	temp := template.Must(template.New("target").Funcs(template.FuncMap{
 		"FormatSizeSuffix": FormatSizeSuffix,
+		"FormatTime":       FormatTime,
 		"FormatRestoreTables": func(tables []*models.RestoreTable) string {
 			return FormatRestoreTables(t.ShowTables, tables)
 		},
@@ -492,6 +789,84 @@ func (t RestoreTarget) Render(w io.Writer) error {
 	return temp.Execute(w, t)
 }
 
+// RestorePlan is a representation of restore execution plan returned
+// when dry running restore task.
+type RestorePlan struct {
+	models.RestorePlan
+	Schedule   *Schedule
+	ShowTables int
+}
+
+const restorePlanTemplate = `
+Manifests:
+{{- range .Manifests }}
+  - {{ .Path }} ({{ .Dc }})
+{{- end }}
+{{- if .Tables }}
+
+Tables:
+{{- range .Tables }}
+  - {{ .Keyspace }}.{{ .Table }}: {{ FormatSizeSuffix .Size }}, tombstone_gc: {{ .TombstoneGc }} -> disabled -> {{ .TombstoneGc }}
+{{- end }}
+{{- end }}
+{{- with .Target }}{{ if .Views }}
+
+Views dropped and recreated:
+{{- range .Views }}
+  - {{ .Keyspace }}.{{ .View }}
+{{- end }}
+{{- end }}{{ end }}
+
+Download size:	~{{ FormatSizeSuffix .DownloadSize }}
+Batches:	{{ .BatchCount }}{{ if .BatchCount }} (max {{ FormatSizeSuffix .MaxBatchSize }}, avg {{ FormatSizeSuffix (AvgBatchSize .) }}){{ end }}
+Estimated duration:	{{ .EstimatedDuration }}
+{{- if .Repair }}
+
+Repaired tables:
+{{- range .Repair }}
+  - {{ . }}
+{{- end }}
+{{- end }}
+`
+
+// Render implements Renderer interface.
+func (p RestorePlan) Render(w io.Writer) error {
+	if p.Target != nil {
+		t := RestoreTarget{
+			RestoreTarget: *p.Target,
+			Schedule:      p.Schedule,
+			ShowTables:    p.ShowTables,
+		}
+		if err := t.Render(w); err != nil {
+			return err
+		}
+	}
+
+	temp := template.Must(template.New("plan").Funcs(template.FuncMap{
+		"FormatSizeSuffix": FormatSizeSuffix,
+		"AvgBatchSize": func(p RestorePlan) int64 {
+			return p.DownloadSize / p.BatchCount
+		},
+	}).Parse(restorePlanTemplate))
+	if err := temp.Execute(w, p); err != nil {
+		return err
+	}
+
+	if len(p.Hosts) == 0 {
+		return nil
+	}
+	fmt.Fprintln(w)
+	t := table.New("Host", "DC", "Shards", "Locations", "Batches", "Size")
+	for _, h := range p.Hosts {
+		t.AddRow(h.Host, h.Dc, h.Shards, strings.Join(h.Location, ", "), h.Batches, FormatSizeSuffix(h.Size))
+	}
+	if _, err := w.Write([]byte(t.String())); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // TaskListItem is a representation of scheduler.Task with additional fields from scheduler.
 type TaskListItem = models.TaskListItem
 
@@ -513,16 +888,23 @@ func (li TaskListItems) Render(w io.Writer) error {
 		columns = append(columns, "Properties")
 	}
 	p := table.New(columns...)
+	names := make(map[string]string, len(li.TaskListItemSlice))
 	for _, t := range li.TaskListItemSlice {
-		var id string
 		if t.Name != "" && !li.ShowIDs {
-			id = taskJoin(t.Type, t.Name)
+			names[t.ID] = taskJoin(t.Type, t.Name)
 		} else {
-			id = taskJoin(t.Type, t.ID)
+			names[t.ID] = taskJoin(t.Type, t.ID)
 		}
+	}
+	tasks, depth := runAfterTree(li.TaskListItemSlice)
+	for i, t := range tasks {
+		id := names[t.ID]
 		if li.All && !t.Enabled {
 			id = "*" + id
 		}
+		if depth[i] > 0 {
+			id = strings.Repeat("  ", depth[i]-1) + "└ " + id
+		}
 
 		emptySpec := schedules.CronSpecification{}
 		bytesEmptySpec, err := json.Marshal(emptySpec)
@@ -547,6 +929,16 @@ func (li TaskListItems) Render(w io.Writer) error {
 		} else if t.Schedule.Interval != "" {
 			schedule = t.Schedule.Interval
 		}
+		if t.RunAfter != "" {
+			after, ok := names[t.RunAfter]
+			if !ok {
+				after = t.RunAfter
+			}
+			if schedule != "" {
+				schedule += ", "
+			}
+			schedule += "after " + after + " " + t.RunAfterCondition
+		}
 
 		status := t.Status
 		if status == TaskStatusError && t.Retry > 0 {
@@ -722,6 +1114,8 @@ Duration:	{{ FormatDuration .StartTime .EndTime }}
 Progress:	{{ FormatTotalRepairProgress .SuccessPercentage .ErrorPercentage }}
 Intensity:	{{ FormatRepairIntensity .Intensity .MaxIntensity }}
 Parallel:	{{ FormatRepairParallel .Parallel .MaxParallel }}
+{{ if .Incremental }}Mode:		incremental
+{{ end -}}
 {{ if .Host }}Host:	{{ .Host }}
 {{ end -}}
 {{ if .Dcs }}Datacenters:	{{ range .Dcs }}
@@ -957,6 +1351,9 @@ Duration:	{{ FormatDuration .StartTime .EndTime }}
 {{ with .Progress }}Progress:	{{ if ne .Size 0 }}{{ FormatUploadProgress .Size .Uploaded .Skipped .Failed }}{{else}}-{{ end }}
 {{- if ne .SnapshotTag "" }}
 Snapshot Tag:	{{ .SnapshotTag }}
+{{- with FormatTime .PointInTime }}
+Point in Time:  {{ . }}
+{{- end }}
 {{- end }}
 {{ if .Dcs -}}
 Datacenters:	{{ range .Dcs }}
@@ -1021,6 +1418,9 @@ Duration:	{{ FormatDuration .StartTime .EndTime }}
 {{ end -}}
 {{ with .Progress }}Progress:	{{ if ne .Size 0 }}{{ FormatRestoreProgress .Size .Restored .Downloaded .Failed }}{{else}}-{{ end }}
 Snapshot Tag:	{{ .SnapshotTag }}
+{{- with FormatTime .PointInTime }}
+Point in Time:  {{ . }}
+{{- end }}
 {{ else }}Progress:	0%
 {{ end }}
 {{- if .Errors -}}
@@ -1280,6 +1680,9 @@ Duration:	{{ FormatDuration .StartTime .EndTime }}
 {{ with progress -}}
 Scanned files:	{{ .ScannedFiles }}
 Missing files:	{{ .MissingFiles }}
+{{- if gt .CorruptedFiles 0 }}
+Corrupted files:	{{ .CorruptedFiles }}
+{{- end }}
 Orphaned files:	{{ .OrphanedFiles }} {{ if gt .OrphanedFiles 0 }}({{ FormatSizeSuffix .OrphanedBytes }}){{ end }}
 {{- if gt .DeletedFiles 0 }}
 Deleted files:	{{ .DeletedFiles }}
@@ -1313,8 +1716,9 @@ func (p ValidateBackupProgress) aggregatedProgress() models.ValidateBackupProgre
 	for _, i := range p.Progress {
 		a.Manifests += i.Manifests
 		a.ScannedFiles += i.ScannedFiles
-		bs.Add(a.BrokenSnapshots...)
+		bs.Add(i.BrokenSnapshots...)
 		a.MissingFiles += i.MissingFiles
+		a.CorruptedFiles += i.CorruptedFiles
 		a.OrphanedFiles += i.OrphanedFiles
 		a.OrphanedBytes += i.OrphanedBytes
 		a.DeletedFiles += i.DeletedFiles
@@ -1331,11 +1735,12 @@ func (p ValidateBackupProgress) addHostProgress(w io.Writer) error {
 		"Manifests",
 		"Scanned files",
 		"Missing files",
+		"Corrupted files",
 		"Orphaned files",
 		"Orphaned bytes",
 		"Deleted files",
 	)
-	t.SetColumnAlignment(termtables.AlignRight, 1, 2, 3, 4, 5, 6, 7)
+	t.SetColumnAlignment(termtables.AlignRight, 1, 2, 3, 4, 5, 6, 7, 8)
 	lastLocation := ""
 
 	fmt.Fprintln(w)
@@ -1355,6 +1760,7 @@ func (p ValidateBackupProgress) addHostProgress(w io.Writer) error {
 			hp.Manifests,
 			hp.ScannedFiles,
 			hp.MissingFiles,
+			hp.CorruptedFiles,
 			hp.OrphanedFiles,
 			FormatSizeSuffix(hp.OrphanedBytes),
 			hp.DeletedFiles,
@@ -1378,6 +1784,7 @@ const backupListItemTemplate = `backup/{{ .TaskID }}
 Snapshots:
 {{- range .SnapshotInfo }}
   - {{ .SnapshotTag }} ({{ if eq .Size 0 }}n/a{{ else }}{{ FormatSizeSuffix .Size }}{{ end }}, {{ .Nodes }} nodes)
+{{- if .ParentSnapshotTag }} <- {{ .ParentSnapshotTag }}{{ else if .BaseSnapshotTag }} synthetic full{{ end }}
 {{- end }}
 Keyspaces:
 {{- range .Units }}
@@ -0,0 +1,133 @@
+// Copyright (C) 2024 ScyllaDB
+
+package managerclient
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/scylladb/scylla-manager/v3/pkg/util/jsonutil"
+)
+
+// Task progress stream events.
+const (
+	progressEvent = "progress"
+	patchEvent    = "patch"
+	endEvent      = "end"
+)
+
+// streamError is returned when server rejects the stream request,
+// it can be printed with PrintError.
+type streamError struct {
+	code    int
+	payload *ErrorResponse
+}
+
+func (e *streamError) Error() string {
+	return fmt.Sprintf("[%d] %s", e.code, e.payload.Message)
+}
+
+func (e *streamError) GetPayload() *ErrorResponse {
+	return e.payload
+}
+
+// FollowProgress streams progress of a task run. Function fn is called with
+// the full progress document, in the same format as returned by RepairProgress,
+// BackupProgress, RestoreProgress and ValidateBackupProgress, every time
+// the progress changes. It returns when the run is done.
+func (c *Client) FollowProgress(ctx context.Context, clusterID, taskType, taskID, runID string,
+	interval time.Duration, fn func(doc json.RawMessage) error,
+) error {
+	u := *c.baseURL
+	u.Path = path.Join(u.Path, "cluster", clusterID, "task", taskType, taskID, runID, "stream")
+	if interval > 0 {
+		u.RawQuery = url.Values{"interval": []string{interval.String()}}.Encode()
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), http.NoBody)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "text/event-stream")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		payload := new(ErrorResponse)
+		if err := json.NewDecoder(resp.Body).Decode(payload); err != nil {
+			payload.Message = http.StatusText(resp.StatusCode)
+		}
+		return &streamError{code: resp.StatusCode, payload: payload}
+	}
+
+	return readProgressStream(resp.Body, fn)
+}
+
+// readProgressStream reads server-sent events and applies patches
+// to the progress document.
+func readProgressStream(r io.Reader, fn func(doc json.RawMessage) error) error {
+	var (
+		doc   json.RawMessage
+		event string
+		data  strings.Builder
+	)
+
+	s := bufio.NewScanner(r)
+	s.Buffer(nil, 64*1024*1024)
+	for s.Scan() {
+		line := s.Text()
+		if line != "" {
+			// Comments and unknown fields are ignored
+			if strings.HasPrefix(line, "event:") {
+				event = strings.TrimSpace(strings.TrimPrefix(line, "event:"))
+			} else if strings.HasPrefix(line, "data:") {
+				data.WriteString(strings.TrimPrefix(strings.TrimPrefix(line, "data:"), " "))
+			}
+			continue
+		}
+
+		// Empty line dispatches the event
+		switch event {
+		case progressEvent:
+			doc = json.RawMessage(data.String())
+		case patchEvent:
+			if doc == nil {
+				return errors.New("received patch before progress")
+			}
+			var ops []jsonutil.PatchOp
+			if err := json.Unmarshal([]byte(data.String()), &ops); err != nil {
+				return errors.Wrap(err, "decode patch")
+			}
+			var err error
+			if doc, err = jsonutil.ApplyPatch(doc, ops); err != nil {
+				return errors.Wrap(err, "apply patch")
+			}
+		case endEvent:
+			return nil
+		}
+		if doc != nil && (event == progressEvent || event == patchEvent) {
+			if err := fn(doc); err != nil {
+				return err
+			}
+		}
+		event = ""
+		data.Reset()
+	}
+	if err := s.Err(); err != nil {
+		return errors.Wrap(err, "read stream")
+	}
+	return errors.New("stream closed before run ended")
+}
@@ -0,0 +1,54 @@
+// Copyright (C) 2024 ScyllaDB
+
+package managerclient
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestReadProgressStream(t *testing.T) {
+	t.Parallel()
+
+	const stream = "event: progress\n" +
+		"data: {\"run\":{\"status\":\"RUNNING\"},\"progress\":{\"hosts\":[{\"host\":\"a\",\"success\":1}]}}\n\n" +
+		": keep-alive\n\n" +
+		"event: patch\n" +
+		"data: [{\"op\":\"replace\",\"path\":\"/progress/hosts/0/success\",\"value\":5},{\"op\":\"add\",\"path\":\"/progress/hosts/1\",\"value\":{\"host\":\"b\",\"success\":0}}]\n\n" +
+		"event: patch\n" +
+		"data: [{\"op\":\"replace\",\"path\":\"/run/status\",\"value\":\"DONE\"}]\n\n" +
+		"event: end\n" +
+		"data: {\"status\":\"DONE\"}\n\n"
+
+	var docs []string
+	err := readProgressStream(strings.NewReader(stream), func(doc json.RawMessage) error {
+		docs = append(docs, string(doc))
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []string{
+		`{"run":{"status":"RUNNING"},"progress":{"hosts":[{"host":"a","success":1}]}}`,
+		`{"progress":{"hosts":[{"host":"a","success":5},{"host":"b","success":0}]},"run":{"status":"RUNNING"}}`,
+		`{"progress":{"hosts":[{"host":"a","success":5},{"host":"b","success":0}]},"run":{"status":"DONE"}}`,
+	}
+	if diff := cmp.Diff(expected, docs); diff != "" {
+		t.Fatal(diff)
+	}
+}
+
+func TestReadProgressStreamClosed(t *testing.T) {
+	t.Parallel()
+
+	const stream = "event: progress\ndata: {}\n\n"
+
+	err := readProgressStream(strings.NewReader(stream), func(doc json.RawMessage) error { return nil })
+	if err == nil {
+		t.Fatal("readProgressStream() expected error")
+	}
+}
@@ -3,11 +3,13 @@
 package managerclient
 
 import (
+	"encoding/json"
 	"fmt"
 	"io"
 	"math"
 	"net/url"
 	"path"
+	"sort"
 	"strings"
 	"time"
 
@@ -283,3 +285,78 @@ func FormatRetentionPolicy(retention, retentionDays int64) string {
 	}
 	return res
 }
+
+// runAfterTree orders tasks so that tasks running after other tasks directly
+// follow them. It returns ordered tasks and their depth in the dependency tree.
+// Relative order of tasks with the same parent is preserved.
+func runAfterTree(tasks []*models.TaskListItem) ([]*models.TaskListItem, []int) {
+	present := make(map[string]struct{}, len(tasks))
+	for _, t := range tasks {
+		present[t.ID] = struct{}{}
+	}
+	children := make(map[string][]*models.TaskListItem)
+	var roots []*models.TaskListItem
+	for _, t := range tasks {
+		if _, ok := present[t.RunAfter]; ok && t.RunAfter != t.ID {
+			children[t.RunAfter] = append(children[t.RunAfter], t)
+		} else {
+			roots = append(roots, t)
+		}
+	}
+
+	var (
+		out     = make([]*models.TaskListItem, 0, len(tasks))
+		depth   = make([]int, 0, len(tasks))
+		visited = make(map[string]struct{}, len(tasks))
+		visit   func(t *models.TaskListItem, d int)
+	)
+	visit = func(t *models.TaskListItem, d int) {
+		if _, ok := visited[t.ID]; ok {
+			return
+		}
+		visited[t.ID] = struct{}{}
+		out = append(out, t)
+		depth = append(depth, d)
+		for _, c := range children[t.ID] {
+			visit(c, d+1)
+		}
+	}
+	for _, t := range roots {
+		visit(t, 0)
+	}
+	// Tasks in a dependency cycle are not reachable from roots
+	for _, t := range tasks {
+		visit(t, 0)
+	}
+	return out, depth
+}
+
+// formatAuditDiff renders diff as sorted "path: old -> new" entries.
+func formatAuditDiff(diff interface{}) string {
+	m, ok := diff.(map[string]interface{})
+	if !ok {
+		return ""
+	}
+	paths := make([]string, 0, len(m))
+	for k := range m {
+		paths = append(paths, k)
+	}
+	sort.Strings(paths)
+
+	value := func(v interface{}) string {
+		if v == nil {
+			return "-"
+		}
+		b, err := json.Marshal(v)
+		if err != nil {
+			return fmt.Sprint(v)
+		}
+		return string(b)
+	}
+	out := make([]string, 0, len(paths))
+	for _, p := range paths {
+		c, _ := m[p].(map[string]interface{}) // nolint: errcheck
+		out = append(out, fmt.Sprintf("%s: %s -> %s", p, value(c["old"]), value(c["new"])))
+	}
+	return strings.Join(out, ", ")
+}
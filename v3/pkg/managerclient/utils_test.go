@@ -8,8 +8,10 @@ import (
 	"time"
 
 	"github.com/go-openapi/strfmt"
+	"github.com/google/go-cmp/cmp"
 	"github.com/scylladb/scylla-manager/v3/pkg/util/timeutc"
 	"github.com/scylladb/scylla-manager/v3/pkg/util/uuid"
+	"github.com/scylladb/scylla-manager/v3/swagger/gen/scylla-manager/models"
 )
 
 func TestTaskSplit(t *testing.T) {
@@ -318,3 +320,49 @@ func TestFormatIntensity(t *testing.T) {
 		})
 	}
 }
+
+func TestRunAfterTree(t *testing.T) {
+	t.Parallel()
+
+	tasks := []*models.TaskListItem{
+		{ID: "restore", RunAfter: "validate"},
+		{ID: "backup"},
+		{ID: "validate", RunAfter: "backup"},
+		{ID: "repair"},
+		{ID: "healthcheck", RunAfter: "deleted"},
+		{ID: "report", RunAfter: "backup"},
+		{ID: "cycle1", RunAfter: "cycle2"},
+		{ID: "cycle2", RunAfter: "cycle1"},
+	}
+	expectedIDs := []string{"backup", "validate", "restore", "report", "repair", "healthcheck", "cycle1", "cycle2"}
+	expectedDepth := []int{0, 1, 2, 1, 0, 0, 0, 1}
+
+	out, depth := runAfterTree(tasks)
+	var ids []string
+	for _, t := range out {
+		ids = append(ids, t.ID)
+	}
+	if diff := cmp.Diff(expectedIDs, ids); diff != "" {
+		t.Fatal(diff)
+	}
+	if diff := cmp.Diff(expectedDepth, depth); diff != "" {
+		t.Fatal(diff)
+	}
+}
+
+func TestFormatAuditDiff(t *testing.T) {
+	t.Parallel()
+
+	diff := map[string]interface{}{
+		"name":                 map[string]interface{}{"old": "a", "new": "b"},
+		"properties.intensity": map[string]interface{}{"new": float64(1)},
+		"enabled":              map[string]interface{}{"old": true},
+	}
+	expected := `enabled: true -> -, name: "a" -> "b", properties.intensity: - -> 1`
+	if got := formatAuditDiff(diff); got != expected {
+		t.Fatalf("formatAuditDiff() = %s, expected %s", got, expected)
+	}
+	if got := formatAuditDiff(nil); got != "" {
+		t.Fatalf("formatAuditDiff(nil) = %s, expected empty string", got)
+	}
+}
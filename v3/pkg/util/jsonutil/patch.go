@@ -0,0 +1,193 @@
+// Copyright (C) 2024 ScyllaDB
+
+package jsonutil
+
+import (
+	"encoding/json"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// PatchOp is a JSON Patch (RFC 6902) operation.
+// Only add, remove and replace operations are supported.
+type PatchOp struct {
+	Op    string      `json:"op"`
+	Path  string      `json:"path"`
+	Value interface{} `json:"value,omitempty"`
+}
+
+// Patch operations.
+const (
+	OpAdd     = "add"
+	OpRemove  = "remove"
+	OpReplace = "replace"
+)
+
+// Diff returns JSON Patch operations that transform document a into b.
+// Objects are compared key by key, arrays element by element. Arrays that
+// got shorter are replaced as a whole.
+func Diff(a, b json.RawMessage) ([]PatchOp, error) {
+	var av, bv interface{}
+	if err := json.Unmarshal(a, &av); err != nil {
+		return nil, errors.Wrap(err, "decode a")
+	}
+	if err := json.Unmarshal(b, &bv); err != nil {
+		return nil, errors.Wrap(err, "decode b")
+	}
+	return diff(nil, "", av, bv), nil
+}
+
+func diff(ops []PatchOp, path string, a, b interface{}) []PatchOp {
+	switch at := a.(type) {
+	case map[string]interface{}:
+		bt, ok := b.(map[string]interface{})
+		if !ok {
+			break
+		}
+		keys := make([]string, 0, len(at)+len(bt))
+		for k := range at {
+			keys = append(keys, k)
+		}
+		for k := range bt {
+			if _, ok := at[k]; !ok {
+				keys = append(keys, k)
+			}
+		}
+		sort.Strings(keys)
+
+		for _, k := range keys {
+			p := path + "/" + escapePointer(k)
+			av, aok := at[k]
+			bv, bok := bt[k]
+			switch {
+			case !bok:
+				ops = append(ops, PatchOp{Op: OpRemove, Path: p})
+			case !aok:
+				ops = append(ops, PatchOp{Op: OpAdd, Path: p, Value: bv})
+			default:
+				ops = diff(ops, p, av, bv)
+			}
+		}
+		return ops
+	case []interface{}:
+		bt, ok := b.([]interface{})
+		if !ok || len(bt) < len(at) {
+			break
+		}
+		for i := range at {
+			ops = diff(ops, path+"/"+strconv.Itoa(i), at[i], bt[i])
+		}
+		for i := len(at); i < len(bt); i++ {
+			ops = append(ops, PatchOp{Op: OpAdd, Path: path + "/" + strconv.Itoa(i), Value: bt[i]})
+		}
+		return ops
+	}
+
+	if !reflect.DeepEqual(a, b) {
+		ops = append(ops, PatchOp{Op: OpReplace, Path: path, Value: b})
+	}
+	return ops
+}
+
+// ApplyPatch applies JSON Patch operations to document and returns
+// the modified document.
+func ApplyPatch(doc json.RawMessage, ops []PatchOp) (json.RawMessage, error) {
+	var v interface{}
+	if err := json.Unmarshal(doc, &v); err != nil {
+		return nil, errors.Wrap(err, "decode document")
+	}
+	for _, op := range ops {
+		var err error
+		if v, err = apply(v, splitPointer(op.Path), op); err != nil {
+			return nil, errors.Wrapf(err, "%s %s", op.Op, op.Path)
+		}
+	}
+	return json.Marshal(v)
+}
+
+func apply(v interface{}, tokens []string, op PatchOp) (interface{}, error) {
+	if len(tokens) == 0 {
+		switch op.Op {
+		case OpAdd, OpReplace:
+			return op.Value, nil
+		default:
+			return nil, errors.New("unsupported operation on document root")
+		}
+	}
+
+	tok, rest := tokens[0], tokens[1:]
+	switch t := v.(type) {
+	case map[string]interface{}:
+		if len(rest) > 0 {
+			c, ok := t[tok]
+			if !ok {
+				return nil, errors.Errorf("missing key %s", tok)
+			}
+			nv, err := apply(c, rest, op)
+			if err != nil {
+				return nil, err
+			}
+			t[tok] = nv
+			return t, nil
+		}
+		switch op.Op {
+		case OpAdd, OpReplace:
+			t[tok] = op.Value
+		case OpRemove:
+			delete(t, tok)
+		default:
+			return nil, errors.Errorf("unsupported operation %s", op.Op)
+		}
+		return t, nil
+	case []interface{}:
+		i, err := strconv.Atoi(tok)
+		if tok == "-" {
+			i, err = len(t), nil
+		}
+		if err != nil || i < 0 || i > len(t) || (i == len(t) && (len(rest) > 0 || op.Op != OpAdd)) {
+			return nil, errors.Errorf("invalid array index %s", tok)
+		}
+		if len(rest) > 0 {
+			nv, err := apply(t[i], rest, op)
+			if err != nil {
+				return nil, err
+			}
+			t[i] = nv
+			return t, nil
+		}
+		switch op.Op {
+		case OpAdd:
+			t = append(t, nil)
+			copy(t[i+1:], t[i:])
+			t[i] = op.Value
+		case OpReplace:
+			t[i] = op.Value
+		case OpRemove:
+			t = append(t[:i], t[i+1:]...)
+		default:
+			return nil, errors.Errorf("unsupported operation %s", op.Op)
+		}
+		return t, nil
+	default:
+		return nil, errors.Errorf("can't index %T with %s", v, tok)
+	}
+}
+
+func escapePointer(s string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(s, "~", "~0"), "/", "~1")
+}
+
+func splitPointer(p string) []string {
+	if p == "" {
+		return nil
+	}
+	tokens := strings.Split(strings.TrimPrefix(p, "/"), "/")
+	for i := range tokens {
+		tokens[i] = strings.ReplaceAll(strings.ReplaceAll(tokens[i], "~1", "/"), "~0", "~")
+	}
+	return tokens
+}
@@ -0,0 +1,119 @@
+// Copyright (C) 2024 ScyllaDB
+
+package jsonutil
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestDiffApplyPatch(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name     string
+		A        string
+		B        string
+		Expected []PatchOp
+	}{
+		{
+			Name:     "equal",
+			A:        `{"a":1,"b":[1,2]}`,
+			B:        `{"a":1,"b":[1,2]}`,
+			Expected: nil,
+		},
+		{
+			Name: "object keys",
+			A:    `{"a":1,"b":{"c":"x","d":true},"e":null}`,
+			B:    `{"a":2,"b":{"c":"y"},"f/g":1}`,
+			Expected: []PatchOp{
+				{Op: OpReplace, Path: "/a", Value: 2.},
+				{Op: OpReplace, Path: "/b/c", Value: "y"},
+				{Op: OpRemove, Path: "/b/d"},
+				{Op: OpRemove, Path: "/e"},
+				{Op: OpAdd, Path: "/f~1g", Value: 1.},
+			},
+		},
+		{
+			Name: "array elements",
+			A:    `{"hosts":[{"host":"a","done":1},{"host":"b","done":0}]}`,
+			B:    `{"hosts":[{"host":"a","done":1},{"host":"b","done":5},{"host":"c","done":0}]}`,
+			Expected: []PatchOp{
+				{Op: OpReplace, Path: "/hosts/1/done", Value: 5.},
+				{Op: OpAdd, Path: "/hosts/2", Value: map[string]interface{}{"host": "c", "done": 0.}},
+			},
+		},
+		{
+			Name: "shorter array",
+			A:    `{"a":[1,2,3]}`,
+			B:    `{"a":[1]}`,
+			Expected: []PatchOp{
+				{Op: OpReplace, Path: "/a", Value: []interface{}{1.}},
+			},
+		},
+		{
+			Name: "type change",
+			A:    `{"a":{"b":1}}`,
+			B:    `{"a":[1]}`,
+			Expected: []PatchOp{
+				{Op: OpReplace, Path: "/a", Value: []interface{}{1.}},
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+
+			ops, err := Diff(json.RawMessage(tc.A), json.RawMessage(tc.B))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tc.Expected, ops); diff != "" {
+				t.Fatal(diff)
+			}
+
+			// Send ops over the wire
+			b, err := json.Marshal(ops)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var decoded []PatchOp
+			if err := json.Unmarshal(b, &decoded); err != nil {
+				t.Fatal(err)
+			}
+
+			got, err := ApplyPatch(json.RawMessage(tc.A), decoded)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var gotV, expectedV interface{}
+			if err := json.Unmarshal(got, &gotV); err != nil {
+				t.Fatal(err)
+			}
+			if err := json.Unmarshal([]byte(tc.B), &expectedV); err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(expectedV, gotV); diff != "" {
+				t.Fatal(diff)
+			}
+		})
+	}
+}
+
+func TestApplyPatchError(t *testing.T) {
+	t.Parallel()
+
+	for _, op := range []PatchOp{
+		{Op: OpReplace, Path: "/a/b", Value: 1},
+		{Op: OpReplace, Path: "/c/5", Value: 1},
+		{Op: "move", Path: "/a"},
+	} {
+		if _, err := ApplyPatch(json.RawMessage(`{"a":1,"c":[1]}`), []PatchOp{op}); err == nil {
+			t.Errorf("ApplyPatch(%+v) expected error", op)
+		}
+	}
+}
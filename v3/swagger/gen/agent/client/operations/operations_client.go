@@ -49,6 +49,8 @@ type ClientService interface {
 
 	OperationsCheckPermissions(params *OperationsCheckPermissionsParams) (*OperationsCheckPermissionsOK, error)
 
+	OperationsChecksum(params *OperationsChecksumParams) (*OperationsChecksumOK, error)
+
 	OperationsCopyfile(params *OperationsCopyfileParams) (*OperationsCopyfileOK, error)
 
 	OperationsDeletefile(params *OperationsDeletefileParams) (*OperationsDeletefileOK, error)
@@ -63,6 +65,8 @@ type ClientService interface {
 
 	OperationsPurge(params *OperationsPurgeParams) (*OperationsPurgeOK, error)
 
+	OperationsRetention(params *OperationsRetentionParams) (*OperationsRetentionOK, error)
+
 	PinCPU(params *PinCPUParams) (*PinCPUOK, error)
 
 	Reload(params *ReloadParams) (*ReloadOK, error)
@@ -73,6 +77,8 @@ type ClientService interface {
 
 	SyncMoveDir(params *SyncMoveDirParams) (*SyncMoveDirOK, error)
 
+	ThrottleStatus(params *ThrottleStatusParams) (*ThrottleStatusOK, error)
+
 	UnpinCPU(params *UnpinCPUParams) (*UnpinCPUOK, error)
 
 	SetTransport(transport runtime.ClientTransport)
@@ -498,6 +504,41 @@ func (a *Client) OperationsCheckPermissions(params *OperationsCheckPermissionsPa
 	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
 }
 
+/*
+OperationsChecksum gets checksums
+
+Get CRC32 checksums of content of provided list of paths
+*/
+func (a *Client) OperationsChecksum(params *OperationsChecksumParams) (*OperationsChecksumOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewOperationsChecksumParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "OperationsChecksum",
+		Method:             "POST",
+		PathPattern:        "/rclone/operations/checksum",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &OperationsChecksumReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*OperationsChecksumOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*OperationsChecksumDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
 /*
 OperationsCopyfile copies a file
 
@@ -571,7 +612,7 @@ func (a *Client) OperationsDeletefile(params *OperationsDeletefileParams) (*Oper
 /*
 OperationsDeletepaths deletes paths
 
-Delete provided list of paths
+Delete provided list of paths, deleting objects protected by object lock or retention policy fails with status 423
 */
 func (a *Client) OperationsDeletepaths(params *OperationsDeletepathsParams) (*OperationsDeletepathsOK, error) {
 	// TODO: Validate the params before sending
@@ -743,6 +784,41 @@ func (a *Client) OperationsPurge(params *OperationsPurgeParams) (*OperationsPurg
 	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
 }
 
+/*
+OperationsRetention sets retention
+
+Set object lock retention of provided list of paths, retention is never shortened
+*/
+func (a *Client) OperationsRetention(params *OperationsRetentionParams) (*OperationsRetentionOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewOperationsRetentionParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "OperationsRetention",
+		Method:             "POST",
+		PathPattern:        "/rclone/operations/retention",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &OperationsRetentionReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*OperationsRetentionOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*OperationsRetentionDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
 /*
 PinCPU pins agent to c p us according to start up logic
 
@@ -918,6 +994,41 @@ func (a *Client) SyncMoveDir(params *SyncMoveDirParams) (*SyncMoveDirOK, error)
 	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
 }
 
+/*
+ThrottleStatus gets adaptive throttling status
+
+Get adaptive throttling status of the node
+*/
+func (a *Client) ThrottleStatus(params *ThrottleStatusParams) (*ThrottleStatusOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewThrottleStatusParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "ThrottleStatus",
+		Method:             "GET",
+		PathPattern:        "/throttle",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &ThrottleStatusReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ThrottleStatusOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*ThrottleStatusDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
 /*
 UnpinCPU unpins agent from c p us
 
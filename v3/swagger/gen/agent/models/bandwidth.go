@@ -17,7 +17,7 @@ import (
 // swagger:model Bandwidth
 type Bandwidth struct {
 
-	// String representation of the bandwidth rate limit (eg. 100k, 1M, ...).
+	// String representation of the bandwidth rate limit (eg. 100k, 1M, ...) or bandwidth profile (eg. 08:00,50M 18:00,off).
 	Rate string `json:"rate,omitempty"`
 }
 
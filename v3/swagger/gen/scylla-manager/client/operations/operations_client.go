@@ -25,18 +25,36 @@ type Client struct {
 
 // ClientService is the interface for Client methods
 type ClientService interface {
+	DeleteBackupEncryptionKeyClusterID(params *DeleteBackupEncryptionKeyClusterIDParams) (*DeleteBackupEncryptionKeyClusterIDOK, error)
+
 	DeleteClusterClusterID(params *DeleteClusterClusterIDParams) (*DeleteClusterClusterIDOK, error)
 
 	DeleteClusterClusterIDBackups(params *DeleteClusterClusterIDBackupsParams) (*DeleteClusterClusterIDBackupsOK, error)
 
+	DeleteClusterClusterIDBlackoutsBlackoutID(params *DeleteClusterClusterIDBlackoutsBlackoutIDParams) (*DeleteClusterClusterIDBlackoutsBlackoutIDOK, error)
+
+	DeleteClusterClusterIDSLAPolicy(params *DeleteClusterClusterIDSLAPolicyParams) (*DeleteClusterClusterIDSLAPolicyOK, error)
+
 	DeleteClusterClusterIDTaskTaskTypeTaskID(params *DeleteClusterClusterIDTaskTaskTypeTaskIDParams) (*DeleteClusterClusterIDTaskTaskTypeTaskIDOK, error)
 
+	DeleteTokenTokenID(params *DeleteTokenTokenIDParams) (*DeleteTokenTokenIDOK, error)
+
+	GetAudit(params *GetAuditParams) (*GetAuditOK, error)
+
+	GetBackupEncryptionKeyClusterID(params *GetBackupEncryptionKeyClusterIDParams) (*GetBackupEncryptionKeyClusterIDOK, error)
+
 	GetClusterClusterID(params *GetClusterClusterIDParams) (*GetClusterClusterIDOK, error)
 
 	GetClusterClusterIDBackups(params *GetClusterClusterIDBackupsParams) (*GetClusterClusterIDBackupsOK, error)
 
 	GetClusterClusterIDBackupsFiles(params *GetClusterClusterIDBackupsFilesParams) (*GetClusterClusterIDBackupsFilesOK, error)
 
+	GetClusterClusterIDBlackouts(params *GetClusterClusterIDBlackoutsParams) (*GetClusterClusterIDBlackoutsOK, error)
+
+	GetClusterClusterIDSLA(params *GetClusterClusterIDSLAParams) (*GetClusterClusterIDSLAOK, error)
+
+	GetClusterClusterIDSLAPolicy(params *GetClusterClusterIDSLAPolicyParams) (*GetClusterClusterIDSLAPolicyOK, error)
+
 	GetClusterClusterIDStatus(params *GetClusterClusterIDStatusParams) (*GetClusterClusterIDStatusOK, error)
 
 	GetClusterClusterIDSuspended(params *GetClusterClusterIDSuspendedParams) (*GetClusterClusterIDSuspendedOK, error)
@@ -59,22 +77,36 @@ type ClientService interface {
 
 	GetClusterClusterIDTasksRepairTarget(params *GetClusterClusterIDTasksRepairTargetParams) (*GetClusterClusterIDTasksRepairTargetOK, error)
 
+	GetClusterClusterIDTasksRestorePlan(params *GetClusterClusterIDTasksRestorePlanParams) (*GetClusterClusterIDTasksRestorePlanOK, error)
+
 	GetClusterClusterIDTasksRestoreTarget(params *GetClusterClusterIDTasksRestoreTargetParams) (*GetClusterClusterIDTasksRestoreTargetOK, error)
 
 	GetClusters(params *GetClustersParams) (*GetClustersOK, error)
 
+	GetOverview(params *GetOverviewParams) (*GetOverviewOK, error)
+
+	GetTokens(params *GetTokensParams) (*GetTokensOK, error)
+
 	GetVersion(params *GetVersionParams) (*GetVersionOK, error)
 
+	PostClusterClusterIDBlackouts(params *PostClusterClusterIDBlackoutsParams) (*PostClusterClusterIDBlackoutsCreated, error)
+
 	PostClusterClusterIDTasks(params *PostClusterClusterIDTasksParams) (*PostClusterClusterIDTasksCreated, error)
 
 	PostClusters(params *PostClustersParams) (*PostClustersCreated, error)
 
+	PostTokens(params *PostTokensParams) (*PostTokensOK, error)
+
+	PutBackupEncryptionKeyClusterID(params *PutBackupEncryptionKeyClusterIDParams) (*PutBackupEncryptionKeyClusterIDOK, error)
+
 	PutClusterClusterID(params *PutClusterClusterIDParams) (*PutClusterClusterIDOK, error)
 
 	PutClusterClusterIDRepairsIntensity(params *PutClusterClusterIDRepairsIntensityParams) (*PutClusterClusterIDRepairsIntensityOK, error)
 
 	PutClusterClusterIDRepairsParallel(params *PutClusterClusterIDRepairsParallelParams) (*PutClusterClusterIDRepairsParallelOK, error)
 
+	PutClusterClusterIDSLAPolicy(params *PutClusterClusterIDSLAPolicyParams) (*PutClusterClusterIDSLAPolicyOK, error)
+
 	PutClusterClusterIDSuspended(params *PutClusterClusterIDSuspendedParams) (*PutClusterClusterIDSuspendedOK, error)
 
 	PutClusterClusterIDTaskTaskTypeTaskID(params *PutClusterClusterIDTaskTaskTypeTaskIDParams) (*PutClusterClusterIDTaskTaskTypeTaskIDOK, error)
@@ -86,6 +118,39 @@ type ClientService interface {
 	SetTransport(transport runtime.ClientTransport)
 }
 
+/*
+DeleteBackupEncryptionKeyClusterID delete backup encryption key cluster ID API
+*/
+func (a *Client) DeleteBackupEncryptionKeyClusterID(params *DeleteBackupEncryptionKeyClusterIDParams) (*DeleteBackupEncryptionKeyClusterIDOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewDeleteBackupEncryptionKeyClusterIDParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "DeleteBackupEncryptionKeyClusterID",
+		Method:             "DELETE",
+		PathPattern:        "/backup/encryption-key/{cluster_id}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &DeleteBackupEncryptionKeyClusterIDReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*DeleteBackupEncryptionKeyClusterIDOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*DeleteBackupEncryptionKeyClusterIDDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
 /*
 DeleteClusterClusterID delete cluster cluster ID API
 */
@@ -152,6 +217,72 @@ func (a *Client) DeleteClusterClusterIDBackups(params *DeleteClusterClusterIDBac
 	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
 }
 
+/*
+DeleteClusterClusterIDBlackoutsBlackoutID delete cluster cluster ID blackouts blackout ID API
+*/
+func (a *Client) DeleteClusterClusterIDBlackoutsBlackoutID(params *DeleteClusterClusterIDBlackoutsBlackoutIDParams) (*DeleteClusterClusterIDBlackoutsBlackoutIDOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewDeleteClusterClusterIDBlackoutsBlackoutIDParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "DeleteClusterClusterIDBlackoutsBlackoutID",
+		Method:             "DELETE",
+		PathPattern:        "/cluster/{cluster_id}/blackouts/{blackout_id}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &DeleteClusterClusterIDBlackoutsBlackoutIDReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*DeleteClusterClusterIDBlackoutsBlackoutIDOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*DeleteClusterClusterIDBlackoutsBlackoutIDDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+DeleteClusterClusterIDSLAPolicy delete cluster cluster ID SLA policy API
+*/
+func (a *Client) DeleteClusterClusterIDSLAPolicy(params *DeleteClusterClusterIDSLAPolicyParams) (*DeleteClusterClusterIDSLAPolicyOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewDeleteClusterClusterIDSLAPolicyParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "DeleteClusterClusterIDSLAPolicy",
+		Method:             "DELETE",
+		PathPattern:        "/cluster/{cluster_id}/sla/policy",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &DeleteClusterClusterIDSLAPolicyReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*DeleteClusterClusterIDSLAPolicyOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*DeleteClusterClusterIDSLAPolicyDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
 /*
 DeleteClusterClusterIDTaskTaskTypeTaskID delete cluster cluster ID task task type task ID API
 */
@@ -185,6 +316,105 @@ func (a *Client) DeleteClusterClusterIDTaskTaskTypeTaskID(params *DeleteClusterC
 	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
 }
 
+/*
+DeleteTokenTokenID delete token token ID API
+*/
+func (a *Client) DeleteTokenTokenID(params *DeleteTokenTokenIDParams) (*DeleteTokenTokenIDOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewDeleteTokenTokenIDParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "DeleteTokenTokenID",
+		Method:             "DELETE",
+		PathPattern:        "/token/{token_id}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &DeleteTokenTokenIDReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*DeleteTokenTokenIDOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*DeleteTokenTokenIDDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+GetAudit get audit API
+*/
+func (a *Client) GetAudit(params *GetAuditParams) (*GetAuditOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetAuditParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "GetAudit",
+		Method:             "GET",
+		PathPattern:        "/audit",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &GetAuditReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*GetAuditOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*GetAuditDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+GetBackupEncryptionKeyClusterID get backup encryption key cluster ID API
+*/
+func (a *Client) GetBackupEncryptionKeyClusterID(params *GetBackupEncryptionKeyClusterIDParams) (*GetBackupEncryptionKeyClusterIDOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetBackupEncryptionKeyClusterIDParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "GetBackupEncryptionKeyClusterID",
+		Method:             "GET",
+		PathPattern:        "/backup/encryption-key/{cluster_id}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &GetBackupEncryptionKeyClusterIDReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*GetBackupEncryptionKeyClusterIDOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*GetBackupEncryptionKeyClusterIDDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
 /*
 GetClusterClusterID get cluster cluster ID API
 */
@@ -284,6 +514,105 @@ func (a *Client) GetClusterClusterIDBackupsFiles(params *GetClusterClusterIDBack
 	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
 }
 
+/*
+GetClusterClusterIDBlackouts get cluster cluster ID blackouts API
+*/
+func (a *Client) GetClusterClusterIDBlackouts(params *GetClusterClusterIDBlackoutsParams) (*GetClusterClusterIDBlackoutsOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetClusterClusterIDBlackoutsParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "GetClusterClusterIDBlackouts",
+		Method:             "GET",
+		PathPattern:        "/cluster/{cluster_id}/blackouts",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &GetClusterClusterIDBlackoutsReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*GetClusterClusterIDBlackoutsOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*GetClusterClusterIDBlackoutsDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+GetClusterClusterIDSLA get cluster cluster ID SLA API
+*/
+func (a *Client) GetClusterClusterIDSLA(params *GetClusterClusterIDSLAParams) (*GetClusterClusterIDSLAOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetClusterClusterIDSLAParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "GetClusterClusterIDSLA",
+		Method:             "GET",
+		PathPattern:        "/cluster/{cluster_id}/sla",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &GetClusterClusterIDSLAReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*GetClusterClusterIDSLAOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*GetClusterClusterIDSLADefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+GetClusterClusterIDSLAPolicy get cluster cluster ID SLA policy API
+*/
+func (a *Client) GetClusterClusterIDSLAPolicy(params *GetClusterClusterIDSLAPolicyParams) (*GetClusterClusterIDSLAPolicyOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetClusterClusterIDSLAPolicyParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "GetClusterClusterIDSLAPolicy",
+		Method:             "GET",
+		PathPattern:        "/cluster/{cluster_id}/sla/policy",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &GetClusterClusterIDSLAPolicyReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*GetClusterClusterIDSLAPolicyOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*GetClusterClusterIDSLAPolicyDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
 /*
 GetClusterClusterIDStatus get cluster cluster ID status API
 */
@@ -647,6 +976,39 @@ func (a *Client) GetClusterClusterIDTasksRepairTarget(params *GetClusterClusterI
 	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
 }
 
+/*
+GetClusterClusterIDTasksRestorePlan get cluster cluster ID tasks restore plan API
+*/
+func (a *Client) GetClusterClusterIDTasksRestorePlan(params *GetClusterClusterIDTasksRestorePlanParams) (*GetClusterClusterIDTasksRestorePlanOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetClusterClusterIDTasksRestorePlanParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "GetClusterClusterIDTasksRestorePlan",
+		Method:             "GET",
+		PathPattern:        "/cluster/{cluster_id}/tasks/restore/plan",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &GetClusterClusterIDTasksRestorePlanReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*GetClusterClusterIDTasksRestorePlanOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*GetClusterClusterIDTasksRestorePlanDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
 /*
 GetClusterClusterIDTasksRestoreTarget get cluster cluster ID tasks restore target API
 */
@@ -713,6 +1075,72 @@ func (a *Client) GetClusters(params *GetClustersParams) (*GetClustersOK, error)
 	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
 }
 
+/*
+GetOverview get overview API
+*/
+func (a *Client) GetOverview(params *GetOverviewParams) (*GetOverviewOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetOverviewParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "GetOverview",
+		Method:             "GET",
+		PathPattern:        "/overview",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &GetOverviewReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*GetOverviewOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*GetOverviewDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+GetTokens get tokens API
+*/
+func (a *Client) GetTokens(params *GetTokensParams) (*GetTokensOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetTokensParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "GetTokens",
+		Method:             "GET",
+		PathPattern:        "/tokens",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &GetTokensReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*GetTokensOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*GetTokensDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
 /*
 GetVersion get version API
 */
@@ -746,6 +1174,39 @@ func (a *Client) GetVersion(params *GetVersionParams) (*GetVersionOK, error) {
 	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
 }
 
+/*
+PostClusterClusterIDBlackouts post cluster cluster ID blackouts API
+*/
+func (a *Client) PostClusterClusterIDBlackouts(params *PostClusterClusterIDBlackoutsParams) (*PostClusterClusterIDBlackoutsCreated, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewPostClusterClusterIDBlackoutsParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "PostClusterClusterIDBlackouts",
+		Method:             "POST",
+		PathPattern:        "/cluster/{cluster_id}/blackouts",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &PostClusterClusterIDBlackoutsReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*PostClusterClusterIDBlackoutsCreated)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*PostClusterClusterIDBlackoutsDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
 /*
 PostClusterClusterIDTasks post cluster cluster ID tasks API
 */
@@ -812,6 +1273,72 @@ func (a *Client) PostClusters(params *PostClustersParams) (*PostClustersCreated,
 	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
 }
 
+/*
+PostTokens post tokens API
+*/
+func (a *Client) PostTokens(params *PostTokensParams) (*PostTokensOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewPostTokensParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "PostTokens",
+		Method:             "POST",
+		PathPattern:        "/tokens",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &PostTokensReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*PostTokensOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*PostTokensDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+PutBackupEncryptionKeyClusterID put backup encryption key cluster ID API
+*/
+func (a *Client) PutBackupEncryptionKeyClusterID(params *PutBackupEncryptionKeyClusterIDParams) (*PutBackupEncryptionKeyClusterIDOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewPutBackupEncryptionKeyClusterIDParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "PutBackupEncryptionKeyClusterID",
+		Method:             "PUT",
+		PathPattern:        "/backup/encryption-key/{cluster_id}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &PutBackupEncryptionKeyClusterIDReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*PutBackupEncryptionKeyClusterIDOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*PutBackupEncryptionKeyClusterIDDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
 /*
 PutClusterClusterID put cluster cluster ID API
 */
@@ -911,6 +1438,39 @@ func (a *Client) PutClusterClusterIDRepairsParallel(params *PutClusterClusterIDR
 	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
 }
 
+/*
+PutClusterClusterIDSLAPolicy put cluster cluster ID SLA policy API
+*/
+func (a *Client) PutClusterClusterIDSLAPolicy(params *PutClusterClusterIDSLAPolicyParams) (*PutClusterClusterIDSLAPolicyOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewPutClusterClusterIDSLAPolicyParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "PutClusterClusterIDSLAPolicy",
+		Method:             "PUT",
+		PathPattern:        "/cluster/{cluster_id}/sla/policy",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &PutClusterClusterIDSLAPolicyReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*PutClusterClusterIDSLAPolicyOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*PutClusterClusterIDSLAPolicyDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
 /*
 PutClusterClusterIDSuspended put cluster cluster ID suspended API
 */
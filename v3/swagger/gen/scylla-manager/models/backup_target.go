@@ -21,18 +21,36 @@ type BackupTarget struct {
 	// cluster id
 	ClusterID string `json:"cluster_id,omitempty"`
 
+	// compression
+	Compression string `json:"compression,omitempty"`
+
 	// dc
 	Dc []string `json:"dc"`
 
+	// encrypt
+	Encrypt bool `json:"encrypt,omitempty"`
+
+	// full every
+	FullEvery int64 `json:"full_every,omitempty"`
+
 	// host
 	Host string `json:"host,omitempty"`
 
+	// incremental
+	Incremental bool `json:"incremental,omitempty"`
+
 	// location
 	Location []string `json:"location"`
 
+	// object lock mode
+	ObjectLockMode string `json:"object_lock_mode,omitempty"`
+
 	// rate limit
 	RateLimit []string `json:"rate_limit"`
 
+	// rate limit profile
+	RateLimitProfile []string `json:"rate_limit_profile"`
+
 	// retention
 	Retention int64 `json:"retention,omitempty"`
 
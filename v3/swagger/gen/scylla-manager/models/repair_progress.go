@@ -41,6 +41,9 @@ type RepairProgress struct {
 	// hosts
 	Hosts []*RepairProgressHostsItems0 `json:"hosts"`
 
+	// incremental
+	Incremental bool `json:"incremental,omitempty"`
+
 	// intensity
 	Intensity float64 `json:"intensity,omitempty"`
 
@@ -18,18 +18,27 @@ import (
 // swagger:model RepairTarget
 type RepairTarget struct {
 
+	// adaptive
+	Adaptive bool `json:"adaptive,omitempty"`
+
 	// cluster id
 	ClusterID string `json:"cluster_id,omitempty"`
 
 	// dc
 	Dc []string `json:"dc"`
 
+	// full every
+	FullEvery int64 `json:"full_every,omitempty"`
+
 	// host
 	Host string `json:"host,omitempty"`
 
 	// ignore hosts
 	IgnoreHosts []string `json:"ignore_hosts"`
 
+	// incremental
+	Incremental bool `json:"incremental,omitempty"`
+
 	// token ranges
 	TokenRanges string `json:"token_ranges,omitempty"`
 
@@ -11,6 +11,7 @@ import (
 	"github.com/go-openapi/errors"
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
 )
 
 // RestoreTarget restore target
@@ -27,21 +28,34 @@ type RestoreTarget struct {
 	// cluster id
 	ClusterID string `json:"cluster_id,omitempty"`
 
+	// dc mapping
+	DcMapping []string `json:"dc_mapping"`
+
 	// location
 	Location []string `json:"location"`
 
 	// parallel
 	Parallel int64 `json:"parallel,omitempty"`
 
+	// point in time
+	// Format: date-time
+	PointInTime strfmt.DateTime `json:"point_in_time,omitempty"`
+
 	// rate limit
 	RateLimit []string `json:"rate_limit"`
 
+	// rename
+	Rename []string `json:"rename"`
+
 	// size
 	Size int64 `json:"size,omitempty"`
 
 	// snapshot tag
 	SnapshotTag string `json:"snapshot_tag,omitempty"`
 
+	// token ranges
+	TokenRanges []string `json:"token_ranges"`
+
 	// transfers
 	Transfers int64 `json:"transfers,omitempty"`
 
@@ -59,6 +73,10 @@ type RestoreTarget struct {
 func (m *RestoreTarget) Validate(formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.validatePointInTime(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateUnits(formats); err != nil {
 		res = append(res, err)
 	}
@@ -73,6 +91,19 @@ func (m *RestoreTarget) Validate(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *RestoreTarget) validatePointInTime(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.PointInTime) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("point_in_time", "body", "date-time", m.PointInTime.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (m *RestoreTarget) validateUnits(formats strfmt.Registry) error {
 
 	if swag.IsZero(m.Units) { // not required
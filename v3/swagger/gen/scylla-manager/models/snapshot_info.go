@@ -15,9 +15,15 @@ import (
 // swagger:model SnapshotInfo
 type SnapshotInfo struct {
 
+	// base snapshot tag
+	BaseSnapshotTag string `json:"base_snapshot_tag,omitempty"`
+
 	// nodes
 	Nodes int64 `json:"nodes,omitempty"`
 
+	// parent snapshot tag
+	ParentSnapshotTag string `json:"parent_snapshot_tag,omitempty"`
+
 	// size
 	Size int64 `json:"size,omitempty"`
 
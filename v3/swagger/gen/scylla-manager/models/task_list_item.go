@@ -47,12 +47,24 @@ type TaskListItem struct {
 	// Format: date-time
 	NextActivation *strfmt.DateTime `json:"next_activation,omitempty"`
 
+	// Names of notification sinks that are used for this task in addition to sinks enabled for all tasks.
+	Notify []string `json:"notify"`
+
+	// Duration after which notification is sent if task has not succeeded, it overrides notifications.stale_after from the server configuration.
+	NotifyStaleAfter string `json:"notify_stale_after,omitempty"`
+
 	// properties
 	Properties interface{} `json:"properties,omitempty"`
 
 	// retry
 	Retry int64 `json:"retry,omitempty"`
 
+	// ID of a task after which this task is run.
+	RunAfter string `json:"run_after,omitempty"`
+
+	// Outcome of the run_after task run that triggers this task, one of on_success, on_error, always.
+	RunAfterCondition string `json:"run_after_condition,omitempty"`
+
 	// schedule
 	Schedule *Schedule `json:"schedule,omitempty"`
 
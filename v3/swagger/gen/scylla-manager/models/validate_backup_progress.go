@@ -24,6 +24,9 @@ type ValidateBackupProgress struct {
 	// Format: date-time
 	CompletedAt *strfmt.DateTime `json:"completed_at,omitempty"`
 
+	// corrupted files
+	CorruptedFiles int64 `json:"corrupted_files,omitempty"`
+
 	// dc
 	Dc string `json:"dc,omitempty"`
 